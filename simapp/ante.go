@@ -5,14 +5,23 @@ import (
 
 	circuitante "cosmossdk.io/x/circuit/ante"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	aclante "github.com/sagaxyz/saga-sdk/x/acl/ante"
 )
 
 // HandlerOptions are the options required for constructing a default SDK AnteHandler.
 type HandlerOptions struct {
 	ante.HandlerOptions
 	CircuitKeeper circuitante.CircuitBreaker
+	AclKeeper     aclante.AclKeeper
+	GroupKeeper   aclante.GroupKeeper
+	Codec         codec.Codec
+	// AllowlistedMsgs are the message type URL prefixes restricted to the
+	// addresses allowed by the ACL.
+	AllowlistedMsgs []string
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -31,11 +40,16 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errors.New("sign mode handler is required for ante builder")
 	}
 
+	if options.AclKeeper == nil {
+		return nil, errors.New("acl keeper is required for ante builder")
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
+		aclante.NewAllowlistDecorator(options.AclKeeper, options.GroupKeeper, options.Codec, options.AllowlistedMsgs...),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
@@ -132,6 +132,7 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		nft.ModuleName:                 nil,
+		admintypes.ModuleName:          {authtypes.Minter, authtypes.Burner},
 	}
 )
 
@@ -403,6 +404,8 @@ func NewSimApp(
 		appCodec,
 		keys[acltypes.StoreKey],
 		app.GetSubspace(acltypes.ModuleName),
+		app.AccountKeeper,
+		app.GroupKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -412,8 +415,12 @@ func NewSimApp(
 		app.GetSubspace(admintypes.ModuleName),
 		app.BankKeeper,
 		app.AclKeeper,
+		app.MsgServiceRouter(),
 		"cosmos14znghca2ummf4ey23n7exrvf5e4ztcf2v235kq",
 	)
+	// Apps register with RegisterExecMsgs the messages ACL admins execute as
+	// the admin authority, which must be the authority of their modules. The
+	// simapp modules are governed by x/gov, so none is registered.
 
 	/****  Module Options ****/
 
@@ -607,6 +614,10 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
 			&app.CircuitKeeper,
+			app.AclKeeper,
+			app.GroupKeeper,
+			app.appCodec,
+			[]string{sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{})},
 		},
 	)
 	if err != nil {
@@ -0,0 +1,165 @@
+package ante
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// MaxNestedMsgDepth is the maximum depth of the restricted messages nested in
+// authz.MsgExec and group proposals. Transactions nesting restricted messages
+// deeper are rejected.
+const MaxNestedMsgDepth = 6
+
+// maxInspectedDepth bounds the depth of the messages inspected, deeper
+// transactions are rejected. The SDK decodes transactions with fewer levels,
+// only group proposals executing each other with group.MsgExec can chain
+// further.
+const maxInspectedDepth = 32
+
+// AclKeeper defines the expected acl keeper
+type AclKeeper interface {
+	Allowed(ctx sdk.Context, addr sdk.AccAddress) bool
+}
+
+// GroupKeeper defines the expected group keeper, used to load the proposals
+// executed by group.MsgExec
+type GroupKeeper interface {
+	Proposal(ctx context.Context, req *group.QueryProposalRequest) (*group.QueryProposalResponse, error)
+}
+
+// AllowlistDecorator restricts the configured message types to the addresses
+// allowed by the ACL. Messages nested in authz.MsgExec and group proposals are
+// checked as well, against their signers and the signers of every message
+// wrapping them. The messages of group proposals are checked again when they
+// are executed with group.MsgExec, against the proposers and the executor, as
+// the ACL may have changed since the submission.
+//
+// Governance proposals are not inspected: their messages are signed by the gov
+// module account and executed at the end of the voting period, without going
+// through the ante handler. Restricted messages reaching the chain through
+// governance are approved by governance, which controls the ACL as well.
+type AllowlistDecorator struct {
+	aclKeeper   AclKeeper
+	groupKeeper GroupKeeper
+	cdc         codec.Codec
+	prefixes    []string
+}
+
+// NewAllowlistDecorator returns a decorator restricting the messages whose
+// type URL matches one of the prefixes, e.g.
+// "/cosmos.staking.v1beta1.MsgCreateValidator", to ACL allowed signers. The
+// group keeper is optional, without it the proposals executed with
+// group.MsgExec are only checked when submitted.
+func NewAllowlistDecorator(aclKeeper AclKeeper, groupKeeper GroupKeeper, cdc codec.Codec, prefixes ...string) AllowlistDecorator {
+	return AllowlistDecorator{
+		aclKeeper:   aclKeeper,
+		groupKeeper: groupKeeper,
+		cdc:         cdc,
+		prefixes:    prefixes,
+	}
+}
+
+func (ad AllowlistDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	err = ad.checkMsgs(ctx, tx.GetMsgs(), nil, 0)
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// checkMsgs checks that the signers of the restricted messages, and the
+// signers of the messages wrapping them, are allowed.
+func (ad AllowlistDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg, wrappingSigners [][]byte, depth int) error {
+	if depth > maxInspectedDepth {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "messages nested deeper than %d", maxInspectedDepth)
+	}
+
+	for _, msg := range msgs {
+		signers, _, err := ad.cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return err
+		}
+		signers = append(signers, wrappingSigners...)
+
+		msgType := sdk.MsgTypeURL(msg)
+		if ad.restricted(msgType) {
+			if depth > MaxNestedMsgDepth {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s nested deeper than %d", msgType, MaxNestedMsgDepth)
+			}
+			for _, signer := range signers {
+				if !ad.aclKeeper.Allowed(ctx, signer) {
+					return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "address %s not allowed to send %s", sdk.AccAddress(signer), msgType)
+				}
+			}
+		}
+
+		nested, nestedSigners, err := ad.nestedMsgs(ctx, msg)
+		if err != nil {
+			return err
+		}
+		if len(nested) > 0 {
+			err = ad.checkMsgs(ctx, nested, append(signers, nestedSigners...), depth+1)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// restricted returns true if the message type matches a prefix.
+func (ad AllowlistDecorator) restricted(msgType string) bool {
+	for _, prefix := range ad.prefixes {
+		if strings.HasPrefix(msgType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// nestedMsgs returns the messages executed on behalf of a message, and the
+// signers wrapping them besides the ones of the message: the proposers of the
+// group proposals executed with group.MsgExec.
+func (ad AllowlistDecorator) nestedMsgs(ctx sdk.Context, msg sdk.Msg) ([]sdk.Msg, [][]byte, error) {
+	switch msg := msg.(type) {
+	case *authz.MsgExec:
+		msgs, err := msg.GetMessages()
+		return msgs, nil, err
+	case *group.MsgSubmitProposal:
+		msgs, err := msg.GetMsgs()
+		return msgs, nil, err
+	case *group.MsgExec:
+		if ad.groupKeeper == nil {
+			return nil, nil, nil
+		}
+		res, err := ad.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: msg.ProposalId})
+		if err != nil {
+			return nil, nil, err
+		}
+		msgs, err := res.Proposal.GetMsgs()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		proposers := make([][]byte, 0, len(res.Proposal.Proposers))
+		for _, proposer := range res.Proposal.Proposers {
+			addr, err := sdk.AccAddressFromBech32(proposer)
+			if err != nil {
+				return nil, nil, err
+			}
+			proposers = append(proposers, addr)
+		}
+		return msgs, proposers, nil
+	default:
+		return nil, nil, nil
+	}
+}
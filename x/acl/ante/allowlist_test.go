@@ -0,0 +1,156 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	groupmodule "github.com/cosmos/cosmos-sdk/x/group/module"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sagaxyz/saga-sdk/x/acl/ante"
+)
+
+type mockAclKeeper struct {
+	allowed map[string]bool
+}
+
+func (k mockAclKeeper) Allowed(_ sdk.Context, addr sdk.AccAddress) bool {
+	return k.allowed[addr.String()]
+}
+
+type mockGroupKeeper struct {
+	proposals map[uint64]group.Proposal
+}
+
+func (k mockGroupKeeper) Proposal(_ context.Context, req *group.QueryProposalRequest) (*group.QueryProposalResponse, error) {
+	proposal, ok := k.proposals[req.ProposalId]
+	if !ok {
+		return nil, sdkerrors.ErrNotFound
+	}
+	return &group.QueryProposalResponse{Proposal: &proposal}, nil
+}
+
+func TestAllowlistDecorator(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{}, authzmodule.AppModuleBasic{}, groupmodule.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	allowed := sdk.AccAddress([]byte("allowed_____________"))
+	denied := sdk.AccAddress([]byte("denied______________"))
+	policy := sdk.AccAddress([]byte("policy______________"))
+	keeper := mockAclKeeper{allowed: map[string]bool{
+		allowed.String(): true,
+		policy.String():  true,
+	}}
+	groupKeeper := mockGroupKeeper{proposals: map[uint64]group.Proposal{}}
+	decorator := ante.NewAllowlistDecorator(keeper, groupKeeper, encCfg.Codec, sdk.MsgTypeURL(&banktypes.MsgSend{}))
+
+	send := func(from sdk.AccAddress) sdk.Msg {
+		return banktypes.NewMsgSend(from, allowed, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	}
+	exec := func(grantee sdk.AccAddress, msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(grantee, msgs)
+		return &msg
+	}
+	proposal := func(proposer sdk.AccAddress, msgs ...sdk.Msg) sdk.Msg {
+		msg, err := group.NewMsgSubmitProposal(policy.String(), []string{proposer.String()}, msgs, "", group.Exec_EXEC_UNSPECIFIED, "", "")
+		require.NoError(t, err)
+		return msg
+	}
+	storedProposal := func(id uint64, proposer sdk.AccAddress, msgs ...sdk.Msg) {
+		proposal := group.Proposal{Id: id, GroupPolicyAddress: policy.String(), Proposers: []string{proposer.String()}}
+		require.NoError(t, proposal.SetMsgs(msgs))
+		groupKeeper.proposals[id] = proposal
+	}
+	storedProposal(1, allowed, send(policy))
+	storedProposal(2, denied, send(policy))
+	storedProposal(3, allowed, send(denied))
+	storedProposal(4, allowed, &group.MsgExec{ProposalId: 2, Executor: policy.String()})
+	execProposal := func(executor sdk.AccAddress, id uint64) sdk.Msg {
+		return &group.MsgExec{ProposalId: id, Executor: executor.String()}
+	}
+	newTx := func(msgs ...sdk.Msg) sdk.Tx {
+		builder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		return builder.GetTx()
+	}
+
+	testCases := []struct {
+		name   string
+		msgs   []sdk.Msg
+		expErr bool
+	}{
+		{"allowed signer", []sdk.Msg{send(allowed)}, false},
+		{"denied signer", []sdk.Msg{send(denied)}, true},
+		{"unrestricted message", []sdk.Msg{&banktypes.MsgMultiSend{Inputs: []banktypes.Input{{Address: denied.String()}}}}, false},
+		{"denied among messages", []sdk.Msg{send(allowed), send(denied)}, true},
+		{"authz exec allowed", []sdk.Msg{exec(allowed, send(allowed))}, false},
+		{"authz exec denied granter", []sdk.Msg{exec(allowed, send(denied))}, true},
+		{"authz exec denied grantee", []sdk.Msg{exec(denied, send(allowed))}, true},
+		{"nested authz exec", []sdk.Msg{exec(allowed, exec(allowed, send(denied)))}, true},
+		{"group proposal allowed", []sdk.Msg{proposal(allowed, send(policy))}, false},
+		{"group proposal denied proposer", []sdk.Msg{proposal(denied, send(policy))}, true},
+		{"group proposal in authz exec", []sdk.Msg{exec(denied, proposal(denied, send(policy)))}, true},
+		{"group exec allowed", []sdk.Msg{execProposal(allowed, 1)}, false},
+		{"group exec denied proposer", []sdk.Msg{execProposal(allowed, 2)}, true},
+		{"group exec denied executor", []sdk.Msg{execProposal(denied, 1)}, true},
+		{"group exec denied signer", []sdk.Msg{execProposal(allowed, 3)}, true},
+		{"group exec of group exec", []sdk.Msg{execProposal(allowed, 4)}, true},
+		{"group exec unknown proposal", []sdk.Msg{execProposal(allowed, 5)}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var called bool
+			next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				called = true
+				return ctx, nil
+			}
+
+			_, err := decorator.AnteHandle(ctx, newTx(tc.msgs...), false, next)
+			if tc.expErr {
+				require.Error(t, err)
+				require.False(t, called)
+			} else {
+				require.NoError(t, err)
+				require.True(t, called)
+			}
+		})
+	}
+
+	t.Run("nesting depth", func(t *testing.T) {
+		next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			return ctx, nil
+		}
+
+		restricted := send(allowed)
+		unrestricted := sdk.Msg(&banktypes.MsgMultiSend{})
+		for i := 0; i <= ante.MaxNestedMsgDepth; i++ {
+			restricted = exec(allowed, restricted)
+			unrestricted = exec(allowed, unrestricted)
+		}
+
+		_, err := decorator.AnteHandle(ctx, newTx(restricted), false, next)
+		require.Error(t, err)
+		_, err = decorator.AnteHandle(ctx, newTx(unrestricted), false, next)
+		require.NoError(t, err)
+	})
+
+	t.Run("group exec without group keeper", func(t *testing.T) {
+		decorator := ante.NewAllowlistDecorator(keeper, nil, encCfg.Codec, sdk.MsgTypeURL(&banktypes.MsgSend{}))
+		_, err := decorator.AnteHandle(ctx, newTx(execProposal(allowed, 3)), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+			return ctx, nil
+		})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,390 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/sagaxyz/saga-sdk/x/acl/types"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+
+	// csvHeader is the optional header of the address column
+	csvHeader = "address"
+	// csvExpiresAt and csvExpiresAtHeight are the headers of the optional
+	// expiry columns
+	csvExpiresAt       = "expires_at"
+	csvExpiresAtHeight = "expires_at_height"
+	// queryPageLimit is the page size used to fetch complete lists
+	queryPageLimit = 1000
+)
+
+// fileFormat returns the format flag value, or the format matching the file
+// extension if the flag is empty.
+func fileFormat(format, path string) (string, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+
+	switch format {
+	case FormatCSV, FormatJSON:
+		return format, nil
+	case "":
+		return "", errors.New("file format not set")
+	default:
+		return "", fmt.Errorf("unknown file format %s", format)
+	}
+}
+
+// entry is an address of the allowed or admin list and its expiry, nil for
+// permanent entries.
+type entry struct {
+	Address string
+	Expiry  *types.Expiry
+}
+
+// jsonEntry is the JSON encoding of an entry. The expiry fields match the
+// --expires-at and --expires-at-height flags.
+type jsonEntry struct {
+	Address         string `json:"address"`
+	ExpiresAt       string `json:"expires_at,omitempty"`
+	ExpiresAtHeight int64  `json:"expires_at_height,omitempty"`
+}
+
+// newExpiry returns the expiry at a block time in RFC3339 format or at a
+// block height, or nil if neither is set.
+func newExpiry(expiresAt string, height int64) (*types.Expiry, error) {
+	var expiry *types.Expiry
+	switch {
+	case expiresAt != "" && height != 0:
+		return nil, errors.New("only one of the expiry time and height can be set")
+	case expiresAt != "":
+		t, err := time.Parse(time.RFC3339, expiresAt)
+		if err != nil {
+			return nil, err
+		}
+		expiry = types.NewTimeExpiry(t)
+	case height != 0:
+		expiry = types.NewHeightExpiry(height)
+	default:
+		return nil, nil
+	}
+
+	return expiry, expiry.Validate()
+}
+
+// expiryFields returns the time in RFC3339 format and the height of an
+// expiry, as read by newExpiry.
+func expiryFields(expiry *types.Expiry) (expiresAt string, height int64) {
+	if expiry == nil {
+		return "", 0
+	}
+	if expiry.Time != nil {
+		return expiry.Time.UTC().Format(time.RFC3339Nano), 0
+	}
+	return "", expiry.Height
+}
+
+// equalExpiry returns true if two expiries, nil for permanent entries, are
+// the same.
+func equalExpiry(a, b *types.Expiry) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Time == nil || b.Time == nil {
+		return a.Time == b.Time && a.Height == b.Height
+	}
+	return a.Time.Equal(*b.Time)
+}
+
+// readEntries reads a list of addresses and their expiries. CSV files have the
+// address in the first column and may start with an "address" header, which
+// names the optional "expires_at" and "expires_at_height" columns. JSON files
+// contain an array of addresses, or of objects with an "address" and the
+// optional "expires_at" and "expires_at_height" fields. The addresses are
+// validated and deduplicated.
+func readEntries(r io.Reader, format string) ([]entry, error) {
+	var raw []jsonEntry
+	switch format {
+	case FormatCSV:
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+		reader.Comment = '#'
+
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, err
+		}
+		timeColumn, heightColumn := -1, -1
+		for i, record := range records {
+			field := strings.TrimSpace(record[0])
+			if i == 0 && strings.EqualFold(field, csvHeader) {
+				for j, name := range record {
+					switch strings.ToLower(strings.TrimSpace(name)) {
+					case csvExpiresAt:
+						timeColumn = j
+					case csvExpiresAtHeight:
+						heightColumn = j
+					}
+				}
+				continue
+			}
+
+			e := jsonEntry{Address: field}
+			if timeColumn >= 0 && timeColumn < len(record) {
+				e.ExpiresAt = strings.TrimSpace(record[timeColumn])
+			}
+			if heightColumn >= 0 && heightColumn < len(record) {
+				if height := strings.TrimSpace(record[heightColumn]); height != "" {
+					e.ExpiresAtHeight, err = strconv.ParseInt(height, 10, 64)
+					if err != nil {
+						return nil, fmt.Errorf("entry %d: invalid expiry height %s: %w", len(raw)+1, height, err)
+					}
+				}
+			}
+			raw = append(raw, e)
+		}
+	case FormatJSON:
+		var elems []json.RawMessage
+		err := json.NewDecoder(r).Decode(&elems)
+		if err != nil {
+			return nil, err
+		}
+		for i, elem := range elems {
+			var e jsonEntry
+			if err := json.Unmarshal(elem, &e.Address); err != nil {
+				if err := json.Unmarshal(elem, &e); err != nil {
+					return nil, fmt.Errorf("entry %d: %w", i+1, err)
+				}
+			}
+			raw = append(raw, e)
+		}
+	default:
+		return nil, fmt.Errorf("unknown file format %s", format)
+	}
+
+	seen := make(map[string]bool)
+	entries := make([]entry, 0, len(raw))
+	for i, e := range raw {
+		addr, err := sdk.AccAddressFromBech32(e.Address)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: invalid address %s: %w", i+1, e.Address, err)
+		}
+		if seen[addr.String()] {
+			continue
+		}
+		seen[addr.String()] = true
+
+		expiry, err := newExpiry(e.ExpiresAt, e.ExpiresAtHeight)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: invalid expiry: %w", i+1, err)
+		}
+		entries = append(entries, entry{Address: addr.String(), Expiry: expiry})
+	}
+
+	return entries, nil
+}
+
+// writeEntries writes a list of addresses and their expiries in a format
+// accepted by readEntries.
+func writeEntries(w io.Writer, format string, entries []entry) error {
+	switch format {
+	case FormatCSV:
+		writer := csv.NewWriter(w)
+		err := writer.Write([]string{csvHeader, csvExpiresAt, csvExpiresAtHeight})
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			expiresAt, height := expiryFields(e.Expiry)
+			record := []string{e.Address, expiresAt, ""}
+			if height != 0 {
+				record[2] = strconv.FormatInt(height, 10)
+			}
+			err = writer.Write(record)
+			if err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	case FormatJSON:
+		raw := make([]jsonEntry, 0, len(entries))
+		for _, e := range entries {
+			expiresAt, height := expiryFields(e.Expiry)
+			raw = append(raw, jsonEntry{Address: e.Address, ExpiresAt: expiresAt, ExpiresAtHeight: height})
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(raw)
+	default:
+		return fmt.Errorf("unknown file format %s", format)
+	}
+}
+
+// readEntryFile reads a list of addresses and their expiries from a CSV or
+// JSON file.
+func readEntryFile(path, format string) ([]entry, error) {
+	format, err := fileFormat(format, path)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return readEntries(f, format)
+}
+
+// diffEntries returns the entries of target missing from current or with
+// another expiry, which are added again to replace it, and, if prune is set,
+// the addresses of current missing from target. Both lists are sorted by
+// address.
+func diffEntries(current, target []entry, prune bool) (add []entry, remove []string) {
+	inCurrent := make(map[string]*types.Expiry, len(current))
+	for _, e := range current {
+		inCurrent[e.Address] = e.Expiry
+	}
+	inTarget := make(map[string]bool, len(target))
+	for _, e := range target {
+		inTarget[e.Address] = true
+		expiry, found := inCurrent[e.Address]
+		if !found || !equalExpiry(expiry, e.Expiry) {
+			add = append(add, e)
+		}
+	}
+	if prune {
+		for _, e := range current {
+			if !inTarget[e.Address] {
+				remove = append(remove, e.Address)
+			}
+		}
+	}
+
+	sort.Slice(add, func(i, j int) bool { return add[i].Address < add[j].Address })
+	sort.Strings(remove)
+	return add, remove
+}
+
+// expiryGroup is a list of addresses sharing an expiry, which are added by
+// the same messages.
+type expiryGroup struct {
+	expiry *types.Expiry
+	addrs  []string
+}
+
+// groupByExpiry groups entries by expiry, in the order of their first entry.
+func groupByExpiry(entries []entry) []expiryGroup {
+	var groups []expiryGroup
+	for _, e := range entries {
+		i := slices.IndexFunc(groups, func(g expiryGroup) bool { return equalExpiry(g.expiry, e.Expiry) })
+		if i < 0 {
+			groups = append(groups, expiryGroup{expiry: e.Expiry})
+			i = len(groups) - 1
+		}
+		groups[i].addrs = append(groups[i].addrs, e.Address)
+	}
+	return groups
+}
+
+// batches splits a list of addresses into batches of at most size addresses.
+func batches(addrs []string, size int) [][]string {
+	var result [][]string
+	for len(addrs) > size {
+		result = append(result, addrs[:size])
+		addrs = addrs[size:]
+	}
+	if len(addrs) > 0 {
+		result = append(result, addrs)
+	}
+	return result
+}
+
+// gasBatches splits a list of addresses into batches of at most size
+// addresses whose gas, estimated by gasOf, does not exceed maxGas, and
+// returns the gas of each batch. The batches above maxGas are halved until
+// they fit, and maxGas 0 is no limit.
+func gasBatches(addrs []string, size int, maxGas uint64, gasOf func([]string) (uint64, error)) (result [][]string, gas []uint64, err error) {
+	pending := batches(addrs, size)
+	for len(pending) > 0 {
+		batch := pending[0]
+		pending = pending[1:]
+
+		batchGas, err := gasOf(batch)
+		if err != nil {
+			return nil, nil, err
+		}
+		if maxGas == 0 || batchGas <= maxGas {
+			result = append(result, batch)
+			gas = append(gas, batchGas)
+			continue
+		}
+		if len(batch) == 1 {
+			return nil, nil, fmt.Errorf("the transaction of %s takes %d gas, above the limit of %d", batch[0], batchGas, maxGas)
+		}
+		half := (len(batch) + 1) / 2
+		pending = append([][]string{batch[:half], batch[half:]}, pending...)
+	}
+	return result, gas, nil
+}
+
+// queryAllEntries fetches every page of the allowed or admin list, with the
+// expiries of the entries.
+func queryAllEntries(clientCtx client.Context, admins bool) ([]entry, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	var entries []entry
+	pageReq := &query.PageRequest{Limit: queryPageLimit}
+	for {
+		var page []string
+		var expiring []types.ExpiringEntry
+		var pageRes *query.PageResponse
+		if admins {
+			res, err := queryClient.ListAdmins(context.Background(), &types.QueryListAdminsRequest{Pagination: pageReq})
+			if err != nil {
+				return nil, err
+			}
+			page, expiring, pageRes = res.Admins, res.Expiring, res.Pagination
+		} else {
+			res, err := queryClient.ListAllowed(context.Background(), &types.QueryListAllowedRequest{Pagination: pageReq})
+			if err != nil {
+				return nil, err
+			}
+			page, expiring, pageRes = res.Allowed, res.Expiring, res.Pagination
+		}
+
+		expiries := make(map[string]*types.Expiry, len(expiring))
+		for _, e := range expiring {
+			expiries[e.Address] = &e.Expiry
+		}
+		for _, addr := range page {
+			entries = append(entries, entry{Address: addr, Expiry: expiries[addr]})
+		}
+
+		if pageRes == nil || len(pageRes.NextKey) == 0 {
+			return entries, nil
+		}
+		pageReq = &query.PageRequest{Key: pageRes.NextKey, Limit: queryPageLimit}
+	}
+}
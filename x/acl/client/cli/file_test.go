@@ -0,0 +1,164 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sagaxyz/saga-sdk/x/acl/types"
+)
+
+func TestReadEntries(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte{1}).String()
+	addr2 := sdk.AccAddress([]byte{2}).String()
+	expiresAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		format     string
+		input      string
+		expEntries []entry
+		expErr     bool
+	}{
+		{"csv", FormatCSV, addr1 + "\n" + addr2 + "\n", []entry{{Address: addr1}, {Address: addr2}}, false},
+		{"csv with header and columns", FormatCSV, "address,name\n" + addr1 + ",partner\n# comment\n" + addr2 + "\n", []entry{{Address: addr1}, {Address: addr2}}, false},
+		{
+			"csv with expiries", FormatCSV,
+			"address,name,expires_at_height,expires_at\n" + addr1 + ",partner,100,\n" + addr2 + ",,,2030-01-02T03:04:05Z\n",
+			[]entry{{Address: addr1, Expiry: types.NewHeightExpiry(100)}, {Address: addr2, Expiry: types.NewTimeExpiry(expiresAt)}},
+			false,
+		},
+		{"csv expiry columns without header", FormatCSV, addr1 + ",2030-01-02T03:04:05Z\n", []entry{{Address: addr1}}, false},
+		{"csv both expiries", FormatCSV, "address,expires_at,expires_at_height\n" + addr1 + ",2030-01-02T03:04:05Z,100\n", nil, true},
+		{"csv invalid expiry height", FormatCSV, "address,expires_at_height\n" + addr1 + ",soon\n", nil, true},
+		{"csv duplicates", FormatCSV, addr1 + "\n" + addr1 + "\n", []entry{{Address: addr1}}, false},
+		{"csv invalid address", FormatCSV, addr1 + "\ninvalid\n", nil, true},
+		{"json", FormatJSON, `["` + addr1 + `", "` + addr2 + `"]`, []entry{{Address: addr1}, {Address: addr2}}, false},
+		{
+			"json objects", FormatJSON,
+			`[{"address": "` + addr1 + `", "expires_at_height": 100}, {"address": "` + addr2 + `", "expires_at": "2030-01-02T03:04:05Z"}]`,
+			[]entry{{Address: addr1, Expiry: types.NewHeightExpiry(100)}, {Address: addr2, Expiry: types.NewTimeExpiry(expiresAt)}},
+			false,
+		},
+		{"json invalid expiry", FormatJSON, `[{"address": "` + addr1 + `", "expires_at": "soon"}]`, nil, true},
+		{"json empty", FormatJSON, `[]`, []entry{}, false},
+		{"json object", FormatJSON, `{"allowed": []}`, nil, true},
+		{"unknown format", "xml", addr1, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			entries, err := readEntries(strings.NewReader(tc.input), tc.format)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expEntries, entries)
+		})
+	}
+}
+
+func TestWriteEntries(t *testing.T) {
+	entries := []entry{
+		{Address: sdk.AccAddress([]byte{1}).String()},
+		{Address: sdk.AccAddress([]byte{2}).String(), Expiry: types.NewHeightExpiry(100)},
+		{Address: sdk.AccAddress([]byte{3}).String(), Expiry: types.NewTimeExpiry(time.Date(2030, 1, 2, 3, 4, 5, 6, time.UTC))},
+	}
+
+	for _, format := range []string{FormatCSV, FormatJSON} {
+		var buf bytes.Buffer
+		require.NoError(t, writeEntries(&buf, format, entries))
+
+		read, err := readEntries(&buf, format)
+		require.NoError(t, err)
+		require.Equal(t, entries, read)
+	}
+}
+
+func TestFileFormat(t *testing.T) {
+	format, err := fileFormat("", "allowed.CSV")
+	require.NoError(t, err)
+	require.Equal(t, FormatCSV, format)
+
+	format, err = fileFormat(FormatJSON, "allowed.txt")
+	require.NoError(t, err)
+	require.Equal(t, FormatJSON, format)
+
+	_, err = fileFormat("", "allowed")
+	require.Error(t, err)
+	_, err = fileFormat("", "allowed.txt")
+	require.Error(t, err)
+}
+
+func TestDiffEntries(t *testing.T) {
+	current := []entry{{Address: "b"}, {Address: "c"}, {Address: "e", Expiry: types.NewHeightExpiry(100)}}
+	target := []entry{{Address: "d"}, {Address: "a"}, {Address: "b"}, {Address: "e", Expiry: types.NewHeightExpiry(100)}}
+
+	add, remove := diffEntries(current, target, false)
+	require.Equal(t, []entry{{Address: "a"}, {Address: "d"}}, add)
+	require.Empty(t, remove)
+
+	add, remove = diffEntries(current, target, true)
+	require.Equal(t, []entry{{Address: "a"}, {Address: "d"}}, add)
+	require.Equal(t, []string{"c"}, remove)
+
+	// Entries with another expiry are added again
+	target[2].Expiry = types.NewHeightExpiry(200)
+	target[3].Expiry = nil
+	add, _ = diffEntries(current, target, false)
+	require.Equal(t, []entry{{Address: "a"}, {Address: "b", Expiry: types.NewHeightExpiry(200)}, {Address: "d"}, {Address: "e"}}, add)
+}
+
+func TestGroupByExpiry(t *testing.T) {
+	groups := groupByExpiry([]entry{
+		{Address: "a"},
+		{Address: "b", Expiry: types.NewHeightExpiry(100)},
+		{Address: "c"},
+		{Address: "d", Expiry: types.NewHeightExpiry(100)},
+		{Address: "e", Expiry: types.NewHeightExpiry(200)},
+	})
+	require.Equal(t, []expiryGroup{
+		{addrs: []string{"a", "c"}},
+		{expiry: types.NewHeightExpiry(100), addrs: []string{"b", "d"}},
+		{expiry: types.NewHeightExpiry(200), addrs: []string{"e"}},
+	}, groups)
+}
+
+func TestBatches(t *testing.T) {
+	require.Empty(t, batches(nil, 2))
+	require.Equal(t, [][]string{{"a", "b"}, {"c", "d"}, {"e"}}, batches([]string{"a", "b", "c", "d", "e"}, 2))
+	require.Equal(t, [][]string{{"a", "b"}}, batches([]string{"a", "b"}, 2))
+}
+
+func TestGasBatches(t *testing.T) {
+	addrs := []string{"a", "b", "c", "d", "e"}
+	gasOf := func(batch []string) (uint64, error) {
+		return uint64(10 * len(batch)), nil
+	}
+
+	result, gas, err := gasBatches(addrs, 4, 0, gasOf)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"a", "b", "c", "d"}, {"e"}}, result)
+	require.Equal(t, []uint64{40, 10}, gas)
+
+	// Batches above the limit are halved until they fit
+	result, gas, err = gasBatches(addrs, 4, 25, gasOf)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"a", "b"}, {"c", "d"}, {"e"}}, result)
+	require.Equal(t, []uint64{20, 20, 10}, gas)
+
+	result, _, err = gasBatches(addrs, 5, 10, gasOf)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"a"}, {"b"}, {"c"}, {"d"}, {"e"}}, result)
+
+	_, _, err = gasBatches(addrs, 4, 5, gasOf)
+	require.Error(t, err)
+	_, _, err = gasBatches(addrs, 4, 0, func([]string) (uint64, error) { return 0, errors.New("simulation failed") })
+	require.Error(t, err)
+}
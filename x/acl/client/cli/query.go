@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"os"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -23,6 +24,12 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		ListAllowedCmd(),
 		ListAdminsCmd(),
+		IsAllowedCmd(),
+		IsAdminCmd(),
+		AdminMembersCmd(),
+		ListRoleMembersCmd(),
+		HistoryCmd(),
+		ExportCmd(),
 	)
 	return cmd
 }
@@ -40,9 +47,16 @@ func ListAllowedCmd() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			req := &types.QueryListAllowedRequest{}
+			req := &types.QueryListAllowedRequest{
+				Pagination: pageReq,
+			}
 
 			res, err := queryClient.ListAllowed(context.Background(), req)
 			if err != nil {
@@ -54,6 +68,7 @@ func ListAllowedCmd() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "allowed")
 	return cmd
 }
 
@@ -70,9 +85,16 @@ func ListAdminsCmd() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			req := &types.QueryListAdminsRequest{}
+			req := &types.QueryListAdminsRequest{
+				Pagination: pageReq,
+			}
 
 			res, err := queryClient.ListAdmins(context.Background(), req)
 			if err != nil {
@@ -83,6 +105,241 @@ func ListAdminsCmd() *cobra.Command {
 		},
 	}
 
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "admins")
+	return cmd
+}
+
+// IsAllowedCmd queries whether an address is allowed
+func IsAllowedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "is-allowed <address>",
+		Short: "Checks if an address is allowed",
+		Long:  "Checks if an address is allowed. Every address is allowed when the ACL is disabled.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryIsAllowedRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.IsAllowed(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// IsAdminCmd queries whether an address is an admin
+func IsAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "is-admin <address>",
+		Short: "Checks if an address is an admin",
+		Long:  "Checks if an address is an admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryIsAdminRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.IsAdmin(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// AdminMembersCmd queries the accounts behind a group policy or multisig admin
+func AdminMembersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin-members <address>",
+		Short: "Shows the accounts behind an admin",
+		Long:  "Shows whether an admin is a single account, an x/group policy or a multisig, and the members and threshold of the latter two",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAdminMembersRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.AdminMembers(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// ListRoleMembersCmd queries the addresses holding a role
+func ListRoleMembersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-role-members <role>",
+		Short: "Gets the addresses holding a role",
+		Long:  "Gets the addresses holding a role",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryListRoleMembersRequest{
+				Role: args[0],
+			}
+
+			res, err := queryClient.ListRoleMembers(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// HistoryCmd queries the past ACL changes affecting an address
+func HistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history <address>",
+		Short: "Gets the past ACL changes affecting an address",
+		Long:  "Gets the past ACL changes affecting an address, with the sender and block of each change.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryHistoryRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.History(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "history")
+	return cmd
+}
+
+// ExportCmd exports the allowed or admin list to a file
+func ExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [file]",
+		Short: "Exports the allowed list to a CSV or JSON file",
+		Long: `Exports the allowed list, or the admins with --admins, to a CSV or JSON file,
+or to the standard output if no file is given. The format is taken from the file
+extension unless --format is set, and defaults to CSV on the standard output.
+The expiries of the entries are exported with them, and exported allowed lists
+can be imported with the import-allowed command.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			format, err := cmd.Flags().GetString(FlagFormat)
+			if err != nil {
+				return err
+			}
+			admins, err := cmd.Flags().GetBool(FlagAdmins)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if len(args) == 0 {
+				if format == "" {
+					format = FormatCSV
+				}
+			} else {
+				format, err = fileFormat(format, args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			entries, err := queryAllEntries(clientCtx, admins)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 0 {
+				return writeEntries(out, format, entries)
+			}
+
+			f, err := os.Create(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			err = writeEntries(f, format, entries)
+			if err != nil {
+				return err
+			}
+			return f.Close()
+		},
+	}
+
+	cmd.Flags().String(FlagFormat, "", "File format, csv or json, instead of the file extension")
+	cmd.Flags().Bool(FlagAdmins, false, "Export the admins instead of the allowed list")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
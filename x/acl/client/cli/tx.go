@@ -1,14 +1,36 @@
 package cli
 
 import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
 	"github.com/spf13/cobra"
 
 	"github.com/sagaxyz/saga-sdk/x/acl/types"
 )
 
+const (
+	FlagExpiresAt       = "expires-at"
+	FlagExpiresAtHeight = "expires-at-height"
+	FlagReplace         = "replace"
+	FlagFormat          = "format"
+	FlagPrune           = "prune"
+	FlagBatchSize       = "batch-size"
+	FlagAdmins          = "admins"
+
+	// DefaultBatchSize is the default number of addresses per transaction
+	DefaultBatchSize = 100
+)
+
 // NewTxCmd returns a root CLI command handler for acl transaction commands
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -22,8 +44,14 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewAddAllowedCmd(),
 		NewRemoveAllowedCmd(),
+		NewImportAllowedCmd(),
 		NewAddAdminsCmd(),
 		NewRemoveAdminsCmd(),
+		NewGrantRoleCmd(),
+		NewRevokeRoleCmd(),
+		NewProposeAdminCmd(),
+		NewAcceptAdminCmd(),
+		NewCancelAdminProposalCmd(),
 	)
 	return txCmd
 }
@@ -40,6 +68,10 @@ func NewAddAllowedCmd() *cobra.Command {
 			}
 
 			msg := types.NewMsgAddAllowed(cliCtx.GetFromAddress().String(), args...)
+			msg.Expiry, err = expiryFromFlags(cmd)
+			if err != nil {
+				return err
+			}
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -49,6 +81,7 @@ func NewAddAllowedCmd() *cobra.Command {
 		},
 	}
 
+	addExpiryFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -78,6 +111,137 @@ func NewRemoveAllowedCmd() *cobra.Command {
 	return cmd
 }
 
+// NewImportAllowedCmd returns a CLI command handler for adding the allowed
+// addresses of a file
+func NewImportAllowedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-allowed <file>",
+		Short: "Adds the addresses of a CSV or JSON file to the allowed list",
+		Long: `Adds the addresses of a CSV or JSON file missing from the allowed list, or
+listed with another expiry. With --prune, the allowed addresses missing from the
+file are removed as well.
+
+The changes are split into transactions of at most --batch-size addresses,
+which are split further until their simulated gas fits in the block gas limit,
+or in --gas if it is set. The transactions are sent one after the other, and
+the import stops at the first one rejected. With --dry-run, the changes are
+printed without sending any transaction.
+
+CSV files have the address in the first column and may start with an "address"
+header, naming the optional "expires_at" and "expires_at_height" columns. JSON
+files contain an array of addresses, or of objects with an "address" and the
+optional "expires_at" and "expires_at_height" fields, as written by the export
+query. The --expires-at and --expires-at-height flags apply to the addresses
+without expiry in the file. The format is taken from the file extension unless
+--format is set.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			format, err := cmd.Flags().GetString(FlagFormat)
+			if err != nil {
+				return err
+			}
+			prune, err := cmd.Flags().GetBool(FlagPrune)
+			if err != nil {
+				return err
+			}
+			batchSize, err := cmd.Flags().GetInt(FlagBatchSize)
+			if err != nil {
+				return err
+			}
+			if batchSize <= 0 {
+				return fmt.Errorf("invalid batch size %d", batchSize)
+			}
+			expiry, err := expiryFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			entries, err := readEntryFile(args[0], format)
+			if err != nil {
+				return err
+			}
+			for i := range entries {
+				if entries[i].Expiry == nil {
+					entries[i].Expiry = expiry
+				}
+			}
+			current, err := queryAllEntries(cliCtx, false)
+			if err != nil {
+				return err
+			}
+			add, remove := diffEntries(current, entries, prune)
+
+			out := cmd.OutOrStdout()
+			if cliCtx.Simulate {
+				for _, e := range add {
+					fmt.Fprintf(out, "+ %s\n", e.Address)
+				}
+				for _, addr := range remove {
+					fmt.Fprintf(out, "- %s\n", addr)
+				}
+			}
+			if len(add) == 0 && len(remove) == 0 {
+				fmt.Fprintln(out, "allowed list already up to date")
+				return nil
+			}
+
+			txf, err := tx.NewFactoryCLI(cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf, err = txf.Prepare(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			gasLimit, err := importGasLimit(cliCtx, txf)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress().String()
+			var txs []importTx
+			for _, group := range groupByExpiry(add) {
+				groupTxs, err := importTxs(cliCtx, txf, group.addrs, batchSize, gasLimit, func(batch []string) sdk.Msg {
+					msg := types.NewMsgAddAllowed(sender, batch...)
+					msg.Expiry = group.expiry
+					return msg
+				})
+				if err != nil {
+					return err
+				}
+				txs = append(txs, groupTxs...)
+			}
+			removeTxs, err := importTxs(cliCtx, txf, remove, batchSize, gasLimit, func(batch []string) sdk.Msg {
+				return types.NewMsgRemoveAllowed(sender, batch...)
+			})
+			if err != nil {
+				return err
+			}
+			txs = append(txs, removeTxs...)
+
+			if cliCtx.Simulate {
+				fmt.Fprintf(out, "%d to add, %d to remove in %d transactions\n", len(add), len(remove), len(txs))
+				return nil
+			}
+
+			return broadcastImportTxs(cliCtx, txf, txs)
+		},
+	}
+
+	cmd.Flags().String(FlagFormat, "", "File format, csv or json, instead of the file extension")
+	cmd.Flags().Bool(FlagPrune, false, "Remove the allowed addresses missing from the file")
+	cmd.Flags().Int(FlagBatchSize, DefaultBatchSize, "Maximum number of addresses per transaction")
+	addExpiryFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewAddAdminsCmd returns a CLI command handler for adding admin addresses
 func NewAddAdminsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -90,6 +254,10 @@ func NewAddAdminsCmd() *cobra.Command {
 			}
 
 			msg := types.NewMsgAddAdmins(cliCtx.GetFromAddress().String(), args...)
+			msg.Expiry, err = expiryFromFlags(cmd)
+			if err != nil {
+				return err
+			}
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -99,6 +267,7 @@ func NewAddAdminsCmd() *cobra.Command {
 		},
 	}
 
+	addExpiryFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -127,3 +296,294 @@ func NewRemoveAdminsCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewGrantRoleCmd returns a CLI command handler for granting a role
+func NewGrantRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role <role> <space-separated list of addresses>",
+		Short: "Grants a role to addresses",
+		Long:  "Grants a role to addresses. Built-in roles are acl-manager, admin-manager, metadata-setter and deployer.",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantRole(cliCtx.GetFromAddress().String(), args[0], args[1:]...)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRevokeRoleCmd returns a CLI command handler for revoking a role
+func NewRevokeRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role <role> <space-separated list of addresses>",
+		Short: "Revokes a role from addresses",
+		Long:  "Revokes a role from addresses. Built-in roles are acl-manager, admin-manager, metadata-setter and deployer.",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeRole(cliCtx.GetFromAddress().String(), args[0], args[1:]...)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewProposeAdminCmd returns a CLI command handler for proposing an admin
+func NewProposeAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-admin <address>",
+		Short: "Proposes an address as admin",
+		Long:  "Proposes an address as admin, which it becomes once it accepts. With --replace, the sender is removed from the admins on acceptance.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			replace, err := cmd.Flags().GetBool(FlagReplace)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgProposeAdmin(cliCtx.GetFromAddress().String(), args[0], replace)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagReplace, false, "Remove the sender from the admins once the proposal is accepted")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewAcceptAdminCmd returns a CLI command handler for accepting an admin
+// proposal
+func NewAcceptAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-admin",
+		Short: "Accepts the admin proposal of the sender",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptAdmin(cliCtx.GetFromAddress().String())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelAdminProposalCmd returns a CLI command handler for canceling an
+// admin proposal
+func NewCancelAdminProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-admin-proposal <address>",
+		Short: "Cancels the admin proposal of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelAdminProposal(cliCtx.GetFromAddress().String(), args[0])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// importTx is a transaction of an import and its simulated gas, zero if it
+// was not simulated.
+type importTx struct {
+	msg sdk.Msg
+	gas uint64
+}
+
+// importGasLimit returns the gas limit of the import transactions: the block
+// gas limit if the gas is simulated, or --gas, which cannot be above the block
+// gas limit. It is zero for no limit, or if the transactions are only
+// generated.
+func importGasLimit(cliCtx client.Context, txf tx.Factory) (uint64, error) {
+	if cliCtx.GenerateOnly || cliCtx.Offline {
+		return 0, nil
+	}
+
+	res, err := consensustypes.NewQueryClient(cliCtx).Params(context.Background(), &consensustypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, err
+	}
+	maxGas := int64(-1)
+	if res.Params != nil && res.Params.Block != nil {
+		maxGas = res.Params.Block.MaxGas
+	}
+
+	if txf.SimulateAndExecute() {
+		if maxGas < 0 {
+			return 0, nil
+		}
+		return uint64(maxGas), nil
+	}
+	if maxGas >= 0 && txf.Gas() > uint64(maxGas) {
+		return 0, fmt.Errorf("gas %d is above the block gas limit of %d", txf.Gas(), maxGas)
+	}
+	return txf.Gas(), nil
+}
+
+// importTxs splits the addresses into the messages built by newMsg, with at
+// most size addresses each and a simulated gas not above gasLimit. The
+// messages are not simulated if the transactions are only generated.
+func importTxs(cliCtx client.Context, txf tx.Factory, addrs []string, size int, gasLimit uint64, newMsg func([]string) sdk.Msg) ([]importTx, error) {
+	if cliCtx.GenerateOnly || cliCtx.Offline {
+		var txs []importTx
+		for _, batch := range batches(addrs, size) {
+			txs = append(txs, importTx{msg: newMsg(batch)})
+		}
+		return txs, nil
+	}
+
+	gasOf := func(batch []string) (uint64, error) {
+		_, gas, err := tx.CalculateGas(cliCtx, txf, newMsg(batch))
+		return gas, err
+	}
+	batched, gas, err := gasBatches(addrs, size, gasLimit, gasOf)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]importTx, len(batched))
+	for i, batch := range batched {
+		txs[i] = importTx{msg: newMsg(batch), gas: gas[i]}
+	}
+	return txs, nil
+}
+
+// broadcastImportTxs signs and broadcasts the import transactions one after
+// the other, stopping at the first one rejected, or generates them with
+// --generate-only.
+func broadcastImportTxs(cliCtx client.Context, txf tx.Factory, txs []importTx) error {
+	if cliCtx.GenerateOnly {
+		for _, itx := range txs {
+			err := tx.GenerateOrBroadcastTxWithFactory(cliCtx, txf, itx.msg)
+			if err != nil {
+				return err
+			}
+			txf = txf.WithSequence(txf.Sequence() + 1)
+		}
+		return nil
+	}
+
+	if !cliCtx.SkipConfirm {
+		ok, err := input.GetConfirmation(fmt.Sprintf("confirm %d transactions before signing and broadcasting", len(txs)), bufio.NewReader(os.Stdin), os.Stderr)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			_, _ = fmt.Fprintln(os.Stderr, "canceled transactions")
+			return nil
+		}
+	}
+
+	for i, itx := range txs {
+		if txf.SimulateAndExecute() {
+			txf = txf.WithGas(itx.gas)
+		}
+		builder, err := txf.BuildUnsignedTx(itx.msg)
+		if err != nil {
+			return err
+		}
+		err = tx.Sign(cliCtx.CmdContext, txf, cliCtx.FromName, builder, true)
+		if err != nil {
+			return err
+		}
+		txBytes, err := cliCtx.TxConfig.TxEncoder()(builder.GetTx())
+		if err != nil {
+			return err
+		}
+
+		res, err := cliCtx.BroadcastTx(txBytes)
+		if err != nil {
+			return err
+		}
+		err = cliCtx.PrintProto(res)
+		if err != nil {
+			return err
+		}
+		if res.Code != 0 {
+			return fmt.Errorf("transaction %d of %d rejected with code %d: %s", i+1, len(txs), res.Code, res.RawLog)
+		}
+		txf = txf.WithSequence(txf.Sequence() + 1)
+	}
+
+	return nil
+}
+
+// addExpiryFlags adds the flags setting the expiry of added entries
+func addExpiryFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagExpiresAt, "", "Time at which the entries expire, in RFC3339 format")
+	cmd.Flags().Int64(FlagExpiresAtHeight, 0, "Block height at which the entries expire")
+}
+
+// expiryFromFlags returns the expiry set by the flags, or nil for permanent
+// entries
+func expiryFromFlags(cmd *cobra.Command) (*types.Expiry, error) {
+	expiresAt, err := cmd.Flags().GetString(FlagExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	height, err := cmd.Flags().GetInt64(FlagExpiresAtHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	if expiresAt != "" && height != 0 {
+		return nil, errors.New("only one of --expires-at and --expires-at-height can be set")
+	}
+
+	return newExpiry(expiresAt, height)
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlock prunes the admin and allowed entries and the admin proposals that
+// expired, and the change records past the history retention.
+func (k Keeper) EndBlock(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.PruneExpiredEntries(ctx); err != nil {
+		return err
+	}
+	if err := k.PruneExpiredAdminProposals(ctx); err != nil {
+		return err
+	}
+	k.PruneHistory(ctx)
+
+	return nil
+}
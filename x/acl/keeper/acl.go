@@ -1,61 +1,179 @@
 package keeper
 
 import (
+	"bytes"
+
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/sagaxyz/saga-sdk/x/acl/types"
 )
 
+// permanentEntry is the value of the entries without expiry.
+var permanentEntry = []byte{0}
+
 func (k Keeper) SetAllowed(ctx sdk.Context, addr sdk.AccAddress) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAllowed)
-	store.Set(addr.Bytes(), []byte{0}) //TODO empty struct
+	k.setEntry(ctx, types.KeyPrefixAllowed, addr, nil)
+}
+
+// SetAllowedUntil allows an address until the expiry.
+func (k Keeper) SetAllowedUntil(ctx sdk.Context, addr sdk.AccAddress, expiry types.Expiry) {
+	k.setEntry(ctx, types.KeyPrefixAllowed, addr, &expiry)
+}
+
+// DeleteAllowed removes an address from the allowed list.
+func (k Keeper) DeleteAllowed(ctx sdk.Context, addr sdk.AccAddress) {
+	k.deleteEntry(ctx, types.KeyPrefixAllowed, addr)
 }
 
 func (k Keeper) Allowed(ctx sdk.Context, addr sdk.AccAddress) bool {
-	var enabled bool
-	k.paramSpace.Get(ctx, types.ParamStoreKeyEnable, &enabled)
-	if !enabled {
+	if !k.Enabled(ctx) {
 		return true
 	}
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAllowed)
-	return store.Has(addr.Bytes())
+	return k.hasEntry(ctx, types.KeyPrefixAllowed, addr) || k.HasRole(ctx, addr, types.RoleDeployer)
 }
 
 func (k Keeper) ExportAllowed(ctx sdk.Context) (addresses []string) {
-	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAllowed).Iterator(nil, nil)
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		addr := sdk.AccAddress(iterator.Key())
-		addresses = append(addresses, addr.String())
-	}
+	addresses, _ = k.exportEntries(ctx, types.KeyPrefixAllowed)
 	return addresses
 }
 
 func (k Keeper) SetAdmin(ctx sdk.Context, addr sdk.AccAddress) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAdmins)
-	store.Set(addr.Bytes(), []byte{0}) //TODO
+	k.setEntry(ctx, types.KeyPrefixAdmins, addr, nil)
+}
+
+// SetAdminUntil makes an address admin until the expiry.
+func (k Keeper) SetAdminUntil(ctx sdk.Context, addr sdk.AccAddress, expiry types.Expiry) {
+	k.setEntry(ctx, types.KeyPrefixAdmins, addr, &expiry)
+}
+
+// DeleteAdmin removes an address from the admins.
+func (k Keeper) DeleteAdmin(ctx sdk.Context, addr sdk.AccAddress) {
+	k.deleteEntry(ctx, types.KeyPrefixAdmins, addr)
 }
 
 func (k Keeper) IsAdmin(ctx sdk.Context, addr sdk.AccAddress) bool {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAdmins)
-	return store.Has(addr.Bytes())
+	return k.hasEntry(ctx, types.KeyPrefixAdmins, addr)
 }
 
 func (k Keeper) ExportAdmins(ctx sdk.Context) (addresses []string) {
-	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAdmins).Iterator(nil, nil)
+	addresses, _ = k.exportEntries(ctx, types.KeyPrefixAdmins)
+	return addresses
+}
+
+func (k Keeper) Enabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).Enable
+}
+
+// setEntry adds an address to the admin or allowed list, replacing its
+// previous expiry. The expiry is stored as the value of the entry and indexed
+// to be pruned once reached.
+func (k Keeper) setEntry(ctx sdk.Context, list []byte, addr sdk.AccAddress, expiry *types.Expiry) {
+	k.deleteEntry(ctx, list, addr)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), list)
+	if expiry == nil {
+		store.Set(addr.Bytes(), permanentEntry)
+		return
+	}
+	store.Set(addr.Bytes(), k.cdc.MustMarshal(expiry))
+	ctx.KVStore(k.storeKey).Set(types.ExpiryQueueKey(*expiry, list, addr), []byte{0})
+}
+
+// getEntry returns the expiry of an entry of the admin or allowed list, which
+// is nil for permanent entries.
+func (k Keeper) getEntry(ctx sdk.Context, list []byte, addr sdk.AccAddress) (expiry *types.Expiry, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), list)
+
+	bz := store.Get(addr.Bytes())
+	if bz == nil {
+		return nil, false
+	}
+
+	return k.unmarshalEntry(bz), true
+}
+
+// unmarshalEntry returns the expiry stored as the value of an entry, which is
+// nil for permanent entries.
+func (k Keeper) unmarshalEntry(bz []byte) *types.Expiry {
+	if bytes.Equal(bz, permanentEntry) {
+		return nil
+	}
+
+	var expiry types.Expiry
+	k.cdc.MustUnmarshal(bz, &expiry)
+	return &expiry
+}
+
+// hasEntry returns true if the address is in the admin or allowed list and
+// its entry has not expired.
+func (k Keeper) hasEntry(ctx sdk.Context, list []byte, addr sdk.AccAddress) bool {
+	expiry, found := k.getEntry(ctx, list, addr)
+	return found && (expiry == nil || !expiry.Expired(ctx))
+}
+
+// deleteEntry removes an address and its expiry from the admin or allowed list.
+func (k Keeper) deleteEntry(ctx sdk.Context, list []byte, addr sdk.AccAddress) {
+	expiry, found := k.getEntry(ctx, list, addr)
+	if !found {
+		return
+	}
+	if expiry != nil {
+		ctx.KVStore(k.storeKey).Delete(types.ExpiryQueueKey(*expiry, list, addr))
+	}
+
+	prefix.NewStore(ctx.KVStore(k.storeKey), list).Delete(addr.Bytes())
+}
+
+// exportEntries returns the unexpired addresses of the admin or allowed list
+// and the expiries of those that have one.
+func (k Keeper) exportEntries(ctx sdk.Context, list []byte) (addresses []string, expiring []types.ExpiringEntry) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), list).Iterator(nil, nil)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
 		addr := sdk.AccAddress(iterator.Key())
+		expiry := k.unmarshalEntry(iterator.Value())
+		if expiry == nil {
+			addresses = append(addresses, addr.String())
+			continue
+		}
+		if expiry.Expired(ctx) {
+			continue
+		}
 		addresses = append(addresses, addr.String())
+		expiring = append(expiring, types.ExpiringEntry{
+			Address: addr.String(),
+			Expiry:  *expiry,
+		})
 	}
-	return addresses
+	return addresses, expiring
 }
 
-func (k Keeper) Enabled(ctx sdk.Context) (enable bool) {
-	k.paramSpace.Get(ctx, types.ParamStoreKeyEnable, &enable)
-	return
+// paginateEntries returns a page of the unexpired addresses of the admin or
+// allowed list, and the expiries of the ones that have one.
+func (k Keeper) paginateEntries(ctx sdk.Context, list []byte, pageReq *query.PageRequest) (addresses []string, expiring []types.ExpiringEntry, pageRes *query.PageResponse, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), list)
+
+	pageRes, err = query.FilteredPaginate(store, pageReq, func(key, value []byte, accumulate bool) (bool, error) {
+		expiry := k.unmarshalEntry(value)
+		if expiry != nil && expiry.Expired(ctx) {
+			return false, nil
+		}
+
+		if accumulate {
+			addr := sdk.AccAddress(key).String()
+			addresses = append(addresses, addr)
+			if expiry != nil {
+				expiring = append(expiring, types.ExpiringEntry{
+					Address: addr,
+					Expiry:  *expiry,
+				})
+			}
+		}
+		return true, nil
+	})
+	return addresses, expiring, pageRes, err
 }
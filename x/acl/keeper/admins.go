@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/saga-sdk/x/acl/types"
+)
+
+// SetAdminProposal stores an admin proposal pending acceptance, replacing any
+// previous proposal of the same address.
+func (k Keeper) SetAdminProposal(ctx sdk.Context, proposal types.AdminProposal) error {
+	addr, err := sdk.AccAddressFromBech32(proposal.Admin)
+	if err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAdminProposals)
+	store.Set(addr.Bytes(), k.cdc.MustMarshal(&proposal))
+	return nil
+}
+
+// GetAdminProposal returns the pending admin proposal of an address.
+func (k Keeper) GetAdminProposal(ctx sdk.Context, addr sdk.AccAddress) (proposal types.AdminProposal, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAdminProposals)
+
+	bz := store.Get(addr.Bytes())
+	if bz == nil {
+		return proposal, false
+	}
+	k.cdc.MustUnmarshal(bz, &proposal)
+
+	return proposal, true
+}
+
+// DeleteAdminProposal removes the pending admin proposal of an address.
+func (k Keeper) DeleteAdminProposal(ctx sdk.Context, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAdminProposals)
+	store.Delete(addr.Bytes())
+}
+
+// ExportAdminProposals returns all the pending admin proposals.
+func (k Keeper) ExportAdminProposals(ctx sdk.Context) (proposals []types.AdminProposal) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAdminProposals).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.AdminProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &proposal)
+		proposals = append(proposals, proposal)
+	}
+	return proposals
+}
+
+// PruneExpiredAdminProposals removes the admin proposals that expired. The
+// proposals are few, being made by admins and admin managers, and are all
+// walked.
+func (k Keeper) PruneExpiredAdminProposals(ctx sdk.Context) error {
+	for _, proposal := range k.ExportAdminProposals(ctx) {
+		if !proposal.Expiry.Expired(ctx) {
+			continue
+		}
+
+		k.DeleteAdminProposal(ctx, sdk.MustAccAddressFromBech32(proposal.Admin))
+		err := ctx.EventManager().EmitTypedEvent(&types.EventCanceledAdminProposal{
+			Admin: proposal.Admin,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// countPermanentAdmins returns the number of admins without expiry, which
+// are the ones protecting against a lockout.
+func (k Keeper) countPermanentAdmins(ctx sdk.Context) (count uint32) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAdmins).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if bytes.Equal(iterator.Value(), permanentEntry) {
+			count++
+		}
+	}
+	return count
+}
+
+// hasAdmins returns true if there are admins, with or without expiry.
+func (k Keeper) hasAdmins(ctx sdk.Context) bool {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAdmins).Iterator(nil, nil)
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// checkAdminLockout checks that an admin change did not lower the number of
+// admins without expiry below the minimum, nor remove the last one, nor left
+// only admins with expiry. The minimum is the one of the min-admins invariant.
+func (k Keeper) checkAdminLockout(ctx sdk.Context, before uint32) error {
+	after := k.countPermanentAdmins(ctx)
+	if after >= before && (after > 0 || !k.hasAdmins(ctx)) {
+		return nil
+	}
+
+	minAdmins := k.GetParams(ctx).MinPermanentAdmins()
+	if after < minAdmins {
+		return fmt.Errorf("%w: %d admins without expiry would remain, the minimum is %d", ErrAdminLockout, after, minAdmins)
+	}
+
+	return nil
+}
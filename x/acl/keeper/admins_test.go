@@ -0,0 +1,361 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/saga-sdk/x/acl/keeper"
+	"github.com/sagaxyz/saga-sdk/x/acl/types"
+)
+
+func (suite *TestSuite) TestAdminLockout() {
+	addr1 := sdk.AccAddress([]byte{111})
+	addr2 := sdk.AccAddress([]byte{222})
+
+	testCases := []struct {
+		name      string
+		minAdmins uint32
+		malleate  func() error
+		expErr    bool
+	}{
+		{
+			"remove the last admin",
+			0,
+			func() error {
+				_, err := suite.aclKeeper.RemoveAdmins(suite.ctx, &types.MsgRemoveAdmins{
+					Sender: suite.adminAddress.String(),
+					Admins: []string{suite.adminAddress.String()},
+				})
+				return err
+			},
+			true,
+		},
+		{
+			"remove every admin",
+			0,
+			func() error {
+				suite.aclKeeper.SetAdmin(suite.ctx, addr1)
+				suite.aclKeeper.SetRoleMember(suite.ctx, types.RoleAdminManager, addr1)
+				_, err := suite.aclKeeper.RemoveAdmins(suite.ctx, &types.MsgRemoveAdmins{
+					Sender: addr1.String(),
+					Admins: []string{suite.adminAddress.String(), addr1.String()},
+				})
+				return err
+			},
+			true,
+		},
+		{
+			"remove an admin above the minimum",
+			2,
+			func() error {
+				suite.aclKeeper.SetAdmin(suite.ctx, addr1)
+				suite.aclKeeper.SetAdmin(suite.ctx, addr2)
+				suite.aclKeeper.SetRoleMember(suite.ctx, types.RoleAdminManager, addr1)
+				_, err := suite.aclKeeper.RemoveAdmins(suite.ctx, &types.MsgRemoveAdmins{
+					Sender: addr1.String(),
+					Admins: []string{addr1.String()},
+				})
+				return err
+			},
+			false,
+		},
+		{
+			"remove an admin below the minimum",
+			2,
+			func() error {
+				suite.aclKeeper.SetAdmin(suite.ctx, addr1)
+				suite.aclKeeper.SetRoleMember(suite.ctx, types.RoleAdminManager, addr1)
+				_, err := suite.aclKeeper.RemoveAdmins(suite.ctx, &types.MsgRemoveAdmins{
+					Sender: addr1.String(),
+					Admins: []string{addr1.String()},
+				})
+				return err
+			},
+			true,
+		},
+		{
+			"remove an expiring admin",
+			1,
+			func() error {
+				suite.aclKeeper.SetAdminUntil(suite.ctx, addr1, *types.NewHeightExpiry(100))
+				_, err := suite.aclKeeper.RemoveAdmins(suite.ctx, &types.MsgRemoveAdmins{
+					Sender: suite.adminAddress.String(),
+					Admins: []string{addr1.String()},
+				})
+				return err
+			},
+			false,
+		},
+		{
+			"expire the last admin",
+			0,
+			func() error {
+				_, err := suite.aclKeeper.AddAdmins(suite.ctx, &types.MsgAddAdmins{
+					Sender: suite.adminAddress.String(),
+					Admins: []string{suite.adminAddress.String()},
+					Expiry: types.NewHeightExpiry(100),
+				})
+				return err
+			},
+			true,
+		},
+		{
+			"add only admins with expiry",
+			0,
+			func() error {
+				suite.aclKeeper.DeleteAdmin(suite.ctx, suite.adminAddress)
+				_, err := suite.aclKeeper.AddAdmins(suite.ctx, &types.MsgAddAdmins{
+					Sender: suite.aclKeeper.GetAuthority(),
+					Admins: []string{addr1.String()},
+					Expiry: types.NewHeightExpiry(100),
+				})
+				return err
+			},
+			true,
+		},
+		{
+			"authority removes the last admin",
+			0,
+			func() error {
+				_, err := suite.aclKeeper.RemoveAdmins(suite.ctx, &types.MsgRemoveAdmins{
+					Sender: suite.aclKeeper.GetAuthority(),
+					Admins: []string{suite.adminAddress.String()},
+				})
+				return err
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			params := suite.aclKeeper.GetParams(suite.ctx)
+			params.MinAdmins = tc.minAdmins
+			suite.aclKeeper.SetParams(suite.ctx, params)
+
+			err := tc.malleate()
+			if tc.expErr {
+				suite.Require().ErrorIs(err, keeper.ErrAdminLockout)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *TestSuite) TestAdminHandover() {
+	suite.SetupTest()
+	addr1 := sdk.AccAddress([]byte{111})
+	addr2 := sdk.AccAddress([]byte{222})
+
+	params := suite.aclKeeper.GetParams(suite.ctx)
+	params.AdminHandover = true
+	suite.aclKeeper.SetParams(suite.ctx, params)
+
+	// Admins cannot be added directly, except by the authority
+	_, err := suite.aclKeeper.AddAdmins(suite.ctx, &types.MsgAddAdmins{
+		Sender: suite.adminAddress.String(),
+		Admins: []string{addr1.String()},
+	})
+	suite.Require().Error(err)
+	_, err = suite.aclKeeper.AddAdmins(suite.ctx, &types.MsgAddAdmins{
+		Sender: suite.aclKeeper.GetAuthority(),
+		Admins: []string{addr2.String()},
+	})
+	suite.Require().NoError(err)
+
+	suite.Run("accept", func() {
+		_, err := suite.aclKeeper.AcceptAdmin(suite.ctx, &types.MsgAcceptAdmin{Sender: addr1.String()})
+		suite.Require().Error(err)
+
+		_, err = suite.aclKeeper.ProposeAdmin(suite.ctx, &types.MsgProposeAdmin{
+			Sender: suite.adminAddress.String(),
+			Admin:  addr1.String(),
+		})
+		suite.Require().NoError(err)
+		suite.Require().False(suite.aclKeeper.IsAdmin(suite.ctx, addr1))
+
+		_, err = suite.aclKeeper.AcceptAdmin(suite.ctx, &types.MsgAcceptAdmin{Sender: addr1.String()})
+		suite.Require().NoError(err)
+		suite.Require().True(suite.aclKeeper.IsAdmin(suite.ctx, addr1))
+		suite.Require().True(suite.aclKeeper.IsAdmin(suite.ctx, suite.adminAddress))
+
+		_, found := suite.aclKeeper.GetAdminProposal(suite.ctx, addr1)
+		suite.Require().False(found)
+	})
+	suite.Run("replace", func() {
+		addr3 := sdk.AccAddress([]byte{233})
+
+		// Only admins can be replaced
+		_, err := suite.aclKeeper.ProposeAdmin(suite.ctx, &types.MsgProposeAdmin{
+			Sender:  suite.aclKeeper.GetAuthority(),
+			Admin:   addr3.String(),
+			Replace: true,
+		})
+		suite.Require().Error(err)
+
+		_, err = suite.aclKeeper.ProposeAdmin(suite.ctx, &types.MsgProposeAdmin{
+			Sender:  addr1.String(),
+			Admin:   addr3.String(),
+			Replace: true,
+		})
+		suite.Require().NoError(err)
+		_, err = suite.aclKeeper.AcceptAdmin(suite.ctx, &types.MsgAcceptAdmin{Sender: addr3.String()})
+		suite.Require().NoError(err)
+		suite.Require().True(suite.aclKeeper.IsAdmin(suite.ctx, addr3))
+		suite.Require().False(suite.aclKeeper.IsAdmin(suite.ctx, addr1))
+	})
+	suite.Run("replace expiring admin", func() {
+		addr7 := sdk.AccAddress([]byte{177})
+		addr8 := sdk.AccAddress([]byte{188})
+		expiry := types.NewHeightExpiry(suite.ctx.BlockHeight() + 10)
+
+		_, err := suite.aclKeeper.AddAdmins(suite.ctx, &types.MsgAddAdmins{
+			Sender: suite.aclKeeper.GetAuthority(),
+			Admins: []string{addr7.String()},
+			Expiry: expiry,
+		})
+		suite.Require().NoError(err)
+		_, err = suite.aclKeeper.ProposeAdmin(suite.ctx, &types.MsgProposeAdmin{
+			Sender:  addr7.String(),
+			Admin:   addr8.String(),
+			Replace: true,
+		})
+		suite.Require().NoError(err)
+		_, err = suite.aclKeeper.AcceptAdmin(suite.ctx, &types.MsgAcceptAdmin{Sender: addr8.String()})
+		suite.Require().NoError(err)
+		suite.Require().False(suite.aclKeeper.IsAdmin(suite.ctx, addr7))
+
+		// The new admin expires with the replaced one
+		genesis := suite.aclKeeper.ExportGenesis(suite.ctx)
+		suite.Require().Contains(genesis.ExpiringAdmins, types.ExpiringEntry{Address: addr8.String(), Expiry: *expiry})
+		suite.Require().True(suite.aclKeeper.IsAdmin(suite.ctx, addr8))
+		suite.Require().False(suite.aclKeeper.IsAdmin(suite.ctx.WithBlockHeight(expiry.Height), addr8))
+	})
+	suite.Run("cancel", func() {
+		addr4 := sdk.AccAddress([]byte{244})
+
+		_, err := suite.aclKeeper.ProposeAdmin(suite.ctx, &types.MsgProposeAdmin{
+			Sender: suite.adminAddress.String(),
+			Admin:  addr4.String(),
+		})
+		suite.Require().NoError(err)
+
+		_, err = suite.aclKeeper.CancelAdminProposal(suite.ctx, &types.MsgCancelAdminProposal{
+			Sender: addr4.String(),
+			Admin:  addr4.String(),
+		})
+		suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+
+		genesis := suite.aclKeeper.ExportGenesis(suite.ctx)
+		suite.Require().Equal([]types.AdminProposal{{
+			Admin:    addr4.String(),
+			Proposer: suite.adminAddress.String(),
+			Expiry:   *types.NewTimeExpiry(suite.ctx.BlockTime().Add(types.AdminProposalPeriod)),
+		}}, genesis.AdminProposals)
+
+		_, err = suite.aclKeeper.CancelAdminProposal(suite.ctx, &types.MsgCancelAdminProposal{
+			Sender: suite.adminAddress.String(),
+			Admin:  addr4.String(),
+		})
+		suite.Require().NoError(err)
+
+		_, err = suite.aclKeeper.AcceptAdmin(suite.ctx, &types.MsgAcceptAdmin{Sender: addr4.String()})
+		suite.Require().Error(err)
+		suite.Require().False(suite.aclKeeper.IsAdmin(suite.ctx, addr4))
+	})
+	suite.Run("proposer no longer authorized", func() {
+		addr5 := sdk.AccAddress([]byte{245})
+		addr6 := sdk.AccAddress([]byte{246})
+
+		_, err := suite.aclKeeper.ProposeAdmin(suite.ctx, &types.MsgProposeAdmin{
+			Sender: suite.adminAddress.String(),
+			Admin:  addr5.String(),
+		})
+		suite.Require().NoError(err)
+		_, err = suite.aclKeeper.ProposeAdmin(suite.ctx, &types.MsgProposeAdmin{
+			Sender:  addr2.String(),
+			Admin:   addr6.String(),
+			Replace: true,
+		})
+		suite.Require().NoError(err)
+
+		suite.aclKeeper.RemoveRoleMember(suite.ctx, types.RoleAdminManager, suite.adminAddress)
+		_, err = suite.aclKeeper.AcceptAdmin(suite.ctx, &types.MsgAcceptAdmin{Sender: addr5.String()})
+		suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+		suite.Require().False(suite.aclKeeper.IsAdmin(suite.ctx, addr5))
+
+		suite.aclKeeper.DeleteAdmin(suite.ctx, addr2)
+		_, err = suite.aclKeeper.AcceptAdmin(suite.ctx, &types.MsgAcceptAdmin{Sender: addr6.String()})
+		suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+		suite.Require().False(suite.aclKeeper.IsAdmin(suite.ctx, addr6))
+	})
+}
+
+func (suite *TestSuite) TestAdminProposalExpiry() {
+	suite.SetupTest()
+	addr1 := sdk.AccAddress([]byte{111})
+	addr2 := sdk.AccAddress([]byte{222})
+
+	for _, addr := range []sdk.AccAddress{addr1, addr2} {
+		_, err := suite.aclKeeper.ProposeAdmin(suite.ctx, &types.MsgProposeAdmin{
+			Sender: suite.adminAddress.String(),
+			Admin:  addr.String(),
+		})
+		suite.Require().NoError(err)
+	}
+
+	// Still pending just before the expiry
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(types.AdminProposalPeriod - time.Second))
+	suite.Require().NoError(suite.aclKeeper.EndBlock(suite.ctx))
+	suite.Require().Len(suite.aclKeeper.ExportAdminProposals(suite.ctx), 2)
+	_, err := suite.aclKeeper.AcceptAdmin(suite.ctx, &types.MsgAcceptAdmin{Sender: addr1.String()})
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second))
+	_, err = suite.aclKeeper.AcceptAdmin(suite.ctx, &types.MsgAcceptAdmin{Sender: addr2.String()})
+	suite.Require().ErrorContains(err, "expired")
+	suite.Require().False(suite.aclKeeper.IsAdmin(suite.ctx, addr2))
+
+	suite.Require().NoError(suite.aclKeeper.EndBlock(suite.ctx))
+	suite.Require().Empty(suite.aclKeeper.ExportAdminProposals(suite.ctx))
+	suite.Require().True(suite.aclKeeper.IsAdmin(suite.ctx, addr1))
+}
+
+func (suite *TestSuite) TestMinAdminsInvariant() {
+	suite.SetupTest()
+	invariant := keeper.MinAdminsInvariant(suite.aclKeeper)
+
+	_, broken := invariant(suite.ctx)
+	suite.Require().False(broken)
+
+	params := suite.aclKeeper.GetParams(suite.ctx)
+	params.MinAdmins = 2
+	suite.aclKeeper.SetParams(suite.ctx, params)
+	_, broken = invariant(suite.ctx)
+	suite.Require().True(broken)
+
+	// Admins with expiry do not count
+	suite.aclKeeper.SetAdminUntil(suite.ctx, sdk.AccAddress([]byte{111}), *types.NewHeightExpiry(100))
+	_, broken = invariant(suite.ctx)
+	suite.Require().True(broken)
+
+	suite.aclKeeper.SetAdmin(suite.ctx, sdk.AccAddress([]byte{222}))
+	_, broken = invariant(suite.ctx)
+	suite.Require().False(broken)
+
+	// Without min_admins, at least one admin without expiry is required once
+	// there are admins, as when changing the admins
+	params.MinAdmins = 0
+	suite.aclKeeper.SetParams(suite.ctx, params)
+	suite.aclKeeper.DeleteAdmin(suite.ctx, suite.adminAddress)
+	suite.aclKeeper.DeleteAdmin(suite.ctx, sdk.AccAddress([]byte{222}))
+	_, broken = invariant(suite.ctx)
+	suite.Require().True(broken)
+
+	suite.aclKeeper.DeleteAdmin(suite.ctx, sdk.AccAddress([]byte{111}))
+	_, broken = invariant(suite.ctx)
+	suite.Require().False(broken)
+}
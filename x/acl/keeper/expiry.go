@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"bytes"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/saga-sdk/x/acl/types"
+)
+
+// expiredEntry is an entry of the admin or allowed list found in an expiry
+// queue.
+type expiredEntry struct {
+	list []byte
+	addr sdk.AccAddress
+}
+
+// PruneExpiredEntries removes the admin and allowed entries whose expiry has
+// been reached, walking the time and height ordered expiry queues up to the
+// current block. The removals are recorded without sender.
+func (k Keeper) PruneExpiredEntries(ctx sdk.Context) error {
+	expired := k.expiredEntries(ctx, types.KeyPrefixExpiryByTime, sdk.FormatTimeBytes(ctx.BlockTime()))
+	expired = append(expired, k.expiredEntries(ctx, types.KeyPrefixExpiryByHeight, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))...)
+
+	var admins, allowed []sdk.AccAddress
+	for _, entry := range expired {
+		k.deleteEntry(ctx, entry.list, entry.addr)
+		if bytes.Equal(entry.list, types.KeyPrefixAdmins) {
+			admins = append(admins, entry.addr)
+		} else {
+			allowed = append(allowed, entry.addr)
+		}
+	}
+
+	if len(admins) > 0 {
+		k.recordChanges(ctx, types.CHANGE_ACTION_REMOVED_ADMIN, "", "", nil, admins)
+		err := ctx.EventManager().EmitTypedEvent(&types.EventRemovedAdmins{
+			Addresses: addressStrings(admins),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if len(allowed) > 0 {
+		k.recordChanges(ctx, types.CHANGE_ACTION_REMOVED_ALLOWED, "", "", nil, allowed)
+		err := ctx.EventManager().EmitTypedEvent(&types.EventRemovedAllowed{
+			Addresses: addressStrings(allowed),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addressStrings returns the bech32 encoding of addresses.
+func addressStrings(addrs []sdk.AccAddress) []string {
+	addresses := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		addresses = append(addresses, addr.String())
+	}
+	return addresses
+}
+
+// expiredEntries returns the entries of an expiry queue up to and including
+// the end key.
+func (k Keeper) expiredEntries(ctx sdk.Context, queue []byte, end []byte) (expired []expiredEntry) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), queue).Iterator(nil, storetypes.PrefixEndBytes(end))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(end):]
+		expired = append(expired, expiredEntry{
+			list: key[:1],
+			addr: sdk.AccAddress(key[1:]),
+		})
+	}
+	return expired
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/saga-sdk/x/acl/types"
+)
+
+func (suite *TestSuite) TestExpiringEntries() {
+	addr1 := sdk.AccAddress([]byte{111})
+	addr2 := sdk.AccAddress([]byte{222})
+
+	testCases := []struct {
+		name   string
+		expiry *types.Expiry
+		// advance moves the context to the block where the expiry is reached
+		advance func(ctx sdk.Context) sdk.Context
+	}{
+		{
+			"time",
+			types.NewTimeExpiry(suite.ctx.BlockTime().Add(time.Hour)),
+			func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+			},
+		},
+		{
+			"height",
+			types.NewHeightExpiry(suite.ctx.BlockHeight() + 10),
+			func(ctx sdk.Context) sdk.Context {
+				return ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.aclKeeper.SetParams(suite.ctx, types.Params{Enable: true})
+
+			_, err := suite.aclKeeper.AddAdmins(suite.ctx, &types.MsgAddAdmins{
+				Sender: suite.adminAddress.String(),
+				Admins: []string{addr1.String()},
+				Expiry: tc.expiry,
+			})
+			suite.Require().NoError(err)
+			_, err = suite.aclKeeper.AddAllowed(suite.ctx, &types.MsgAddAllowed{
+				Sender:  suite.adminAddress.String(),
+				Allowed: []string{addr1.String(), addr2.String()},
+				Expiry:  tc.expiry,
+			})
+			suite.Require().NoError(err)
+			// Re-adding without expiry makes the entry permanent
+			_, err = suite.aclKeeper.AddAllowed(suite.ctx, &types.MsgAddAllowed{
+				Sender:  suite.adminAddress.String(),
+				Allowed: []string{addr2.String()},
+			})
+			suite.Require().NoError(err)
+
+			suite.Require().True(suite.aclKeeper.IsAdmin(suite.ctx, addr1))
+			suite.Require().True(suite.aclKeeper.Allowed(suite.ctx, addr1))
+
+			genesis := suite.aclKeeper.ExportGenesis(suite.ctx)
+			suite.Require().Equal([]types.ExpiringEntry{{Address: addr1.String(), Expiry: *tc.expiry}}, genesis.ExpiringAdmins)
+			suite.Require().Equal([]types.ExpiringEntry{{Address: addr1.String(), Expiry: *tc.expiry}}, genesis.ExpiringAllowed)
+
+			// Expired entries stop counting before being pruned
+			ctx := tc.advance(suite.ctx)
+			suite.Require().False(suite.aclKeeper.IsAdmin(ctx, addr1))
+			suite.Require().False(suite.aclKeeper.Allowed(ctx, addr1))
+			suite.Require().True(suite.aclKeeper.Allowed(ctx, addr2))
+			suite.Require().NotContains(suite.aclKeeper.ExportAdmins(ctx), addr1.String())
+
+			suite.Require().NoError(suite.aclKeeper.EndBlock(ctx))
+			genesis = suite.aclKeeper.ExportGenesis(ctx)
+			suite.Require().NotContains(genesis.Admins, addr1.String())
+			suite.Require().Equal([]string{addr2.String()}, genesis.Allowed)
+			suite.Require().Empty(genesis.ExpiringAdmins)
+			suite.Require().Empty(genesis.ExpiringAllowed)
+
+			// The time and height queues are empty once pruned
+			iterator := ctx.KVStore(suite.storeKey).Iterator(types.KeyPrefixExpiryByTime, storetypes.PrefixEndBytes(types.KeyPrefixExpiryByHeight))
+			defer iterator.Close()
+			suite.Require().False(iterator.Valid())
+		})
+	}
+}
+
+func (suite *TestSuite) TestExpiringEntriesReplaced() {
+	suite.SetupTest()
+	addr := sdk.AccAddress([]byte{111})
+	height := suite.ctx.BlockHeight()
+
+	suite.aclKeeper.SetAdminUntil(suite.ctx, addr, *types.NewHeightExpiry(height + 5))
+	suite.aclKeeper.SetAdminUntil(suite.ctx, addr, *types.NewHeightExpiry(height + 10))
+
+	// The previous expiry no longer prunes the entry
+	ctx := suite.ctx.WithBlockHeight(height + 5)
+	suite.Require().NoError(suite.aclKeeper.EndBlock(ctx))
+	suite.Require().True(suite.aclKeeper.IsAdmin(ctx, addr))
+
+	ctx = suite.ctx.WithBlockHeight(height + 10)
+	suite.Require().NoError(suite.aclKeeper.EndBlock(ctx))
+	suite.Require().False(suite.aclKeeper.IsAdmin(ctx, addr))
+}
+
+func (suite *TestSuite) TestAddExpiredEntries() {
+	suite.SetupTest()
+	addr := sdk.AccAddress([]byte{111})
+
+	_, err := suite.aclKeeper.AddAdmins(suite.ctx, &types.MsgAddAdmins{
+		Sender: suite.adminAddress.String(),
+		Admins: []string{addr.String()},
+		Expiry: types.NewTimeExpiry(suite.ctx.BlockTime()),
+	})
+	suite.Require().Error(err)
+	_, err = suite.aclKeeper.AddAllowed(suite.ctx, &types.MsgAddAllowed{
+		Sender:  suite.adminAddress.String(),
+		Allowed: []string{addr.String()},
+		Expiry:  types.NewHeightExpiry(suite.ctx.BlockHeight()),
+	})
+	suite.Require().Error(err)
+	suite.Require().False(suite.aclKeeper.IsAdmin(suite.ctx, addr))
+}
@@ -24,13 +24,54 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 		}
 		k.SetAllowed(ctx, accAddr)
 	}
+	for _, entry := range data.ExpiringAdmins {
+		accAddr, err := sdk.AccAddressFromBech32(entry.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.SetAdminUntil(ctx, accAddr, entry.Expiry)
+	}
+	for _, entry := range data.ExpiringAllowed {
+		accAddr, err := sdk.AccAddressFromBech32(entry.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.SetAllowedUntil(ctx, accAddr, entry.Expiry)
+	}
+	for _, role := range data.Roles {
+		for _, addr := range role.Members {
+			accAddr, err := sdk.AccAddressFromBech32(addr)
+			if err != nil {
+				panic(err)
+			}
+			k.SetRoleMember(ctx, role.Name, accAddr)
+		}
+	}
+	for _, proposal := range data.AdminProposals {
+		if err := k.SetAdminProposal(ctx, proposal); err != nil {
+			panic(err)
+		}
+	}
+	for _, record := range data.History {
+		if err := k.SetChangeRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	admins, expiringAdmins := k.exportEntries(ctx, types.KeyPrefixAdmins)
+	allowed, expiringAllowed := k.exportEntries(ctx, types.KeyPrefixAllowed)
+
 	return &types.GenesisState{
-		Params:  k.GetParams(ctx),
-		Admins:  k.ExportAdmins(ctx),
-		Allowed: k.ExportAllowed(ctx),
+		Params:          k.GetParams(ctx),
+		Admins:          admins,
+		Allowed:         allowed,
+		Roles:           k.ExportRoles(ctx),
+		ExpiringAdmins:  expiringAdmins,
+		ExpiringAllowed: expiringAllowed,
+		History:         k.ExportHistory(ctx),
+		AdminProposals:  k.ExportAdminProposals(ctx),
 	}
 }
@@ -40,6 +40,40 @@ func (suite *TestSuite) TestInitGenesis() {
 			func() {},
 			false,
 		},
+		{
+			"custom genesis - roles",
+			&types.GenesisState{
+				Params: types.DefaultParams(),
+				Roles: []types.Role{
+					{Name: types.RoleACLManager, Members: []string{addr1.String()}},
+				},
+			},
+			func() {},
+			false,
+		},
+		{
+			"custom genesis - expiring entries",
+			&types.GenesisState{
+				Params:          types.DefaultParams(),
+				Admins:          []string{addr1.String()},
+				Allowed:         []string{addr1.String()},
+				ExpiringAdmins:  []types.ExpiringEntry{{Address: addr1.String(), Expiry: *types.NewHeightExpiry(100)}},
+				ExpiringAllowed: []types.ExpiringEntry{{Address: addr1.String(), Expiry: *types.NewHeightExpiry(200)}},
+			},
+			func() {},
+			false,
+		},
+		{
+			"invalid role member",
+			&types.GenesisState{
+				Params: types.DefaultParams(),
+				Roles: []types.Role{
+					{Name: types.RoleACLManager, Members: []string{"abcd"}},
+				},
+			},
+			func() {},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -70,6 +104,12 @@ func (suite *TestSuite) TestInitGenesis() {
 					admin := suite.aclKeeper.IsAdmin(suite.ctx, addr)
 					suite.Require().True(admin)
 				}
+				for _, role := range tc.genesis.Roles {
+					for _, member := range role.Members {
+						addr := sdk.MustAccAddressFromBech32(member)
+						suite.Require().True(suite.aclKeeper.HasRole(suite.ctx, addr, role.Name))
+					}
+				}
 			}
 		})
 	}
@@ -83,6 +123,8 @@ func (suite *TestSuite) TestExportGenesis() {
 	genesis := suite.aclKeeper.ExportGenesis(suite.ctx)
 	genesis.Admins = append(genesis.Admins, addr1.String())
 	genesis.Allowed = append(genesis.Allowed, addr1.String())
+	genesis.Roles = append(genesis.Roles, types.Role{Name: types.RoleDeployer, Members: []string{addr1.String()}})
+	genesis.ExpiringAllowed = append(genesis.ExpiringAllowed, types.ExpiringEntry{Address: addr1.String(), Expiry: *types.NewHeightExpiry(100)})
 
 	suite.aclKeeper.InitGenesis(suite.ctx, genesis)
 	genesisExported := suite.aclKeeper.ExportGenesis(suite.ctx)
@@ -91,8 +133,13 @@ func (suite *TestSuite) TestExportGenesis() {
 	sort.Slice(genesis.Allowed, func(i, j int) bool { return genesis.Allowed[i] < genesis.Allowed[j] })
 	sort.Slice(genesisExported.Admins, func(i, j int) bool { return genesisExported.Admins[i] < genesisExported.Admins[j] })
 	sort.Slice(genesisExported.Allowed, func(i, j int) bool { return genesisExported.Allowed[i] < genesisExported.Allowed[j] })
+	sort.Slice(genesis.Roles, func(i, j int) bool { return genesis.Roles[i].Name < genesis.Roles[j].Name })
+	sort.Slice(genesisExported.Roles, func(i, j int) bool { return genesisExported.Roles[i].Name < genesisExported.Roles[j].Name })
 
 	suite.Require().Equal(genesisExported.Params, genesis.Params)
 	suite.Require().Equal(genesisExported.Admins, genesis.Admins)
 	suite.Require().Equal(genesisExported.Allowed, genesis.Allowed)
+	suite.Require().Equal(genesisExported.Roles, genesis.Roles)
+	suite.Require().Equal(genesisExported.ExpiringAdmins, genesis.ExpiringAdmins)
+	suite.Require().Equal(genesisExported.ExpiringAllowed, genesis.ExpiringAllowed)
 }
@@ -3,14 +3,27 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/sagaxyz/saga-sdk/x/acl/types"
 )
 
-var _ types.QueryServer = Keeper{}
+var _ types.QueryServer = queryServer{}
+
+// queryServer implements the query service on the keeper. It only wraps it
+// because the IsAdmin query conflicts with the IsAdmin keeper method.
+type queryServer struct {
+	Keeper
+}
+
+// NewQueryServerImpl returns an implementation of the query service.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{Keeper: k}
+}
 
 // Params returns the params of the claim module
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
@@ -29,8 +42,15 @@ func (k Keeper) ListAdmins(c context.Context, req *types.QueryListAdminsRequest)
 
 	ctx := sdk.UnwrapSDKContext(c)
 
+	admins, expiring, pageRes, err := k.paginateEntries(ctx, types.KeyPrefixAdmins, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &types.QueryListAdminsResponse{
-		Admins: k.ExportAdmins(ctx),
+		Admins:     admins,
+		Pagination: pageRes,
+		Expiring:   expiring,
 	}, nil
 }
 
@@ -41,7 +61,115 @@ func (k Keeper) ListAllowed(c context.Context, req *types.QueryListAllowedReques
 
 	ctx := sdk.UnwrapSDKContext(c)
 
+	allowed, expiring, pageRes, err := k.paginateEntries(ctx, types.KeyPrefixAllowed, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &types.QueryListAllowedResponse{
-		Allowed: k.ExportAllowed(ctx),
+		Allowed:    allowed,
+		Pagination: pageRes,
+		Expiring:   expiring,
+	}, nil
+}
+
+func (k Keeper) IsAllowed(c context.Context, req *types.QueryIsAllowedRequest) (*types.QueryIsAllowedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryIsAllowedResponse{
+		Allowed: k.Allowed(ctx, addr),
+	}, nil
+}
+
+func (k Keeper) ListRoleMembers(c context.Context, req *types.QueryListRoleMembersRequest) (*types.QueryListRoleMembersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateRole(req.Role); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryListRoleMembersResponse{
+		Members: k.GetRoleMembers(ctx, req.Role),
 	}, nil
 }
+
+func (k Keeper) History(c context.Context, req *types.QueryHistoryRequest) (*types.QueryHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HistoryKeyPrefix(addr))
+
+	var history []types.ChangeRecord
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var record types.ChangeRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		history = append(history, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHistoryResponse{
+		History:    history,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q queryServer) IsAdmin(c context.Context, req *types.QueryIsAdminRequest) (*types.QueryIsAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryIsAdminResponse{
+		Admin: q.Keeper.IsAdmin(ctx, addr),
+	}, nil
+}
+
+func (k Keeper) AdminMembers(c context.Context, req *types.QueryAdminMembersRequest) (*types.QueryAdminMembersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.IsAdmin(ctx, addr) {
+		return nil, status.Errorf(codes.NotFound, "%s is not an admin", req.Address)
+	}
+
+	res, err := k.ResolveAdmin(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
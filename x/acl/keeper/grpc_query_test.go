@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/sagaxyz/saga-sdk/x/acl/types"
+)
+
+func (suite *TestSuite) TestListAllowedPagination() {
+	suite.SetupTest()
+
+	var allowed []string
+	for i := byte(1); i <= 5; i++ {
+		addr := sdk.AccAddress([]byte{i})
+		suite.aclKeeper.SetAllowed(suite.ctx, addr)
+		allowed = append(allowed, addr.String())
+	}
+	suite.aclKeeper.SetAllowedUntil(suite.ctx, sdk.AccAddress([]byte{4}), *types.NewHeightExpiry(100))
+	// Expired entries are skipped
+	suite.aclKeeper.SetAllowedUntil(suite.ctx, sdk.AccAddress([]byte{3, 3}), *types.NewTimeExpiry(suite.ctx.BlockTime().Add(-time.Hour)))
+
+	res, err := suite.queryClient.ListAllowed(suite.ctx, &types.QueryListAllowedRequest{
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(allowed[:3], res.Allowed)
+	suite.Require().Equal(uint64(5), res.Pagination.Total)
+	suite.Require().Empty(res.Expiring)
+
+	res, err = suite.queryClient.ListAllowed(suite.ctx, &types.QueryListAllowedRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(allowed[3:], res.Allowed)
+	suite.Require().Nil(res.Pagination.NextKey)
+	suite.Require().Equal([]types.ExpiringEntry{{Address: allowed[3], Expiry: *types.NewHeightExpiry(100)}}, res.Expiring)
+}
+
+func (suite *TestSuite) TestListAdminsPagination() {
+	suite.SetupTest()
+
+	addr := sdk.AccAddress([]byte{234})
+	suite.aclKeeper.SetAdmin(suite.ctx, addr)
+
+	res, err := suite.queryClient.ListAdmins(suite.ctx, &types.QueryListAdminsRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{suite.adminAddress.String()}, res.Admins)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = suite.queryClient.ListAdmins(suite.ctx, &types.QueryListAdminsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{addr.String()}, res.Admins)
+}
+
+func (suite *TestSuite) TestIsAllowed() {
+	allowed := sdk.AccAddress([]byte{111})
+	deployer := sdk.AccAddress([]byte{222})
+	other := sdk.AccAddress([]byte{233})
+
+	testCases := []struct {
+		name       string
+		enable     bool
+		address    string
+		expAllowed bool
+		expErr     bool
+	}{
+		{"allowed", true, allowed.String(), true, false},
+		{"deployer", true, deployer.String(), true, false},
+		{"not allowed", true, other.String(), false, false},
+		{"disabled", false, other.String(), true, false},
+		{"invalid address", true, "invalid", false, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.aclKeeper.SetParams(suite.ctx, types.Params{Enable: tc.enable})
+			suite.aclKeeper.SetAllowed(suite.ctx, allowed)
+			suite.aclKeeper.SetRoleMember(suite.ctx, types.RoleDeployer, deployer)
+
+			res, err := suite.queryClient.IsAllowed(suite.ctx, &types.QueryIsAllowedRequest{Address: tc.address})
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expAllowed, res.Allowed)
+		})
+	}
+}
+
+func (suite *TestSuite) TestIsAdmin() {
+	suite.SetupTest()
+
+	res, err := suite.queryClient.IsAdmin(suite.ctx, &types.QueryIsAdminRequest{Address: suite.adminAddress.String()})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Admin)
+
+	res, err = suite.queryClient.IsAdmin(suite.ctx, &types.QueryIsAdminRequest{Address: sdk.AccAddress([]byte{111}).String()})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Admin)
+
+	_, err = suite.queryClient.IsAdmin(suite.ctx, &types.QueryIsAdminRequest{Address: "invalid"})
+	suite.Require().Error(err)
+}
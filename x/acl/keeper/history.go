@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/saga-sdk/x/acl/types"
+)
+
+// SetChangeRecord stores a change record of the ACL under the address it
+// affects.
+func (k Keeper) SetChangeRecord(ctx sdk.Context, record types.ChangeRecord) error {
+	addr, err := sdk.AccAddressFromBech32(record.Address)
+	if err != nil {
+		return err
+	}
+
+	k.setChangeRecord(ctx, addr, record)
+	return nil
+}
+
+// GetHistory returns the change records of an address ordered by height.
+func (k Keeper) GetHistory(ctx sdk.Context, addr sdk.AccAddress) (history []types.ChangeRecord) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.HistoryKeyPrefix(addr)).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ChangeRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		history = append(history, record)
+	}
+	return history
+}
+
+// ExportHistory returns the change records of every address.
+func (k Keeper) ExportHistory(ctx sdk.Context) (history []types.ChangeRecord) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixHistory).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ChangeRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		history = append(history, record)
+	}
+	return history
+}
+
+// recordChanges records a change of the ACL made by the sender for every
+// affected address at the current block.
+func (k Keeper) recordChanges(ctx sdk.Context, action types.ChangeAction, sender string, role string, expiry *types.Expiry, addrs []sdk.AccAddress) {
+	for _, addr := range addrs {
+		record := types.ChangeRecord{
+			Address: addr.String(),
+			Action:  action,
+			Sender:  sender,
+			Role:    role,
+			Expiry:  expiry,
+			Height:  ctx.BlockHeight(),
+			Time:    ctx.BlockTime(),
+		}
+		k.setChangeRecord(ctx, addr, record)
+	}
+}
+
+// setChangeRecord stores a change record after the previous records of the
+// address, and indexes it by height to be pruned.
+func (k Keeper) setChangeRecord(ctx sdk.Context, addr sdk.AccAddress, record types.ChangeRecord) {
+	store := ctx.KVStore(k.storeKey)
+	sequence := k.nextHistorySequence(ctx)
+
+	store.Set(types.HistoryKey(addr, record.Height, sequence), k.cdc.MustMarshal(&record))
+	store.Set(types.HistoryQueueKey(record.Height, addr, sequence), []byte{})
+}
+
+// PruneHistory deletes the change records older than the history retention.
+func (k Keeper) PruneHistory(ctx sdk.Context) {
+	retention := k.GetParams(ctx).Retention()
+	if uint64(ctx.BlockHeight()) < retention {
+		return
+	}
+	end := ctx.BlockHeight() - int64(retention) + 1
+
+	store := ctx.KVStore(k.storeKey)
+	queue := prefix.NewStore(store, types.KeyPrefixHistoryByHeight)
+	iterator := queue.Iterator(nil, sdk.Uint64ToBigEndian(uint64(end)))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		height, addr, sequence := types.ParseHistoryQueueKey(key)
+		store.Delete(types.HistoryKey(addr, height, sequence))
+		queue.Delete(key)
+	}
+}
+
+// nextHistorySequence returns the sequence ordering the change records of the
+// same address and height, and increments it.
+func (k Keeper) nextHistorySequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	var sequence uint64
+	if bz := store.Get(types.KeyHistorySequence); bz != nil {
+		sequence = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.KeyHistorySequence, sdk.Uint64ToBigEndian(sequence+1))
+
+	return sequence
+}
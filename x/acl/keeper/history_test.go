@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/sagaxyz/saga-sdk/x/acl/types"
+)
+
+func (suite *TestSuite) TestEvents() {
+	addr := sdk.AccAddress([]byte{111})
+	sender := suite.adminAddress.String()
+
+	testCases := []struct {
+		name     string
+		run      func() error
+		expEvent proto.Message
+	}{
+		{
+			"add allowed",
+			func() error {
+				_, err := suite.aclKeeper.AddAllowed(suite.ctx, &types.MsgAddAllowed{Sender: sender, Allowed: []string{addr.String()}})
+				return err
+			},
+			&types.EventAddedAllowed{Sender: sender, Addresses: []string{addr.String()}},
+		},
+		{
+			"remove allowed",
+			func() error {
+				_, err := suite.aclKeeper.RemoveAllowed(suite.ctx, &types.MsgRemoveAllowed{Sender: sender, Allowed: []string{addr.String()}})
+				return err
+			},
+			&types.EventRemovedAllowed{Sender: sender, Addresses: []string{addr.String()}},
+		},
+		{
+			"add admins",
+			func() error {
+				_, err := suite.aclKeeper.AddAdmins(suite.ctx, &types.MsgAddAdmins{Sender: sender, Admins: []string{addr.String()}, Expiry: types.NewHeightExpiry(100)})
+				return err
+			},
+			&types.EventAddedAdmins{Sender: sender, Addresses: []string{addr.String()}, Expiry: types.NewHeightExpiry(100)},
+		},
+		{
+			"remove admins",
+			func() error {
+				_, err := suite.aclKeeper.RemoveAdmins(suite.ctx, &types.MsgRemoveAdmins{Sender: sender, Admins: []string{addr.String()}})
+				return err
+			},
+			&types.EventRemovedAdmins{Sender: sender, Addresses: []string{addr.String()}},
+		},
+		{
+			"enable",
+			func() error {
+				_, err := suite.aclKeeper.Enable(suite.ctx, &types.MsgEnable{Sender: sender})
+				return err
+			},
+			&types.EventEnabled{Sender: sender},
+		},
+		{
+			"disable",
+			func() error {
+				_, err := suite.aclKeeper.Disable(suite.ctx, &types.MsgDisable{Sender: sender})
+				return err
+			},
+			&types.EventDisabled{Sender: sender},
+		},
+		{
+			"grant role",
+			func() error {
+				_, err := suite.aclKeeper.GrantRole(suite.ctx, &types.MsgGrantRole{Sender: sender, Role: types.RoleDeployer, Addresses: []string{addr.String()}})
+				return err
+			},
+			&types.EventGrantedRole{Sender: sender, Role: types.RoleDeployer, Addresses: []string{addr.String()}},
+		},
+		{
+			"revoke role",
+			func() error {
+				_, err := suite.aclKeeper.RevokeRole(suite.ctx, &types.MsgRevokeRole{Sender: sender, Role: types.RoleDeployer, Addresses: []string{addr.String()}})
+				return err
+			},
+			&types.EventRevokedRole{Sender: sender, Role: types.RoleDeployer, Addresses: []string{addr.String()}},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+			suite.Require().NoError(tc.run())
+
+			events := suite.ctx.EventManager().ABCIEvents()
+			suite.Require().Len(events, 1)
+			event, err := sdk.ParseTypedEvent(events[0])
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expEvent, event)
+		})
+	}
+}
+
+func (suite *TestSuite) TestHistory() {
+	suite.SetupTest()
+	addr1 := sdk.AccAddress([]byte{111})
+	addr2 := sdk.AccAddress([]byte{222})
+	sender := suite.adminAddress.String()
+
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+	_, err := suite.aclKeeper.AddAllowed(suite.ctx, &types.MsgAddAllowed{
+		Sender:  sender,
+		Allowed: []string{addr1.String(), addr2.String()},
+		Expiry:  types.NewHeightExpiry(30),
+	})
+	suite.Require().NoError(err)
+	_, err = suite.aclKeeper.GrantRole(suite.ctx, &types.MsgGrantRole{
+		Sender:    sender,
+		Role:      types.RoleDeployer,
+		Addresses: []string{addr1.String()},
+	})
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockHeight(20)
+	_, err = suite.aclKeeper.RemoveAllowed(suite.ctx, &types.MsgRemoveAllowed{
+		Sender:  sender,
+		Allowed: []string{addr1.String()},
+	})
+	suite.Require().NoError(err)
+
+	// addr2 expires
+	suite.ctx = suite.ctx.WithBlockHeight(30)
+	suite.Require().NoError(suite.aclKeeper.EndBlock(suite.ctx))
+
+	record := func(addr sdk.AccAddress, action types.ChangeAction, sender, role string, expiry *types.Expiry, height int64) types.ChangeRecord {
+		return types.ChangeRecord{
+			Address: addr.String(),
+			Action:  action,
+			Sender:  sender,
+			Role:    role,
+			Expiry:  expiry,
+			Height:  height,
+			Time:    suite.ctx.BlockTime(),
+		}
+	}
+	expHistory1 := []types.ChangeRecord{
+		record(addr1, types.CHANGE_ACTION_ADDED_ALLOWED, sender, "", types.NewHeightExpiry(30), 10),
+		record(addr1, types.CHANGE_ACTION_GRANTED_ROLE, sender, types.RoleDeployer, nil, 10),
+		record(addr1, types.CHANGE_ACTION_REMOVED_ALLOWED, sender, "", nil, 20),
+	}
+	expHistory2 := []types.ChangeRecord{
+		record(addr2, types.CHANGE_ACTION_ADDED_ALLOWED, sender, "", types.NewHeightExpiry(30), 10),
+		record(addr2, types.CHANGE_ACTION_REMOVED_ALLOWED, "", "", nil, 30),
+	}
+	suite.Require().Equal(expHistory1, suite.aclKeeper.GetHistory(suite.ctx, addr1))
+	suite.Require().Equal(expHistory2, suite.aclKeeper.GetHistory(suite.ctx, addr2))
+
+	res, err := suite.aclKeeper.History(suite.ctx, &types.QueryHistoryRequest{
+		Address:    addr1.String(),
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expHistory1[:2], res.History)
+	res, err = suite.aclKeeper.History(suite.ctx, &types.QueryHistoryRequest{
+		Address:    addr1.String(),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expHistory1[2:], res.History)
+
+	_, err = suite.aclKeeper.History(suite.ctx, &types.QueryHistoryRequest{Address: "invalid"})
+	suite.Require().Error(err)
+
+	// The history survives a genesis export and import
+	genesis := suite.aclKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(genesis.Validate())
+	suite.SetupTest()
+	suite.aclKeeper.InitGenesis(suite.ctx, genesis)
+	suite.Require().Equal(expHistory1, suite.aclKeeper.GetHistory(suite.ctx, addr1))
+	suite.Require().Equal(expHistory2, suite.aclKeeper.GetHistory(suite.ctx, addr2))
+}
+
+func (suite *TestSuite) TestPruneHistory() {
+	suite.SetupTest()
+	addr1 := sdk.AccAddress([]byte{111})
+	addr2 := sdk.AccAddress([]byte{222})
+	sender := suite.adminAddress.String()
+
+	params := suite.aclKeeper.GetParams(suite.ctx)
+	params.HistoryRetention = 2
+	suite.aclKeeper.SetParams(suite.ctx, params)
+
+	for height := int64(1); height <= 4; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		_, err := suite.aclKeeper.AddAllowed(suite.ctx, &types.MsgAddAllowed{Sender: sender, Allowed: []string{addr1.String(), addr2.String()}})
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.aclKeeper.EndBlock(suite.ctx))
+	}
+
+	for _, addr := range []sdk.AccAddress{addr1, addr2} {
+		history := suite.aclKeeper.GetHistory(suite.ctx, addr)
+		suite.Require().Len(history, 2)
+		suite.Require().Equal(int64(3), history[0].Height)
+		suite.Require().Equal(int64(4), history[1].Height)
+	}
+	suite.Require().Len(suite.aclKeeper.ExportHistory(suite.ctx), 4)
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/saga-sdk/x/acl/types"
+)
+
+// RegisterInvariants registers the acl module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "min-admins", MinAdminsInvariant(k))
+}
+
+// MinAdminsInvariant checks that there are at least the minimum number of
+// admins without expiry, the same minimum as enforced on admin changes
+func MinAdminsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params := k.GetParams(ctx)
+		count := k.countPermanentAdmins(ctx)
+		err := params.ValidatePermanentAdmins(count, k.hasAdmins(ctx))
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "min-admins",
+			fmt.Sprintf("\t%d admins without expiry, minimum %d\n", count, params.MinPermanentAdmins()),
+		), err != nil
+	}
+}
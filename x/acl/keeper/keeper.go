@@ -19,10 +19,16 @@ type Keeper struct {
 	storeKey   storetypes.StoreKey
 	paramSpace paramtypes.Subspace
 	authority  string
+
+	// optional keepers used to resolve the members behind an admin
+	accountKeeper types.AccountKeeper
+	groupKeeper   types.GroupKeeper
 }
 
-// New returns keeper
-func New(cdc codec.Codec, storeKey storetypes.StoreKey, ps paramtypes.Subspace, authority string) Keeper {
+// New returns keeper. The account and group keepers are optional, without them
+// admins are not recognized as multisigs or group policies.
+func New(cdc codec.Codec, storeKey storetypes.StoreKey, ps paramtypes.Subspace,
+	ak types.AccountKeeper, gk types.GroupKeeper, authority string) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
 		ps = ps.WithKeyTable(types.ParamKeyTable())
@@ -33,6 +39,9 @@ func New(cdc codec.Codec, storeKey storetypes.StoreKey, ps paramtypes.Subspace,
 		storeKey:   storeKey,
 		paramSpace: ps,
 		authority:  authority,
+
+		accountKeeper: ak,
+		groupKeeper:   gk,
 	}
 }
 
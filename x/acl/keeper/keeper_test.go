@@ -24,6 +24,7 @@ type TestSuite struct {
 	suite.Suite
 
 	ctx          sdk.Context
+	storeKey     *storetypes.KVStoreKey
 	aclKeeper    keeper.Keeper
 	paramsKeeper paramskeeper.Keeper //nolint:staticcheck
 	queryClient  types.QueryClient
@@ -52,6 +53,7 @@ func (suite *TestSuite) SetupTest() {
 		nil)
 	ctx = ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now()})
 	suite.ctx = ctx
+	suite.storeKey = key
 	encCfg := moduletestutil.MakeTestEncodingConfig(acl.AppModuleBasic{})
 
 	//nolint:staticcheck
@@ -73,6 +75,8 @@ func (suite *TestSuite) SetupTest() {
 		encCfg.Codec,
 		key,
 		ss,
+		nil,
+		nil,
 		sdk.AccAddress(address.Module("gov")).String(),
 	)
 
@@ -80,11 +84,12 @@ func (suite *TestSuite) SetupTest() {
 	genesis := types.DefaultGenesis()
 	genesis.Params.Enable = true
 	genesis.Admins = append(genesis.Admins, suite.adminAddress.String())
+	genesis.Roles = append(genesis.Roles, types.Role{Name: types.RoleAdminManager, Members: []string{suite.adminAddress.String()}})
 	suite.aclKeeper.InitGenesis(suite.ctx, genesis)
 
 	types.RegisterInterfaces(encCfg.InterfaceRegistry)
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, encCfg.InterfaceRegistry)
-	types.RegisterQueryServer(queryHelper, suite.aclKeeper)
+	types.RegisterQueryServer(queryHelper, keeper.NewQueryServerImpl(suite.aclKeeper))
 	queryClient := types.NewQueryClient(queryHelper)
 	suite.queryClient = queryClient
 
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	"github.com/sagaxyz/saga-sdk/x/acl/types"
+)
+
+// ResolveAdmin returns the kind of account behind an address and, for group
+// policies and multisigs, the accounts able to act through it.
+func (k Keeper) ResolveAdmin(ctx sdk.Context, addr sdk.AccAddress) (*types.QueryAdminMembersResponse, error) {
+	if k.groupKeeper != nil {
+		res, err := k.resolveGroupPolicy(ctx, addr)
+		if err != nil || res != nil {
+			return res, err
+		}
+	}
+
+	if k.accountKeeper != nil {
+		acc := k.accountKeeper.GetAccount(ctx, addr)
+		if acc != nil {
+			if pubKey, ok := acc.GetPubKey().(*multisig.LegacyAminoPubKey); ok {
+				return resolveMultisig(pubKey), nil
+			}
+		}
+	}
+
+	return &types.QueryAdminMembersResponse{
+		Kind: types.ADMIN_KIND_ACCOUNT,
+	}, nil
+}
+
+// resolveGroupPolicy returns nil if the address is not a group policy.
+func (k Keeper) resolveGroupPolicy(ctx sdk.Context, addr sdk.AccAddress) (*types.QueryAdminMembersResponse, error) {
+	policyRes, err := k.groupKeeper.GroupPolicyInfo(ctx, &group.QueryGroupPolicyInfoRequest{
+		Address: addr.String(),
+	})
+	if errors.Is(err, sdkerrors.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	info := policyRes.Info
+
+	policy, err := info.GetDecisionPolicy()
+	if err != nil {
+		return nil, err
+	}
+	var threshold string
+	switch policy := policy.(type) {
+	case *group.ThresholdDecisionPolicy:
+		threshold = policy.Threshold
+	case *group.PercentageDecisionPolicy:
+		threshold = policy.Percentage
+	default:
+		return nil, fmt.Errorf("unknown decision policy %T", policy)
+	}
+
+	var members []types.AdminMember
+	pageReq := &query.PageRequest{}
+	for {
+		membersRes, err := k.groupKeeper.GroupMembers(ctx, &group.QueryGroupMembersRequest{
+			GroupId:    info.GroupId,
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range membersRes.Members {
+			members = append(members, types.AdminMember{
+				Address: member.Member.Address,
+				Weight:  member.Member.Weight,
+			})
+		}
+
+		if membersRes.Pagination == nil || len(membersRes.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: membersRes.Pagination.NextKey}
+	}
+
+	return &types.QueryAdminMembersResponse{
+		Kind:      types.ADMIN_KIND_GROUP_POLICY,
+		Members:   members,
+		Threshold: threshold,
+		GroupId:   info.GroupId,
+	}, nil
+}
+
+func resolveMultisig(pubKey *multisig.LegacyAminoPubKey) *types.QueryAdminMembersResponse {
+	var members []types.AdminMember
+	for _, key := range pubKey.GetPubKeys() {
+		members = append(members, types.AdminMember{
+			Address: sdk.AccAddress(key.Address()).String(),
+			Weight:  "1",
+		})
+	}
+
+	return &types.QueryAdminMembersResponse{
+		Kind:      types.ADMIN_KIND_MULTISIG,
+		Members:   members,
+		Threshold: fmt.Sprintf("%d", pubKey.Threshold),
+	}
+}
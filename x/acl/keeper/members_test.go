@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	"github.com/sagaxyz/saga-sdk/x/acl/keeper"
+	"github.com/sagaxyz/saga-sdk/x/acl/types"
+)
+
+type mockAccountKeeper struct {
+	accounts map[string]sdk.AccountI
+}
+
+func (k mockAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return k.accounts[addr.String()]
+}
+
+type mockGroupKeeper struct {
+	policies map[string]*group.GroupPolicyInfo
+	members  map[uint64][]*group.GroupMember
+}
+
+func (k mockGroupKeeper) GroupPolicyInfo(_ context.Context, req *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error) {
+	info, ok := k.policies[req.Address]
+	if !ok {
+		return nil, sdkerrors.ErrNotFound
+	}
+	return &group.QueryGroupPolicyInfoResponse{Info: info}, nil
+}
+
+func (k mockGroupKeeper) GroupMembers(_ context.Context, req *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error) {
+	return &group.QueryGroupMembersResponse{Members: k.members[req.GroupId]}, nil
+}
+
+func (suite *TestSuite) TestAdminMembers() {
+	suite.SetupTest()
+
+	policy := sdk.AccAddress([]byte{111})
+	member1 := sdk.AccAddress([]byte{1})
+	member2 := sdk.AccAddress([]byte{2})
+	member3 := sdk.AccAddress([]byte{3})
+	info := &group.GroupPolicyInfo{Address: policy.String(), GroupId: 7}
+	suite.Require().NoError(info.SetDecisionPolicy(group.NewThresholdDecisionPolicy("2", time.Hour, 0)))
+
+	pubKeys := []cryptotypes.PubKey{
+		secp256k1.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(),
+		secp256k1.GenPrivKey().PubKey(),
+	}
+	multisigKey := multisig.NewLegacyAminoPubKey(2, pubKeys)
+	multisigAddr := sdk.AccAddress(multisigKey.Address())
+
+	ss, ok := suite.paramsKeeper.GetSubspace(types.ModuleName)
+	suite.Require().True(ok)
+	k := keeper.New(
+		suite.encCfg.Codec,
+		suite.storeKey,
+		ss,
+		mockAccountKeeper{accounts: map[string]sdk.AccountI{
+			multisigAddr.String(): authtypes.NewBaseAccount(multisigAddr, multisigKey, 1, 0),
+		}},
+		mockGroupKeeper{
+			policies: map[string]*group.GroupPolicyInfo{policy.String(): info},
+			members: map[uint64][]*group.GroupMember{7: {
+				{GroupId: 7, Member: &group.Member{Address: member1.String(), Weight: "1"}},
+				{GroupId: 7, Member: &group.Member{Address: member2.String(), Weight: "1"}},
+				{GroupId: 7, Member: &group.Member{Address: member3.String(), Weight: "1"}},
+			}},
+		},
+		sdk.AccAddress(address.Module("gov")).String(),
+	)
+	queryServer := keeper.NewQueryServerImpl(k)
+
+	// A group policy admin manages the ACL through group proposals
+	_, err := k.AddAdmins(suite.ctx, &types.MsgAddAdmins{
+		Sender: suite.adminAddress.String(),
+		Admins: []string{policy.String(), multisigAddr.String()},
+	})
+	suite.Require().NoError(err)
+	_, err = k.AddAllowed(suite.ctx, &types.MsgAddAllowed{
+		Sender:  policy.String(),
+		Allowed: []string{member1.String()},
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(k.Allowed(suite.ctx, member1))
+
+	suite.Run("group policy", func() {
+		res, err := queryServer.AdminMembers(suite.ctx, &types.QueryAdminMembersRequest{Address: policy.String()})
+		suite.Require().NoError(err)
+		suite.Require().Equal(types.ADMIN_KIND_GROUP_POLICY, res.Kind)
+		suite.Require().Equal("2", res.Threshold)
+		suite.Require().Equal(uint64(7), res.GroupId)
+		suite.Require().Equal([]types.AdminMember{
+			{Address: member1.String(), Weight: "1"},
+			{Address: member2.String(), Weight: "1"},
+			{Address: member3.String(), Weight: "1"},
+		}, res.Members)
+	})
+	suite.Run("multisig", func() {
+		res, err := queryServer.AdminMembers(suite.ctx, &types.QueryAdminMembersRequest{Address: multisigAddr.String()})
+		suite.Require().NoError(err)
+		suite.Require().Equal(types.ADMIN_KIND_MULTISIG, res.Kind)
+		suite.Require().Equal("2", res.Threshold)
+		suite.Require().Len(res.Members, 3)
+		for i, member := range res.Members {
+			suite.Require().Equal(sdk.AccAddress(pubKeys[i].Address()).String(), member.Address)
+		}
+	})
+	suite.Run("account", func() {
+		res, err := queryServer.AdminMembers(suite.ctx, &types.QueryAdminMembersRequest{Address: suite.adminAddress.String()})
+		suite.Require().NoError(err)
+		suite.Require().Equal(types.ADMIN_KIND_ACCOUNT, res.Kind)
+		suite.Require().Empty(res.Members)
+	})
+	suite.Run("not an admin", func() {
+		_, err := queryServer.AdminMembers(suite.ctx, &types.QueryAdminMembersRequest{Address: member1.String()})
+		suite.Require().Error(err)
+	})
+}
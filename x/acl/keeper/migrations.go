@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/saga-sdk/x/acl/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate2to3 migrates the store from consensus version 2 to 3 by moving the
+// params from the legacy x/params subspace to the module store. The existing
+// admins are granted the admin manager role, so that they keep managing the
+// admins and the roles until the role is revoked.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := m.keeper.legacyParams(ctx)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	m.keeper.SetParams(ctx, params)
+
+	for _, admin := range m.keeper.ExportAdmins(ctx) {
+		addr, err := sdk.AccAddressFromBech32(admin)
+		if err != nil {
+			return err
+		}
+		m.keeper.SetRoleMember(ctx, types.RoleAdminManager, addr)
+	}
+	return nil
+}
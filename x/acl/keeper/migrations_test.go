@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/saga-sdk/x/acl/keeper"
+	"github.com/sagaxyz/saga-sdk/x/acl/types"
+)
+
+func (suite *TestSuite) TestMigrate2to3() {
+	suite.SetupTest()
+
+	// Params stored before the migration are only in the legacy subspace
+	legacyParams := types.Params{Enable: true, MinAdmins: 1, AdminHandover: true}
+	ss, ok := suite.paramsKeeper.GetSubspace(types.ModuleName)
+	suite.Require().True(ok)
+	ss.SetParamSet(suite.ctx, &legacyParams)
+	suite.ctx.KVStore(suite.storeKey).Delete(types.KeyParams)
+
+	// Legacy fallback
+	suite.Require().Equal(legacyParams, suite.aclKeeper.GetParams(suite.ctx))
+
+	// Admins of consensus version 2 managed the admins without a role
+	legacyAdmin := sdk.AccAddress([]byte{234})
+	_, err := suite.aclKeeper.AddAdmins(suite.ctx, types.NewMsgAddAdmins(suite.aclKeeper.GetAuthority(), legacyAdmin.String()))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.aclKeeper.HasRole(suite.ctx, legacyAdmin, types.RoleAdminManager))
+
+	err = keeper.NewMigrator(suite.aclKeeper).Migrate2to3(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.aclKeeper.HasRole(suite.ctx, legacyAdmin, types.RoleAdminManager))
+	suite.Require().True(suite.ctx.KVStore(suite.storeKey).Has(types.KeyParams))
+	suite.Require().Equal(legacyParams, suite.aclKeeper.GetParams(suite.ctx))
+
+	// The legacy subspace is no longer read
+	ss.SetParamSet(suite.ctx, &types.Params{})
+	suite.Require().Equal(legacyParams, suite.aclKeeper.GetParams(suite.ctx))
+
+	// Disabling stores params that marshal to empty bytes, which must not
+	// fall back to the legacy subspace
+	ss.SetParamSet(suite.ctx, &legacyParams)
+	_, err = suite.aclKeeper.Disable(suite.ctx, types.NewMsgDisable(sdk.MustAccAddressFromBech32(suite.aclKeeper.GetAuthority())))
+	suite.Require().NoError(err)
+	_, err = suite.aclKeeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{Authority: suite.aclKeeper.GetAuthority(), Params: types.Params{}})
+	suite.Require().NoError(err)
+	suite.Require().True(suite.ctx.KVStore(suite.storeKey).Has(types.KeyParams))
+	suite.Require().Equal(types.Params{}, suite.aclKeeper.GetParams(suite.ctx))
+	suite.Require().False(suite.aclKeeper.Enabled(suite.ctx))
+}
@@ -3,37 +3,73 @@ package keeper
 import (
 	"context"
 	"errors"
+	"fmt"
 
-	"cosmossdk.io/store/prefix"
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/sagaxyz/saga-sdk/x/acl/types"
 )
 
 var _ types.MsgServer = &Keeper{}
 
-var ErrNotAuthorized = errors.New("not authorized")
+var (
+	ErrNotAuthorized = errors.New("not authorized")
+	ErrAdminLockout  = errors.New("admin lockout")
+)
+
+// authorize checks that the sender is the authority or holds the role gating
+// the message. Admins hold every role but the admin manager one, so that
+// managing the admins and the roles is granted explicitly.
+func (k Keeper) authorize(ctx sdk.Context, sender string, role string) error {
+	if sender == k.GetAuthority() {
+		return nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return err
+	}
+	if k.HasRole(ctx, addr, role) {
+		return nil
+	}
+	if role != types.RoleAdminManager && k.IsAdmin(ctx, addr) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: missing role %s", ErrNotAuthorized, role)
+}
 
 func (k Keeper) AddAllowed(goCtx context.Context, msg *types.MsgAddAllowed) (resp *types.MsgAddAllowedResponse, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	sender, err := sdk.AccAddressFromBech32(msg.GetSender())
+	err = k.authorize(ctx, msg.Sender, types.RoleACLManager)
 	if err != nil {
 		return
 	}
-	if !k.IsAdmin(ctx, sender) && k.GetAuthority() != msg.GetSender() {
-		err = ErrNotAuthorized
+
+	if msg.Expiry != nil && msg.Expiry.Expired(ctx) {
+		err = fmt.Errorf("expiry already reached: %s", msg.Expiry)
 		return
 	}
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAllowed)
-	for _, addr := range msg.Allowed {
-		var accAddr sdk.AccAddress
-		accAddr, err = sdk.AccAddressFromBech32(addr)
-		if err != nil {
-			return
-		}
-		store.Set(accAddr, []byte{0}) //TODO
+	addrs, err := parseAddresses(msg.Allowed)
+	if err != nil {
+		return
+	}
+	for _, addr := range addrs {
+		k.setEntry(ctx, types.KeyPrefixAllowed, addr, msg.Expiry)
+	}
+
+	k.recordChanges(ctx, types.CHANGE_ACTION_ADDED_ALLOWED, msg.Sender, "", msg.Expiry, addrs)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAddedAllowed{
+		Sender:    msg.Sender,
+		Addresses: msg.Allowed,
+		Expiry:    msg.Expiry,
+	})
+	if err != nil {
+		return
 	}
 
 	resp = &types.MsgAddAllowedResponse{}
@@ -42,24 +78,28 @@ func (k Keeper) AddAllowed(goCtx context.Context, msg *types.MsgAddAllowed) (res
 func (k Keeper) RemoveAllowed(goCtx context.Context, msg *types.MsgRemoveAllowed) (resp *types.MsgRemoveAllowedResponse, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	err = k.authorize(ctx, msg.Sender, types.RoleACLManager)
 	if err != nil {
 		return
 	}
-	if !k.IsAdmin(ctx, sender) && k.GetAuthority() != msg.GetSender() {
-		err = ErrNotAuthorized
+
+	addrs, err := parseAddresses(msg.Allowed)
+	if err != nil {
 		return
 	}
+	for _, addr := range addrs {
+		k.deleteEntry(ctx, types.KeyPrefixAllowed, addr)
+	}
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAllowed)
-	for _, addr := range msg.Allowed {
-		var accAddr sdk.AccAddress
-		accAddr, err = sdk.AccAddressFromBech32(addr)
-		if err != nil {
-			return
-		}
-		store.Delete(accAddr)
+	k.recordChanges(ctx, types.CHANGE_ACTION_REMOVED_ALLOWED, msg.Sender, "", nil, addrs)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRemovedAllowed{
+		Sender:    msg.Sender,
+		Addresses: msg.Allowed,
+	})
+	if err != nil {
+		return
 	}
+
 	resp = &types.MsgRemoveAllowedResponse{}
 	return
 }
@@ -67,23 +107,43 @@ func (k Keeper) RemoveAllowed(goCtx context.Context, msg *types.MsgRemoveAllowed
 func (k Keeper) AddAdmins(goCtx context.Context, msg *types.MsgAddAdmins) (resp *types.MsgAddAdminsResponse, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	err = k.authorize(ctx, msg.Sender, types.RoleAdminManager)
 	if err != nil {
 		return
 	}
-	if !k.IsAdmin(ctx, sender) && k.GetAuthority() != msg.GetSender() {
-		err = ErrNotAuthorized
+
+	if msg.Expiry != nil && msg.Expiry.Expired(ctx) {
+		err = fmt.Errorf("expiry already reached: %s", msg.Expiry)
 		return
 	}
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAdmins)
-	for _, addr := range msg.Admins {
-		var accAddr sdk.AccAddress
-		accAddr, err = sdk.AccAddressFromBech32(addr)
-		if err != nil {
-			return
-		}
-		store.Set(accAddr, []byte{0}) //TODO
+	if k.GetParams(ctx).AdminHandover && msg.Sender != k.GetAuthority() {
+		err = errors.New("admins must be proposed and accepted with admin handover enabled")
+		return
+	}
+
+	addrs, err := parseAddresses(msg.Admins)
+	if err != nil {
+		return
+	}
+	before := k.countPermanentAdmins(ctx)
+	for _, addr := range addrs {
+		k.setEntry(ctx, types.KeyPrefixAdmins, addr, msg.Expiry)
+	}
+	// Adding an expiry to admins reduces the admins without expiry
+	err = k.checkAdminLockout(ctx, before)
+	if err != nil {
+		return
+	}
+
+	k.recordChanges(ctx, types.CHANGE_ACTION_ADDED_ADMIN, msg.Sender, "", msg.Expiry, addrs)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAddedAdmins{
+		Sender:    msg.Sender,
+		Addresses: msg.Admins,
+		Expiry:    msg.Expiry,
+	})
+	if err != nil {
+		return
 	}
 
 	resp = &types.MsgAddAdminsResponse{}
@@ -92,23 +152,31 @@ func (k Keeper) AddAdmins(goCtx context.Context, msg *types.MsgAddAdmins) (resp
 func (k Keeper) RemoveAdmins(goCtx context.Context, msg *types.MsgRemoveAdmins) (resp *types.MsgRemoveAdminsResponse, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	err = k.authorize(ctx, msg.Sender, types.RoleAdminManager)
+	if err != nil {
+		return
+	}
+
+	addrs, err := parseAddresses(msg.Admins)
 	if err != nil {
 		return
 	}
-	if !k.IsAdmin(ctx, sender) && k.GetAuthority() != msg.GetSender() {
-		err = ErrNotAuthorized
+	before := k.countPermanentAdmins(ctx)
+	for _, addr := range addrs {
+		k.deleteEntry(ctx, types.KeyPrefixAdmins, addr)
+	}
+	err = k.checkAdminLockout(ctx, before)
+	if err != nil {
 		return
 	}
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAdmins)
-	for _, addr := range msg.Admins {
-		var accAddr sdk.AccAddress
-		accAddr, err = sdk.AccAddressFromBech32(addr)
-		if err != nil {
-			return
-		}
-		store.Delete(accAddr)
+	k.recordChanges(ctx, types.CHANGE_ACTION_REMOVED_ADMIN, msg.Sender, "", nil, addrs)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRemovedAdmins{
+		Sender:    msg.Sender,
+		Addresses: msg.Admins,
+	})
+	if err != nil {
+		return
 	}
 
 	resp = &types.MsgRemoveAdminsResponse{}
@@ -118,34 +186,301 @@ func (k Keeper) RemoveAdmins(goCtx context.Context, msg *types.MsgRemoveAdmins)
 func (k Keeper) Enable(goCtx context.Context, msg *types.MsgEnable) (resp *types.MsgEnableResponse, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	err = k.authorize(ctx, msg.Sender, types.RoleACLManager)
 	if err != nil {
 		return
 	}
-	if !k.IsAdmin(ctx, sender) && k.GetAuthority() != msg.GetSender() {
-		err = ErrNotAuthorized
+
+	params := k.GetParams(ctx)
+	params.Enable = true
+	k.SetParams(ctx, params)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventEnabled{
+		Sender: msg.Sender,
+	})
+	if err != nil {
 		return
 	}
 
-	k.paramSpace.Set(ctx, types.ParamStoreKeyEnable, true)
-
 	resp = &types.MsgEnableResponse{}
 	return
 }
 func (k Keeper) Disable(goCtx context.Context, msg *types.MsgDisable) (resp *types.MsgDisableResponse, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	err = k.authorize(ctx, msg.Sender, types.RoleACLManager)
 	if err != nil {
 		return
 	}
-	if !k.IsAdmin(ctx, sender) && k.GetAuthority() != msg.GetSender() {
-		err = ErrNotAuthorized
+
+	params := k.GetParams(ctx)
+	params.Enable = false
+	k.SetParams(ctx, params)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDisabled{
+		Sender: msg.Sender,
+	})
+	if err != nil {
 		return
 	}
 
-	k.paramSpace.Set(ctx, types.ParamStoreKeyEnable, false)
-
 	resp = &types.MsgDisableResponse{}
 	return
 }
+
+func (k Keeper) GrantRole(goCtx context.Context, msg *types.MsgGrantRole) (resp *types.MsgGrantRoleResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err = k.authorize(ctx, msg.Sender, types.RoleAdminManager)
+	if err != nil {
+		return
+	}
+	err = types.ValidateRole(msg.Role)
+	if err != nil {
+		return
+	}
+
+	addrs, err := parseAddresses(msg.Addresses)
+	if err != nil {
+		return
+	}
+	for _, addr := range addrs {
+		k.SetRoleMember(ctx, msg.Role, addr)
+	}
+
+	k.recordChanges(ctx, types.CHANGE_ACTION_GRANTED_ROLE, msg.Sender, msg.Role, nil, addrs)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventGrantedRole{
+		Sender:    msg.Sender,
+		Role:      msg.Role,
+		Addresses: msg.Addresses,
+	})
+	if err != nil {
+		return
+	}
+
+	resp = &types.MsgGrantRoleResponse{}
+	return
+}
+func (k Keeper) RevokeRole(goCtx context.Context, msg *types.MsgRevokeRole) (resp *types.MsgRevokeRoleResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err = k.authorize(ctx, msg.Sender, types.RoleAdminManager)
+	if err != nil {
+		return
+	}
+	err = types.ValidateRole(msg.Role)
+	if err != nil {
+		return
+	}
+
+	addrs, err := parseAddresses(msg.Addresses)
+	if err != nil {
+		return
+	}
+	for _, addr := range addrs {
+		k.RemoveRoleMember(ctx, msg.Role, addr)
+	}
+
+	k.recordChanges(ctx, types.CHANGE_ACTION_REVOKED_ROLE, msg.Sender, msg.Role, nil, addrs)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRevokedRole{
+		Sender:    msg.Sender,
+		Role:      msg.Role,
+		Addresses: msg.Addresses,
+	})
+	if err != nil {
+		return
+	}
+
+	resp = &types.MsgRevokeRoleResponse{}
+	return
+}
+
+// ProposeAdmin proposes a new admin. Admins propose their own replacement
+// without the admin manager role, as the number of admins does not grow.
+func (k Keeper) ProposeAdmin(goCtx context.Context, msg *types.MsgProposeAdmin) (resp *types.MsgProposeAdminResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Replace {
+		var sender sdk.AccAddress
+		sender, err = sdk.AccAddressFromBech32(msg.Sender)
+		if err != nil {
+			return
+		}
+		if !k.IsAdmin(ctx, sender) {
+			err = errors.New("only admins can be replaced")
+			return
+		}
+	} else {
+		err = k.authorize(ctx, msg.Sender, types.RoleAdminManager)
+		if err != nil {
+			return
+		}
+	}
+
+	err = k.SetAdminProposal(ctx, types.AdminProposal{
+		Admin:    msg.Admin,
+		Proposer: msg.Sender,
+		Replace:  msg.Replace,
+		Expiry:   *types.NewTimeExpiry(ctx.BlockTime().Add(types.AdminProposalPeriod)),
+	})
+	if err != nil {
+		return
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventProposedAdmin{
+		Sender:  msg.Sender,
+		Admin:   msg.Admin,
+		Replace: msg.Replace,
+	})
+	if err != nil {
+		return
+	}
+
+	resp = &types.MsgProposeAdminResponse{}
+	return
+}
+
+// AcceptAdmin makes the sender admin if it has a pending proposal that did not
+// expire and whose proposer could still make it. An admin replacing itself
+// hands over its expiry, so that the new admin expires with it.
+func (k Keeper) AcceptAdmin(goCtx context.Context, msg *types.MsgAcceptAdmin) (resp *types.MsgAcceptAdminResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return
+	}
+	proposal, found := k.GetAdminProposal(ctx, admin)
+	if !found {
+		err = fmt.Errorf("no admin proposal for %s", msg.Sender)
+		return
+	}
+	if proposal.Expiry.Expired(ctx) {
+		err = fmt.Errorf("admin proposal for %s expired", msg.Sender)
+		return
+	}
+	proposer, err := sdk.AccAddressFromBech32(proposal.Proposer)
+	if err != nil {
+		return
+	}
+
+	// The proposer must still be allowed to make the proposal
+	if proposal.Replace {
+		if !k.IsAdmin(ctx, proposer) {
+			err = fmt.Errorf("%w: proposer %s is no longer an admin", ErrNotAuthorized, proposal.Proposer)
+			return
+		}
+	} else {
+		err = k.authorize(ctx, proposal.Proposer, types.RoleAdminManager)
+		if err != nil {
+			return
+		}
+	}
+
+	var expiry *types.Expiry
+	if proposal.Replace {
+		expiry, _ = k.getEntry(ctx, types.KeyPrefixAdmins, proposer)
+	}
+
+	before := k.countPermanentAdmins(ctx)
+	k.DeleteAdminProposal(ctx, admin)
+	k.setEntry(ctx, types.KeyPrefixAdmins, admin, expiry)
+	k.recordChanges(ctx, types.CHANGE_ACTION_ADDED_ADMIN, proposal.Proposer, "", expiry, []sdk.AccAddress{admin})
+
+	replaced := proposal.Replace
+	if replaced {
+		k.deleteEntry(ctx, types.KeyPrefixAdmins, proposer)
+		k.recordChanges(ctx, types.CHANGE_ACTION_REMOVED_ADMIN, proposal.Proposer, "", nil, []sdk.AccAddress{proposer})
+	}
+	err = k.checkAdminLockout(ctx, before)
+	if err != nil {
+		return
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAcceptedAdmin{
+		Admin:    msg.Sender,
+		Proposer: proposal.Proposer,
+		Replaced: replaced,
+	})
+	if err != nil {
+		return
+	}
+
+	resp = &types.MsgAcceptAdminResponse{}
+	return
+}
+func (k Keeper) CancelAdminProposal(goCtx context.Context, msg *types.MsgCancelAdminProposal) (resp *types.MsgCancelAdminProposalResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err = k.authorize(ctx, msg.Sender, types.RoleAdminManager)
+	if err != nil {
+		return
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return
+	}
+	if _, found := k.GetAdminProposal(ctx, admin); !found {
+		err = fmt.Errorf("no admin proposal for %s", msg.Admin)
+		return
+	}
+	k.DeleteAdminProposal(ctx, admin)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventCanceledAdminProposal{
+		Sender: msg.Sender,
+		Admin:  msg.Admin,
+	})
+	if err != nil {
+		return
+	}
+
+	resp = &types.MsgCancelAdminProposalResponse{}
+	return
+}
+
+// UpdateParams updates the module params. The update can only be performed by
+// the authority, and cannot require more admins without expiry than there are.
+func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (resp *types.MsgUpdateParamsResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.GetAuthority() {
+		err = errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+		return
+	}
+	err = msg.Params.Validate()
+	if err != nil {
+		return
+	}
+
+	admins := k.countPermanentAdmins(ctx)
+	if msg.Params.MinAdmins > admins {
+		err = fmt.Errorf("%w: %d admins without expiry, the minimum would be %d", ErrAdminLockout, admins, msg.Params.MinAdmins)
+		return
+	}
+
+	k.SetParams(ctx, msg.Params)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventUpdatedParams{
+		Params: msg.Params,
+	})
+	if err != nil {
+		return
+	}
+
+	resp = &types.MsgUpdateParamsResponse{}
+	return
+}
+
+// parseAddresses parses a list of bech32 account addresses.
+func parseAddresses(addresses []string) ([]sdk.AccAddress, error) {
+	addrs := make([]sdk.AccAddress, 0, len(addresses))
+	for _, addr := range addresses {
+		accAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, accAddr)
+	}
+	return addrs, nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/sagaxyz/saga-sdk/x/acl/keeper"
 	"github.com/sagaxyz/saga-sdk/x/acl/types"
 )
 
@@ -39,7 +40,7 @@ func (suite *TestSuite) TestMsgServer() {
 			suite.Require().Error(err)
 
 			_, err = suite.aclKeeper.RemoveAdmins(suite.ctx, &types.MsgRemoveAdmins{
-				Sender: addr1.String(),
+				Sender: suite.adminAddress.String(),
 				Admins: []string{addr1.String()},
 			})
 			suite.Require().NoError(err)
@@ -84,4 +85,89 @@ func (suite *TestSuite) TestMsgServer() {
 			suite.Require().False(suite.aclKeeper.Allowed(suite.ctx, addr))
 		})
 	})
+	suite.Run("roles", func() {
+		manager := sdk.AccAddress([]byte{111})
+		member := sdk.AccAddress([]byte{222})
+
+		suite.Run("grant", func() {
+			_, err := suite.aclKeeper.GrantRole(suite.ctx, &types.MsgGrantRole{
+				Sender:    manager.String(),
+				Role:      types.RoleACLManager,
+				Addresses: []string{manager.String()},
+			})
+			suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+
+			_, err = suite.aclKeeper.GrantRole(suite.ctx, &types.MsgGrantRole{
+				Sender:    suite.adminAddress.String(),
+				Role:      types.RoleACLManager,
+				Addresses: []string{manager.String()},
+			})
+			suite.Require().NoError(err)
+			suite.Require().True(suite.aclKeeper.HasRole(suite.ctx, manager, types.RoleACLManager))
+			suite.Require().Equal([]string{manager.String()}, suite.aclKeeper.GetRoleMembers(suite.ctx, types.RoleACLManager))
+
+			_, err = suite.aclKeeper.GrantRole(suite.ctx, &types.MsgGrantRole{
+				Sender:    suite.adminAddress.String(),
+				Role:      "Invalid Role",
+				Addresses: []string{manager.String()},
+			})
+			suite.Require().Error(err)
+		})
+		suite.Run("gated on role", func() {
+			// The ACL manager manages the allowed list but not the admins
+			_, err := suite.aclKeeper.AddAllowed(suite.ctx, types.NewMsgAddAllowed(manager.String(), member.String()))
+			suite.Require().NoError(err)
+			suite.Require().True(suite.aclKeeper.Allowed(suite.ctx, member))
+
+			_, err = suite.aclKeeper.AddAdmins(suite.ctx, types.NewMsgAddAdmins(manager.String(), member.String()))
+			suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+			_, err = suite.aclKeeper.RemoveAdmins(suite.ctx, types.NewMsgRemoveAdmins(manager.String(), suite.adminAddress.String()))
+			suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+			suite.Require().True(suite.aclKeeper.IsAdmin(suite.ctx, suite.adminAddress))
+
+			_, err = suite.aclKeeper.GrantRole(suite.ctx, types.NewMsgGrantRole(manager.String(), types.RoleAdminManager, manager.String()))
+			suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+		})
+		suite.Run("plain admin", func() {
+			// Admins hold the other roles, but not the admin manager one
+			admin := sdk.AccAddress([]byte{112})
+			_, err := suite.aclKeeper.AddAdmins(suite.ctx, types.NewMsgAddAdmins(suite.adminAddress.String(), admin.String()))
+			suite.Require().NoError(err)
+
+			_, err = suite.aclKeeper.AddAllowed(suite.ctx, types.NewMsgAddAllowed(admin.String(), member.String()))
+			suite.Require().NoError(err)
+			_, err = suite.aclKeeper.RemoveAdmins(suite.ctx, types.NewMsgRemoveAdmins(admin.String(), suite.adminAddress.String()))
+			suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+			suite.Require().True(suite.aclKeeper.IsAdmin(suite.ctx, suite.adminAddress))
+			_, err = suite.aclKeeper.AddAdmins(suite.ctx, types.NewMsgAddAdmins(admin.String(), member.String()))
+			suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+			_, err = suite.aclKeeper.GrantRole(suite.ctx, types.NewMsgGrantRole(admin.String(), types.RoleAdminManager, admin.String()))
+			suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+			_, err = suite.aclKeeper.RevokeRole(suite.ctx, types.NewMsgRevokeRole(admin.String(), types.RoleAdminManager, suite.adminAddress.String()))
+			suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+
+			_, err = suite.aclKeeper.RemoveAdmins(suite.ctx, types.NewMsgRemoveAdmins(suite.adminAddress.String(), admin.String()))
+			suite.Require().NoError(err)
+		})
+		suite.Run("deployer", func() {
+			deployer := sdk.AccAddress([]byte{99})
+			suite.Require().False(suite.aclKeeper.Allowed(suite.ctx, deployer))
+
+			_, err := suite.aclKeeper.GrantRole(suite.ctx, types.NewMsgGrantRole(suite.adminAddress.String(), types.RoleDeployer, deployer.String()))
+			suite.Require().NoError(err)
+			suite.Require().True(suite.aclKeeper.Allowed(suite.ctx, deployer))
+		})
+		suite.Run("revoke", func() {
+			_, err := suite.aclKeeper.RevokeRole(suite.ctx, types.NewMsgRevokeRole(manager.String(), types.RoleACLManager, manager.String()))
+			suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+
+			// The authority holds every role
+			_, err = suite.aclKeeper.RevokeRole(suite.ctx, types.NewMsgRevokeRole(suite.aclKeeper.GetAuthority(), types.RoleACLManager, manager.String()))
+			suite.Require().NoError(err)
+			suite.Require().False(suite.aclKeeper.HasRole(suite.ctx, manager, types.RoleACLManager))
+
+			_, err = suite.aclKeeper.AddAllowed(suite.ctx, types.NewMsgAddAllowed(manager.String(), manager.String()))
+			suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+		})
+	})
 }
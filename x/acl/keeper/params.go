@@ -6,11 +6,28 @@ import (
 	"github.com/sagaxyz/saga-sdk/x/acl/types"
 )
 
+// GetParams returns the acl params, falling back to the legacy x/params
+// subspace before the store is migrated.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	// Default params marshal to empty bytes, so the presence of the key
+	// tells whether the store was migrated.
+	if !store.Has(types.KeyParams) {
+		return k.legacyParams(ctx)
+	}
+
+	k.cdc.MustUnmarshal(store.Get(types.KeyParams), &params)
 	return params
 }
 
+// SetParams sets the acl params in the module store.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyParams, k.cdc.MustMarshal(&params))
+}
+
+// legacyParams returns the params stored in the legacy x/params subspace.
+func (k Keeper) legacyParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params
 }
@@ -16,3 +16,32 @@ func (suite *TestSuite) TestParams() {
 	newParams := suite.aclKeeper.GetParams(suite.ctx)
 	suite.Require().Equal(newParams, params)
 }
+
+func (suite *TestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name      string
+		authority string
+		params    types.Params
+		expErr    bool
+	}{
+		{"authority", suite.aclKeeper.GetAuthority(), types.Params{Enable: false, MinAdmins: 1, AdminHandover: true}, false},
+		{"not the authority", suite.adminAddress.String(), types.DefaultParams(), true},
+		{"more admins than there are", suite.aclKeeper.GetAuthority(), types.Params{Enable: true, MinAdmins: 2}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			before := suite.aclKeeper.GetParams(suite.ctx)
+
+			_, err := suite.aclKeeper.UpdateParams(suite.ctx, types.NewMsgUpdateParams(tc.authority, tc.params))
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Equal(before, suite.aclKeeper.GetParams(suite.ctx))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.params, suite.aclKeeper.GetParams(suite.ctx))
+		})
+	}
+}
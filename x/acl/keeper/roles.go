@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/saga-sdk/x/acl/types"
+)
+
+// SetRoleMember grants a role to an address.
+func (k Keeper) SetRoleMember(ctx sdk.Context, role string, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RoleKeyPrefix(role))
+	store.Set(addr.Bytes(), []byte{0})
+}
+
+// RemoveRoleMember revokes a role from an address.
+func (k Keeper) RemoveRoleMember(ctx sdk.Context, role string, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RoleKeyPrefix(role))
+	store.Delete(addr.Bytes())
+}
+
+// HasRole returns true if the address holds the role.
+func (k Keeper) HasRole(ctx sdk.Context, addr sdk.AccAddress, role string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RoleKeyPrefix(role))
+	return store.Has(addr.Bytes())
+}
+
+// GetRoleMembers returns the addresses holding a role.
+func (k Keeper) GetRoleMembers(ctx sdk.Context, role string) (addresses []string) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.RoleKeyPrefix(role)).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addr := sdk.AccAddress(iterator.Key())
+		addresses = append(addresses, addr.String())
+	}
+	return addresses
+}
+
+// ExportRoles returns every role with at least one member.
+func (k Keeper) ExportRoles(ctx sdk.Context) (roles []types.Role) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRoles).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		role := string(key[1 : 1+key[0]])
+		addr := sdk.AccAddress(key[1+key[0]:])
+
+		// Keys of the same role are contiguous
+		if len(roles) == 0 || roles[len(roles)-1].Name != role {
+			roles = append(roles, types.Role{Name: role})
+		}
+		last := &roles[len(roles)-1]
+		last.Members = append(last.Members, addr.String())
+	}
+	return roles
+}
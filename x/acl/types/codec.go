@@ -15,6 +15,12 @@ const (
 	removeAllowedName = "saga/MsgRemoveAllowed"
 	enableName        = "saga/MsgEnable"
 	disableName       = "saga/MsgDisable"
+	grantRoleName     = "saga/MsgGrantRole"
+	revokeRoleName    = "saga/MsgRevokeRole"
+	proposeAdminName  = "saga/MsgProposeAdmin"
+	acceptAdminName   = "saga/MsgAcceptAdmin"
+	cancelAdminName   = "saga/MsgCancelAdminProposal"
+	updateParamsName  = "saga/acl/MsgUpdateParams"
 )
 
 // RegisterInterfaces register implementations
@@ -27,6 +33,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRemoveAdmins{},
 		&MsgEnable{},
 		&MsgDisable{},
+		&MsgGrantRole{},
+		&MsgRevokeRole{},
+		&MsgProposeAdmin{},
+		&MsgAcceptAdmin{},
+		&MsgCancelAdminProposal{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -42,4 +54,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRemoveAllowed{}, removeAllowedName, nil)
 	cdc.RegisterConcrete(&MsgEnable{}, enableName, nil)
 	cdc.RegisterConcrete(&MsgDisable{}, disableName, nil)
+	cdc.RegisterConcrete(&MsgGrantRole{}, grantRoleName, nil)
+	cdc.RegisterConcrete(&MsgRevokeRole{}, revokeRoleName, nil)
+	cdc.RegisterConcrete(&MsgProposeAdmin{}, proposeAdminName, nil)
+	cdc.RegisterConcrete(&MsgAcceptAdmin{}, acceptAdminName, nil)
+	cdc.RegisterConcrete(&MsgCancelAdminProposal{}, cancelAdminName, nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
 }
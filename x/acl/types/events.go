@@ -1,14 +1,25 @@
 package types
 
+// The ACL changes emit the typed events of events.proto. The legacy event
+// types and attribute keys are kept for importers, but no longer emitted.
 const (
-	EventTypeEnabled        = "enabled"
-	EventTypeDisabled       = "disabled"
-	EventTypeAddedAdmin     = "added_admin"
-	EventTypeRemovedAdmin   = "removed_admin"
-	EventTypeAddedAllowed   = "added_allowed"
+	// Deprecated: use EventEnabled.
+	EventTypeEnabled = "enabled"
+	// Deprecated: use EventDisabled.
+	EventTypeDisabled = "disabled"
+	// Deprecated: use EventAddedAdmins.
+	EventTypeAddedAdmin = "added_admin"
+	// Deprecated: use EventRemovedAdmins.
+	EventTypeRemovedAdmin = "removed_admin"
+	// Deprecated: use EventAddedAllowed.
+	EventTypeAddedAllowed = "added_allowed"
+	// Deprecated: use EventRemovedAllowed.
 	EventTypeRemovedAllowed = "removed_allowed"
 )
+
 const (
-	AttributeKeyAdmin   = "admin"
+	// Deprecated: the typed events list the affected addresses.
+	AttributeKeyAdmin = "admin"
+	// Deprecated: the typed events list the affected addresses.
 	AttributeKeyAllowed = "allowed"
 )
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTimeExpiry returns an expiry at a block time.
+func NewTimeExpiry(t time.Time) *Expiry {
+	return &Expiry{Time: &t}
+}
+
+// NewHeightExpiry returns an expiry at a block height.
+func NewHeightExpiry(height int64) *Expiry {
+	return &Expiry{Height: height}
+}
+
+// Validate checks that exactly one of the time and the height is set.
+func (e Expiry) Validate() error {
+	if e.Time != nil && e.Height != 0 {
+		return errors.New("expiry cannot have both a time and a height")
+	}
+	if e.Time == nil && e.Height == 0 {
+		return errors.New("expiry must have a time or a height")
+	}
+	if e.Height < 0 {
+		return fmt.Errorf("negative expiry height: %d", e.Height)
+	}
+
+	return nil
+}
+
+// Expired returns true if the expiry has been reached at the block of ctx.
+func (e Expiry) Expired(ctx sdk.Context) bool {
+	if e.Time != nil {
+		return !ctx.BlockTime().Before(*e.Time)
+	}
+
+	return ctx.BlockHeight() >= e.Height
+}
@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/sagaxyz/saga-sdk/x/acl/types"
+	admintypes "github.com/sagaxyz/saga-sdk/x/admin/types"
+)
+
+type aclQueryClient struct {
+	types.QueryClient
+}
+
+func (aclQueryClient) Params(context.Context, *types.QueryParamsRequest, ...grpc.CallOption) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: types.Params{Enable: true}}, nil
+}
+
+type adminQueryClient struct {
+	admintypes.QueryClient
+}
+
+func (adminQueryClient) Params(context.Context, *admintypes.QueryParamsRequest, ...grpc.CallOption) (*admintypes.QueryParamsResponse, error) {
+	return &admintypes.QueryParamsResponse{Params: admintypes.Params{Permissions: admintypes.Permissions{SetMetadata: true}}}, nil
+}
+
+func TestGatewayRoutes(t *testing.T) {
+	mux := runtime.NewServeMux()
+	require.NoError(t, types.RegisterQueryHandlerClient(context.Background(), mux, aclQueryClient{}))
+	require.NoError(t, admintypes.RegisterQueryHandlerClient(context.Background(), mux, adminQueryClient{}))
+
+	for path, expBody := range map[string]string{
+		"/saga/acl/v1/params":   `{"params":{"enable":true`,
+		"/saga/v1/params":       `{"params":{"enable":true`,
+		"/saga/admin/v1/params": `{"params":{"permissions":{"set_metadata":true`,
+	} {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		require.Equal(t, http.StatusOK, rec.Code, path)
+		require.Contains(t, rec.Body.String(), expBody, path)
+	}
+}
@@ -4,11 +4,15 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// AdminProposalPeriod is how long an admin proposal can be accepted.
+const AdminProposalPeriod = 7 * 24 * time.Hour
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -47,6 +51,110 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("allowed address invalid: %w", err)
 		}
 	}
+	roles := make(map[string]bool, len(gs.Roles))
+	for _, role := range gs.Roles {
+		if err := ValidateRole(role.Name); err != nil {
+			return err
+		}
+		if roles[role.Name] {
+			return fmt.Errorf("duplicate role %s", role.Name)
+		}
+		roles[role.Name] = true
+
+		for _, member := range role.Members {
+			_, err := sdk.AccAddressFromBech32(member)
+			if err != nil {
+				return fmt.Errorf("%s member address invalid: %w", role.Name, err)
+			}
+		}
+	}
+
+	if err := validateExpiringEntries(gs.ExpiringAdmins, gs.Admins); err != nil {
+		return fmt.Errorf("expiring admin invalid: %w", err)
+	}
+	if err := validateExpiringEntries(gs.ExpiringAllowed, gs.Allowed); err != nil {
+		return fmt.Errorf("expiring allowed invalid: %w", err)
+	}
+
+	if err := validateMinAdmins(gs.Params, gs.Admins, gs.ExpiringAdmins); err != nil {
+		return err
+	}
+
+	proposed := make(map[string]bool, len(gs.AdminProposals))
+	for _, proposal := range gs.AdminProposals {
+		if err := proposal.Validate(); err != nil {
+			return fmt.Errorf("admin proposal invalid: %w", err)
+		}
+		if proposed[proposal.Admin] {
+			return fmt.Errorf("duplicate admin proposal for %s", proposal.Admin)
+		}
+		proposed[proposal.Admin] = true
+	}
+
+	for _, record := range gs.History {
+		if err := record.Validate(); err != nil {
+			return fmt.Errorf("change record invalid: %w", err)
+		}
+	}
 
 	return gs.Params.Validate()
 }
+
+// validateExpiringEntries checks that every expiring entry is unique and in the
+// list it is the expiry of.
+func validateExpiringEntries(entries []ExpiringEntry, list []string) error {
+	listed := make(map[string]bool, len(list))
+	for _, addr := range list {
+		listed[addr] = true
+	}
+
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		if !listed[entry.Address] {
+			return fmt.Errorf("%s is not listed", entry.Address)
+		}
+		if seen[entry.Address] {
+			return fmt.Errorf("duplicate expiry for %s", entry.Address)
+		}
+		seen[entry.Address] = true
+
+		if err := entry.Expiry.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", entry.Address, err)
+		}
+	}
+
+	return nil
+}
+
+// validateMinAdmins checks that there are at least the minimum number of admins
+// without expiry.
+func validateMinAdmins(params Params, admins []string, expiring []ExpiringEntry) error {
+	expires := make(map[string]bool, len(expiring))
+	for _, entry := range expiring {
+		expires[entry.Address] = true
+	}
+
+	var permanent uint32
+	for _, admin := range admins {
+		if !expires[admin] {
+			permanent++
+		}
+	}
+
+	return params.ValidatePermanentAdmins(permanent, len(admins) > 0)
+}
+
+// Validate performs a basic validation of an admin proposal.
+func (p AdminProposal) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Admin); err != nil {
+		return fmt.Errorf("admin address invalid: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Proposer); err != nil {
+		return fmt.Errorf("proposer address invalid: %w", err)
+	}
+	if err := p.Expiry.Validate(); err != nil {
+		return fmt.Errorf("expiry invalid: %w", err)
+	}
+
+	return nil
+}
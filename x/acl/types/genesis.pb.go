@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,11 +27,61 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ChangeAction is a change of the ACL affecting an address.
+type ChangeAction int32
+
+const (
+	CHANGE_ACTION_UNSPECIFIED     ChangeAction = 0
+	CHANGE_ACTION_ADDED_ADMIN     ChangeAction = 1
+	CHANGE_ACTION_REMOVED_ADMIN   ChangeAction = 2
+	CHANGE_ACTION_ADDED_ALLOWED   ChangeAction = 3
+	CHANGE_ACTION_REMOVED_ALLOWED ChangeAction = 4
+	CHANGE_ACTION_GRANTED_ROLE    ChangeAction = 5
+	CHANGE_ACTION_REVOKED_ROLE    ChangeAction = 6
+)
+
+var ChangeAction_name = map[int32]string{
+	0: "CHANGE_ACTION_UNSPECIFIED",
+	1: "CHANGE_ACTION_ADDED_ADMIN",
+	2: "CHANGE_ACTION_REMOVED_ADMIN",
+	3: "CHANGE_ACTION_ADDED_ALLOWED",
+	4: "CHANGE_ACTION_REMOVED_ALLOWED",
+	5: "CHANGE_ACTION_GRANTED_ROLE",
+	6: "CHANGE_ACTION_REVOKED_ROLE",
+}
+
+var ChangeAction_value = map[string]int32{
+	"CHANGE_ACTION_UNSPECIFIED":     0,
+	"CHANGE_ACTION_ADDED_ADMIN":     1,
+	"CHANGE_ACTION_REMOVED_ADMIN":   2,
+	"CHANGE_ACTION_ADDED_ALLOWED":   3,
+	"CHANGE_ACTION_REMOVED_ALLOWED": 4,
+	"CHANGE_ACTION_GRANTED_ROLE":    5,
+	"CHANGE_ACTION_REVOKED_ROLE":    6,
+}
+
+func (x ChangeAction) String() string {
+	return proto.EnumName(ChangeAction_name, int32(x))
+}
+
+func (ChangeAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7d891ccbf7a5b6f3, []int{0}
+}
+
 // GenesisState defines the module's genesis state.
 type GenesisState struct {
 	Params  Params   `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	Admins  []string `protobuf:"bytes,2,rep,name=admins,proto3" json:"admins,omitempty"`
 	Allowed []string `protobuf:"bytes,3,rep,name=allowed,proto3" json:"allowed,omitempty"`
+	Roles   []Role   `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles"`
+	// expiring_admins are the expiries of the admins that have one.
+	ExpiringAdmins []ExpiringEntry `protobuf:"bytes,5,rep,name=expiring_admins,json=expiringAdmins,proto3" json:"expiring_admins"`
+	// expiring_allowed are the expiries of the allowed addresses that have one.
+	ExpiringAllowed []ExpiringEntry `protobuf:"bytes,6,rep,name=expiring_allowed,json=expiringAllowed,proto3" json:"expiring_allowed"`
+	// history are the past changes of the ACL, ordered by address and height.
+	History []ChangeRecord `protobuf:"bytes,7,rep,name=history,proto3" json:"history"`
+	// admin_proposals are the admin proposals pending acceptance.
+	AdminProposals []AdminProposal `protobuf:"bytes,8,rep,name=admin_proposals,json=adminProposals,proto3" json:"admin_proposals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,16 +138,114 @@ func (m *GenesisState) GetAllowed() []string {
 	return nil
 }
 
+func (m *GenesisState) GetRoles() []Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExpiringAdmins() []ExpiringEntry {
+	if m != nil {
+		return m.ExpiringAdmins
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExpiringAllowed() []ExpiringEntry {
+	if m != nil {
+		return m.ExpiringAllowed
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHistory() []ChangeRecord {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAdminProposals() []AdminProposal {
+	if m != nil {
+		return m.AdminProposals
+	}
+	return nil
+}
+
+// Role is a named permission and the addresses holding it.
+type Role struct {
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (m *Role) Reset()         { *m = Role{} }
+func (m *Role) String() string { return proto.CompactTextString(m) }
+func (*Role) ProtoMessage()    {}
+func (*Role) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d891ccbf7a5b6f3, []int{1}
+}
+func (m *Role) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Role) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Role.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Role) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Role.Merge(m, src)
+}
+func (m *Role) XXX_Size() int {
+	return m.Size()
+}
+func (m *Role) XXX_DiscardUnknown() {
+	xxx_messageInfo_Role.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Role proto.InternalMessageInfo
+
+func (m *Role) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Role) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
 // Params defines the module's params
 type Params struct {
 	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	// min_admins is the minimum number of admins without expiry. Admin changes
+	// cannot leave fewer of them, nor remove the last one.
+	MinAdmins uint32 `protobuf:"varint,2,opt,name=min_admins,json=minAdmins,proto3" json:"min_admins,omitempty"`
+	// admin_handover requires admins to be proposed and to accept, instead of
+	// being added directly. Only the authority can still add admins.
+	AdminHandover bool `protobuf:"varint,3,opt,name=admin_handover,json=adminHandover,proto3" json:"admin_handover,omitempty"`
+	// history_retention is the number of blocks the change records are kept
+	// for. Older records are pruned at the end of each block. The default
+	// retention applies when it is zero.
+	HistoryRetention uint64 `protobuf:"varint,4,opt,name=history_retention,json=historyRetention,proto3" json:"history_retention,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d891ccbf7a5b6f3, []int{1}
+	return fileDescriptor_7d891ccbf7a5b6f3, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -129,31 +281,378 @@ func (m *Params) GetEnable() bool {
 	return false
 }
 
+func (m *Params) GetMinAdmins() uint32 {
+	if m != nil {
+		return m.MinAdmins
+	}
+	return 0
+}
+
+func (m *Params) GetAdminHandover() bool {
+	if m != nil {
+		return m.AdminHandover
+	}
+	return false
+}
+
+func (m *Params) GetHistoryRetention() uint64 {
+	if m != nil {
+		return m.HistoryRetention
+	}
+	return 0
+}
+
+// AdminProposal is a proposal to make an address admin, pending its
+// acceptance.
+type AdminProposal struct {
+	// admin is the proposed address.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// proposer is the address that made the proposal.
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// replace removes the proposer from the admins once the proposal is
+	// accepted.
+	Replace bool `protobuf:"varint,3,opt,name=replace,proto3" json:"replace,omitempty"`
+	// expiry is when the proposal can no longer be accepted and is pruned.
+	Expiry Expiry `protobuf:"bytes,4,opt,name=expiry,proto3" json:"expiry"`
+}
+
+func (m *AdminProposal) Reset()         { *m = AdminProposal{} }
+func (m *AdminProposal) String() string { return proto.CompactTextString(m) }
+func (*AdminProposal) ProtoMessage()    {}
+func (*AdminProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d891ccbf7a5b6f3, []int{3}
+}
+func (m *AdminProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AdminProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminProposal.Merge(m, src)
+}
+func (m *AdminProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminProposal proto.InternalMessageInfo
+
+func (m *AdminProposal) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *AdminProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *AdminProposal) GetReplace() bool {
+	if m != nil {
+		return m.Replace
+	}
+	return false
+}
+
+func (m *AdminProposal) GetExpiry() Expiry {
+	if m != nil {
+		return m.Expiry
+	}
+	return Expiry{}
+}
+
+// Expiry is when an ACL entry stops counting. Exactly one of time and height
+// is set.
+type Expiry struct {
+	// time is the block time from which the entry is expired.
+	Time *time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+	// height is the block height from which the entry is expired.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *Expiry) Reset()         { *m = Expiry{} }
+func (m *Expiry) String() string { return proto.CompactTextString(m) }
+func (*Expiry) ProtoMessage()    {}
+func (*Expiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d891ccbf7a5b6f3, []int{4}
+}
+func (m *Expiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Expiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Expiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Expiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Expiry.Merge(m, src)
+}
+func (m *Expiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *Expiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_Expiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Expiry proto.InternalMessageInfo
+
+func (m *Expiry) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *Expiry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// ExpiringEntry is the expiry of an admin or allowed address.
+type ExpiringEntry struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Expiry  Expiry `protobuf:"bytes,2,opt,name=expiry,proto3" json:"expiry"`
+}
+
+func (m *ExpiringEntry) Reset()         { *m = ExpiringEntry{} }
+func (m *ExpiringEntry) String() string { return proto.CompactTextString(m) }
+func (*ExpiringEntry) ProtoMessage()    {}
+func (*ExpiringEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d891ccbf7a5b6f3, []int{5}
+}
+func (m *ExpiringEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpiringEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpiringEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpiringEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpiringEntry.Merge(m, src)
+}
+func (m *ExpiringEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpiringEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpiringEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpiringEntry proto.InternalMessageInfo
+
+func (m *ExpiringEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ExpiringEntry) GetExpiry() Expiry {
+	if m != nil {
+		return m.Expiry
+	}
+	return Expiry{}
+}
+
+// ChangeRecord records a change of the ACL affecting an address.
+type ChangeRecord struct {
+	// address is the address affected by the change.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// action is the change.
+	Action ChangeAction `protobuf:"varint,2,opt,name=action,proto3,enum=saga.acl.v1.ChangeAction" json:"action,omitempty"`
+	// sender is the address that made the change. It is empty when an expired
+	// entry was removed.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// role is the role granted or revoked.
+	Role string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	// expiry is the expiry of an added admin or allowed address, if any.
+	Expiry *Expiry `protobuf:"bytes,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// height is the block height of the change.
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the change.
+	Time time.Time `protobuf:"bytes,7,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *ChangeRecord) Reset()         { *m = ChangeRecord{} }
+func (m *ChangeRecord) String() string { return proto.CompactTextString(m) }
+func (*ChangeRecord) ProtoMessage()    {}
+func (*ChangeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d891ccbf7a5b6f3, []int{6}
+}
+func (m *ChangeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChangeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeRecord.Merge(m, src)
+}
+func (m *ChangeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeRecord proto.InternalMessageInfo
+
+func (m *ChangeRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ChangeRecord) GetAction() ChangeAction {
+	if m != nil {
+		return m.Action
+	}
+	return CHANGE_ACTION_UNSPECIFIED
+}
+
+func (m *ChangeRecord) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ChangeRecord) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *ChangeRecord) GetExpiry() *Expiry {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+func (m *ChangeRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ChangeRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 func init() {
+	proto.RegisterEnum("saga.acl.v1.ChangeAction", ChangeAction_name, ChangeAction_value)
 	proto.RegisterType((*GenesisState)(nil), "saga.acl.v1.GenesisState")
+	proto.RegisterType((*Role)(nil), "saga.acl.v1.Role")
 	proto.RegisterType((*Params)(nil), "saga.acl.v1.Params")
+	proto.RegisterType((*AdminProposal)(nil), "saga.acl.v1.AdminProposal")
+	proto.RegisterType((*Expiry)(nil), "saga.acl.v1.Expiry")
+	proto.RegisterType((*ExpiringEntry)(nil), "saga.acl.v1.ExpiringEntry")
+	proto.RegisterType((*ChangeRecord)(nil), "saga.acl.v1.ChangeRecord")
 }
 
 func init() { proto.RegisterFile("saga/acl/v1/genesis.proto", fileDescriptor_7d891ccbf7a5b6f3) }
 
 var fileDescriptor_7d891ccbf7a5b6f3 = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0x4e, 0x4c, 0x4f,
-	0xd4, 0x4f, 0x4c, 0xce, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x06, 0x49, 0xe9, 0x25, 0x26, 0xe7, 0xe8, 0x95, 0x19,
-	0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xc5, 0xf5, 0x41, 0x2c, 0x88, 0x12, 0xa5, 0x62, 0x2e,
-	0x1e, 0x77, 0x88, 0x9e, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x43, 0x2e, 0xb6, 0x82, 0xc4, 0xa2,
-	0xc4, 0xdc, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x61, 0x3d, 0x24, 0x33, 0xf4, 0x02,
-	0xc0, 0x52, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x15, 0x0a, 0x89, 0x71, 0xb1, 0x25,
-	0xa6, 0xe4, 0x66, 0xe6, 0x15, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x06, 0x41, 0x79, 0x42, 0x12,
-	0x5c, 0xec, 0x89, 0x39, 0x39, 0xf9, 0xe5, 0xa9, 0x29, 0x12, 0xcc, 0x60, 0x09, 0x18, 0x57, 0x49,
-	0x81, 0x8b, 0x2d, 0x00, 0xae, 0x37, 0x35, 0x2f, 0x31, 0x29, 0x27, 0x15, 0x6c, 0x1d, 0x47, 0x10,
-	0x94, 0xe7, 0xe4, 0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31,
-	0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xea, 0xe9,
-	0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x20, 0xa7, 0x55, 0x54, 0x56, 0x81,
-	0x69, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x0a, 0x70, 0x38, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1,
-	0x81, 0x3d, 0x68, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x7f, 0x18, 0x2f, 0x20, 0x01, 0x00,
-	0x00,
+	// 803 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x13, 0xc7, 0x49, 0xa6, 0x9b, 0x25, 0x3b, 0xac, 0x90, 0x1b, 0x54, 0x27, 0x44, 0x42,
+	0x44, 0xac, 0xd6, 0x56, 0x97, 0x3d, 0xc0, 0xd1, 0x4d, 0x4c, 0x37, 0xda, 0x36, 0x89, 0xa6, 0xa5,
+	0x48, 0x08, 0xc9, 0x9a, 0xc4, 0x83, 0x63, 0x61, 0x7b, 0x2c, 0x8f, 0x5b, 0x1a, 0x7e, 0x00, 0xe2,
+	0x84, 0x2a, 0xf1, 0x13, 0xf8, 0x23, 0x1c, 0x7b, 0xec, 0x91, 0x13, 0xa0, 0xf6, 0x47, 0x70, 0x45,
+	0x33, 0x1e, 0xa7, 0x09, 0x6d, 0xd9, 0x9e, 0xe2, 0xf7, 0xde, 0xf7, 0xde, 0x7c, 0xef, 0x7b, 0xef,
+	0x29, 0x60, 0x9b, 0x61, 0x1f, 0x5b, 0x78, 0x1e, 0x5a, 0x67, 0xbb, 0x96, 0x4f, 0x62, 0xc2, 0x02,
+	0x66, 0x26, 0x29, 0xcd, 0x28, 0xdc, 0xe2, 0x21, 0x13, 0xcf, 0x43, 0xf3, 0x6c, 0xb7, 0xfd, 0xdc,
+	0xa7, 0x3e, 0x15, 0x7e, 0x8b, 0x7f, 0xe5, 0x90, 0x76, 0xc7, 0xa7, 0xd4, 0x0f, 0x89, 0x25, 0xac,
+	0xd9, 0xe9, 0x77, 0x56, 0x16, 0x44, 0x84, 0x65, 0x38, 0x4a, 0x72, 0x40, 0xef, 0xf7, 0x0a, 0x78,
+	0xb2, 0x9f, 0x57, 0x3d, 0xca, 0x70, 0x46, 0xe0, 0x2e, 0xd0, 0x12, 0x9c, 0xe2, 0x88, 0xe9, 0x4a,
+	0x57, 0xe9, 0x6f, 0xbd, 0x7a, 0xdf, 0x5c, 0x7b, 0xc5, 0x9c, 0x8a, 0xd0, 0x9e, 0x7a, 0xf9, 0x67,
+	0xa7, 0x84, 0x24, 0x10, 0x7e, 0x00, 0x34, 0xec, 0x45, 0x41, 0xcc, 0xf4, 0x72, 0xb7, 0xd2, 0x6f,
+	0x20, 0x69, 0x41, 0x1d, 0xd4, 0x70, 0x18, 0xd2, 0x1f, 0x88, 0xa7, 0x57, 0x44, 0xa0, 0x30, 0xe1,
+	0x4b, 0x50, 0x4d, 0x69, 0x48, 0x98, 0xae, 0x76, 0x2b, 0xfd, 0xad, 0x57, 0xcf, 0x36, 0xde, 0x40,
+	0x34, 0x24, 0xf2, 0x85, 0x1c, 0x05, 0x47, 0xe0, 0x3d, 0x72, 0x9e, 0x04, 0x69, 0x10, 0xfb, 0xae,
+	0x7c, 0xa9, 0x2a, 0x12, 0xdb, 0x1b, 0x89, 0x8e, 0xc4, 0x38, 0x71, 0x96, 0x2e, 0x65, 0x85, 0xa7,
+	0x45, 0xa2, 0x9d, 0x73, 0x7a, 0x0b, 0x5a, 0xb7, 0xa5, 0x24, 0x39, 0xed, 0x91, 0xb5, 0x56, 0x24,
+	0x6c, 0xd9, 0xc6, 0x17, 0xa0, 0xb6, 0x08, 0x58, 0x46, 0xd3, 0xa5, 0x5e, 0x13, 0x35, 0xb6, 0x37,
+	0x6a, 0x0c, 0x16, 0x38, 0xf6, 0x09, 0x22, 0x73, 0x9a, 0x7a, 0xb2, 0x44, 0x81, 0xe7, 0x2d, 0x89,
+	0x4e, 0xdc, 0x24, 0xa5, 0x09, 0x65, 0x38, 0x64, 0x7a, 0xfd, 0x1e, 0x1a, 0x82, 0xf5, 0x54, 0x42,
+	0x8a, 0x96, 0xf0, 0xba, 0x93, 0xf5, 0x5e, 0x03, 0x95, 0x4b, 0x06, 0x21, 0x50, 0x63, 0x1c, 0x11,
+	0x31, 0xb7, 0x06, 0x12, 0xdf, 0x7c, 0x04, 0x11, 0x89, 0x66, 0x24, 0x2d, 0x66, 0x53, 0x98, 0xbd,
+	0x5f, 0x15, 0xa0, 0x4d, 0x57, 0xf3, 0x23, 0x31, 0x9e, 0x85, 0x79, 0x6a, 0x1d, 0x49, 0x0b, 0xee,
+	0x00, 0xc0, 0x19, 0xae, 0x66, 0xab, 0xf4, 0x9b, 0xa8, 0x11, 0x05, 0xb1, 0x94, 0xf2, 0x63, 0x90,
+	0x33, 0x71, 0x17, 0x38, 0xf6, 0xe8, 0x19, 0x49, 0xf5, 0x8a, 0x48, 0x6f, 0x0a, 0xef, 0x1b, 0xe9,
+	0x84, 0x2f, 0xc0, 0x33, 0xd9, 0xb4, 0x9b, 0x92, 0x8c, 0xc4, 0x59, 0x40, 0x63, 0x5d, 0xed, 0x2a,
+	0x7d, 0x15, 0xb5, 0x64, 0x00, 0x15, 0xfe, 0xde, 0x2f, 0x0a, 0x68, 0x6e, 0xf4, 0x0c, 0x9f, 0x83,
+	0xaa, 0xa8, 0x27, 0xdb, 0xca, 0x0d, 0xd8, 0x06, 0xf5, 0x5c, 0x38, 0x92, 0x0a, 0x62, 0x0d, 0xb4,
+	0xb2, 0x79, 0xcf, 0x29, 0x49, 0x42, 0x3c, 0x27, 0x92, 0x50, 0x61, 0xf2, 0xdd, 0x16, 0x23, 0x5c,
+	0x8a, 0xf7, 0xff, 0xbb, 0xdb, 0x62, 0xe4, 0xc5, 0xac, 0x25, 0xb0, 0x77, 0x02, 0xb4, 0xdc, 0x0f,
+	0x5f, 0x03, 0x95, 0x1f, 0x8f, 0x3c, 0x8b, 0xb6, 0x99, 0x5f, 0x96, 0x59, 0x5c, 0x96, 0x79, 0x5c,
+	0x5c, 0xd6, 0x9e, 0x7a, 0xf1, 0x57, 0x47, 0x41, 0x02, 0xcd, 0xb5, 0x5d, 0x90, 0xc0, 0x5f, 0x64,
+	0x82, 0x66, 0x05, 0x49, 0xab, 0xf7, 0x2d, 0x68, 0x6e, 0xac, 0x98, 0x38, 0x16, 0xcf, 0x4b, 0x09,
+	0x63, 0xb2, 0xd3, 0xc2, 0x5c, 0x63, 0x5d, 0x7e, 0x2c, 0xeb, 0x9f, 0xca, 0xe0, 0xc9, 0xfa, 0xf6,
+	0xfd, 0x7f, 0x75, 0x3c, 0x17, 0x33, 0xe1, 0xd5, 0x9f, 0xde, 0xbb, 0xc2, 0xb6, 0x00, 0x20, 0x09,
+	0xe4, 0x3d, 0x31, 0x12, 0x7b, 0x72, 0xe0, 0x0d, 0x24, 0x2d, 0xbe, 0x80, 0xfc, 0x5e, 0x85, 0xb8,
+	0x0d, 0x24, 0xbe, 0xe1, 0x8b, 0x15, 0xf9, 0xea, 0x83, 0xe4, 0x0b, 0xda, 0x6b, 0x62, 0x69, 0xeb,
+	0x62, 0xc1, 0xcf, 0xa5, 0xf4, 0xb5, 0x77, 0x4a, 0x5f, 0xe7, 0x32, 0xdc, 0xca, 0xff, 0xe9, 0x3f,
+	0x4a, 0x21, 0x44, 0xde, 0x03, 0xdc, 0x01, 0xdb, 0x83, 0x37, 0xf6, 0x78, 0xdf, 0x71, 0xed, 0xc1,
+	0xf1, 0x68, 0x32, 0x76, 0xbf, 0x1a, 0x1f, 0x4d, 0x9d, 0xc1, 0xe8, 0xcb, 0x91, 0x33, 0x6c, 0x95,
+	0xee, 0x86, 0xed, 0xe1, 0xd0, 0x19, 0xba, 0xf6, 0xf0, 0x70, 0x34, 0x6e, 0x29, 0xb0, 0x03, 0x3e,
+	0xdc, 0x0c, 0x23, 0xe7, 0x70, 0x72, 0xb2, 0x02, 0x94, 0xef, 0x02, 0x64, 0xfe, 0xc1, 0xc1, 0xe4,
+	0x6b, 0x67, 0xd8, 0xaa, 0xc0, 0x8f, 0xc0, 0xce, 0x03, 0x15, 0x24, 0x44, 0x85, 0x06, 0x68, 0x6f,
+	0x42, 0xf6, 0x91, 0x3d, 0x3e, 0x76, 0x86, 0x2e, 0x9a, 0x1c, 0x38, 0xad, 0xea, 0xdd, 0x38, 0x72,
+	0x4e, 0x26, 0x6f, 0x8b, 0xb8, 0xd6, 0x56, 0x7f, 0xfe, 0xcd, 0x28, 0xed, 0xd9, 0x97, 0xd7, 0x86,
+	0x72, 0x75, 0x6d, 0x28, 0x7f, 0x5f, 0x1b, 0xca, 0xc5, 0x8d, 0x51, 0xba, 0xba, 0x31, 0x4a, 0x7f,
+	0xdc, 0x18, 0xa5, 0x6f, 0x3e, 0xf1, 0x83, 0x6c, 0x71, 0x3a, 0x33, 0xe7, 0x34, 0xb2, 0xf8, 0x30,
+	0xce, 0x97, 0x3f, 0x8a, 0xdf, 0x97, 0xcc, 0xfb, 0xde, 0x3a, 0x17, 0x7f, 0x35, 0xd9, 0x32, 0x21,
+	0x6c, 0xa6, 0x09, 0x81, 0x3f, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x53, 0x83, 0xad, 0x83,
+	0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -176,6 +675,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AdminProposals) > 0 {
+		for iNdEx := len(m.AdminProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdminProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ExpiringAllowed) > 0 {
+		for iNdEx := len(m.ExpiringAllowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpiringAllowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ExpiringAdmins) > 0 {
+		for iNdEx := len(m.ExpiringAdmins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpiringAdmins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Allowed) > 0 {
 		for iNdEx := len(m.Allowed) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Allowed[iNdEx])
@@ -207,7 +776,7 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *Role) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -217,7 +786,46 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *Role) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Role) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -227,6 +835,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HistoryRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HistoryRetention))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AdminHandover {
+		i--
+		if m.AdminHandover {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinAdmins != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinAdmins))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Enable {
 		i--
 		if m.Enable {
@@ -240,6 +868,215 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AdminProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Replace {
+		i--
+		if m.Replace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Expiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Expiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Expiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Time != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintGenesis(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExpiringEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpiringEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpiringEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x3a
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Expiry != nil {
+		{
+			size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Action != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -271,6 +1108,55 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExpiringAdmins) > 0 {
+		for _, e := range m.ExpiringAdmins {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExpiringAllowed) > 0 {
+		for _, e := range m.ExpiringAllowed {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AdminProposals) > 0 {
+		for _, e := range m.AdminProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Role) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -283,6 +1169,101 @@ func (m *Params) Size() (n int) {
 	if m.Enable {
 		n += 2
 	}
+	if m.MinAdmins != 0 {
+		n += 1 + sovGenesis(uint64(m.MinAdmins))
+	}
+	if m.AdminHandover {
+		n += 2
+	}
+	if m.HistoryRetention != 0 {
+		n += 1 + sovGenesis(uint64(m.HistoryRetention))
+	}
+	return n
+}
+
+func (m *AdminProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Replace {
+		n += 2
+	}
+	l = m.Expiry.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Expiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ExpiringEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Expiry.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ChangeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovGenesis(uint64(m.Action))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Expiry != nil {
+		l = m.Expiry.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -418,12 +1399,811 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Allowed = append(m.Allowed, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, Role{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiringAdmins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiringAdmins = append(m.ExpiringAdmins, ExpiringEntry{})
+			if err := m.ExpiringAdmins[len(m.ExpiringAdmins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiringAllowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiringAllowed = append(m.ExpiringAllowed, ExpiringEntry{})
+			if err := m.ExpiringAllowed[len(m.ExpiringAllowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, ChangeRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminProposals = append(m.AdminProposals, AdminProposal{})
+			if err := m.AdminProposals[len(m.AdminProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Role) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Role: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Role: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enable = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAdmins", wireType)
+			}
+			m.MinAdmins = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinAdmins |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminHandover", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AdminHandover = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetention", wireType)
+			}
+			m.HistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replace = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Expiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Expiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Expiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpiringEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpiringEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpiringEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
@@ -439,7 +2219,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ChangeRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -462,17 +2242,49 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangeRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var v int
+			m.Action = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -482,12 +2294,163 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Action |= ChangeAction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enable = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = &Expiry{}
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
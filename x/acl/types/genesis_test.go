@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -83,6 +84,191 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with roles",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Roles: []Role{
+					{Name: RoleACLManager, Members: []string{"cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"}},
+					{Name: "custom-role", Members: []string{"cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"}},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - bad role name",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Roles:  []Role{{Name: "Bad Role"}},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicate role",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Roles:  []Role{{Name: RoleDeployer}, {Name: RoleDeployer}},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - bad role member address",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Roles:  []Role{{Name: RoleDeployer, Members: []string{"abcd"}}},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with expiring entries",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				Admins:          []string{"cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", "cosmos1wdjkxmmwv30kzerdd9h97h6lta047h6l4p43d4"},
+				Allowed:         []string{"cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"},
+				ExpiringAdmins:  []ExpiringEntry{{Address: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", Expiry: *NewHeightExpiry(10)}},
+				ExpiringAllowed: []ExpiringEntry{{Address: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", Expiry: *NewTimeExpiry(time.Unix(1e9, 0))}},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - expiring admin not listed",
+			genState: &GenesisState{
+				Params:         DefaultParams(),
+				ExpiringAdmins: []ExpiringEntry{{Address: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", Expiry: *NewHeightExpiry(10)}},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicate expiring allowed",
+			genState: &GenesisState{
+				Params:  DefaultParams(),
+				Allowed: []string{"cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"},
+				ExpiringAllowed: []ExpiringEntry{
+					{Address: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", Expiry: *NewHeightExpiry(10)},
+					{Address: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", Expiry: *NewHeightExpiry(20)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - expiry with time and height",
+			genState: &GenesisState{
+				Params:  DefaultParams(),
+				Allowed: []string{"cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"},
+				ExpiringAllowed: []ExpiringEntry{{
+					Address: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc",
+					Expiry:  Expiry{Time: NewTimeExpiry(time.Unix(1e9, 0)).Time, Height: 10},
+				}},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - empty expiry",
+			genState: &GenesisState{
+				Params:         DefaultParams(),
+				Admins:         []string{"cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"},
+				ExpiringAdmins: []ExpiringEntry{{Address: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"}},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with history",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				History: []ChangeRecord{
+					{Address: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", Action: CHANGE_ACTION_ADDED_ADMIN, Sender: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", Height: 1},
+					{Address: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", Action: CHANGE_ACTION_GRANTED_ROLE, Role: RoleDeployer, Height: 2},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - history without action",
+			genState: &GenesisState{
+				Params:  DefaultParams(),
+				History: []ChangeRecord{{Address: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"}},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - history with bad address",
+			genState: &GenesisState{
+				Params:  DefaultParams(),
+				History: []ChangeRecord{{Address: "abcd", Action: CHANGE_ACTION_ADDED_ALLOWED}},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - history role without role change",
+			genState: &GenesisState{
+				Params:  DefaultParams(),
+				History: []ChangeRecord{{Address: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", Action: CHANGE_ACTION_ADDED_ALLOWED, Role: RoleDeployer}},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - min admins",
+			genState: &GenesisState{
+				Params: Params{MinAdmins: 1},
+				Admins: []string{"cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - only admins with expiry",
+			genState: &GenesisState{
+				Params:         DefaultParams(),
+				Admins:         []string{"cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"},
+				ExpiringAdmins: []ExpiringEntry{{Address: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", Expiry: *NewHeightExpiry(10)}},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - fewer admins than the minimum",
+			genState: &GenesisState{
+				Params:         Params{MinAdmins: 1},
+				Admins:         []string{"cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"},
+				ExpiringAdmins: []ExpiringEntry{{Address: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", Expiry: *NewHeightExpiry(10)}},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with admin proposals",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				AdminProposals: []AdminProposal{
+					{Admin: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", Proposer: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", Replace: true, Expiry: *NewHeightExpiry(100)},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicate admin proposal",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				AdminProposals: []AdminProposal{
+					{Admin: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", Proposer: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", Expiry: *NewHeightExpiry(100)},
+					{Admin: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", Proposer: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", Expiry: *NewHeightExpiry(100)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - admin proposal without proposer",
+			genState: &GenesisState{
+				Params:         DefaultParams(),
+				AdminProposals: []AdminProposal{{Admin: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", Expiry: *NewHeightExpiry(100)}},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - admin proposal without expiry",
+			genState: &GenesisState{
+				Params:         DefaultParams(),
+				AdminProposals: []AdminProposal{{Admin: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", Proposer: "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"}},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid genesis - bad allowed address",
 			genState: &GenesisState{
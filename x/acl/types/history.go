@@ -0,0 +1,44 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a basic validation of a change record.
+func (r ChangeRecord) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return fmt.Errorf("address invalid: %w", err)
+	}
+	if _, ok := ChangeAction_name[int32(r.Action)]; !ok || r.Action == CHANGE_ACTION_UNSPECIFIED {
+		return fmt.Errorf("invalid action %d", r.Action)
+	}
+	if r.Sender != "" {
+		if _, err := sdk.AccAddressFromBech32(r.Sender); err != nil {
+			return fmt.Errorf("sender address invalid: %w", err)
+		}
+	}
+
+	switch r.Action {
+	case CHANGE_ACTION_GRANTED_ROLE, CHANGE_ACTION_REVOKED_ROLE:
+		if err := ValidateRole(r.Role); err != nil {
+			return err
+		}
+	default:
+		if r.Role != "" {
+			return errors.New("role set on a change not granting or revoking a role")
+		}
+	}
+	if r.Expiry != nil {
+		if err := r.Expiry.Validate(); err != nil {
+			return err
+		}
+	}
+	if r.Height < 0 {
+		return fmt.Errorf("negative height: %d", r.Height)
+	}
+
+	return nil
+}
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "acl"
@@ -15,10 +20,87 @@ const (
 const (
 	prefixAllowed = iota + 1
 	prefixAdmins
+	prefixRoles
+	prefixExpiryByTime
+	prefixExpiryByHeight
+	prefixHistory
+	prefixHistorySequence
+	prefixAdminProposals
+	prefixParams
+	prefixHistoryByHeight
 )
 
 // KVStore key prefixes
 var (
 	KeyPrefixAdmins  = []byte{prefixAdmins}
 	KeyPrefixAllowed = []byte{prefixAllowed}
+	KeyPrefixRoles   = []byte{prefixRoles}
+
+	KeyPrefixExpiryByTime   = []byte{prefixExpiryByTime}
+	KeyPrefixExpiryByHeight = []byte{prefixExpiryByHeight}
+
+	KeyPrefixHistory         = []byte{prefixHistory}
+	KeyHistorySequence       = []byte{prefixHistorySequence}
+	KeyPrefixHistoryByHeight = []byte{prefixHistoryByHeight}
+
+	KeyPrefixAdminProposals = []byte{prefixAdminProposals}
+
+	KeyParams = []byte{prefixParams}
 )
+
+// RoleKeyPrefix returns the store key prefix of the members of a role.
+func RoleKeyPrefix(role string) []byte {
+	return append(KeyPrefixRoles, address.MustLengthPrefix([]byte(role))...)
+}
+
+// ExpiryQueueKey returns the key indexing the expiry of an entry of the admin
+// or allowed list, ordered by expiry time or height.
+func ExpiryQueueKey(expiry Expiry, list []byte, addr sdk.AccAddress) []byte {
+	var key []byte
+	if expiry.Time != nil {
+		key = append(key, KeyPrefixExpiryByTime...)
+		key = append(key, sdk.FormatTimeBytes(*expiry.Time)...)
+	} else {
+		key = append(key, KeyPrefixExpiryByHeight...)
+		key = append(key, sdk.Uint64ToBigEndian(uint64(expiry.Height))...)
+	}
+	key = append(key, list...)
+
+	return append(key, addr...)
+}
+
+// HistoryKeyPrefix returns the store key prefix of the change records of an
+// address.
+func HistoryKeyPrefix(addr sdk.AccAddress) []byte {
+	return append(KeyPrefixHistory, address.MustLengthPrefix(addr)...)
+}
+
+// HistoryKey returns the store key of a change record of an address, ordered
+// by height and then by sequence.
+func HistoryKey(addr sdk.AccAddress, height int64, sequence uint64) []byte {
+	key := HistoryKeyPrefix(addr)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(height))...)
+
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// HistoryQueueKey returns the key indexing a change record by height, so that
+// the records are pruned in height order.
+func HistoryQueueKey(height int64, addr sdk.AccAddress, sequence uint64) []byte {
+	key := append([]byte{}, KeyPrefixHistoryByHeight...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(height))...)
+	key = append(key, address.MustLengthPrefix(addr)...)
+
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// ParseHistoryQueueKey returns the height, address and sequence of a change
+// record from its HistoryQueueKey, without the prefix.
+func ParseHistoryQueueKey(key []byte) (height int64, addr sdk.AccAddress, sequence uint64) {
+	height = int64(sdk.BigEndianToUint64(key[:8]))
+	addrLen := int(key[8])
+	addr = sdk.AccAddress(key[9 : 9+addrLen])
+	sequence = sdk.BigEndianToUint64(key[9+addrLen:])
+
+	return height, addr, sequence
+}
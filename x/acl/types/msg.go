@@ -13,6 +13,12 @@ var (
 	_ sdk.Msg = &MsgRemoveAdmins{}
 	_ sdk.Msg = &MsgEnable{}
 	_ sdk.Msg = &MsgDisable{}
+	_ sdk.Msg = &MsgGrantRole{}
+	_ sdk.Msg = &MsgRevokeRole{}
+	_ sdk.Msg = &MsgProposeAdmin{}
+	_ sdk.Msg = &MsgAcceptAdmin{}
+	_ sdk.Msg = &MsgCancelAdminProposal{}
+	_ sdk.Msg = &MsgUpdateParams{}
 )
 
 const (
@@ -22,6 +28,12 @@ const (
 	TypeMsgRemoveAdmins  = "remove_admins"
 	TypeMsgEnable        = "enable"
 	TypeMsgDisable       = "disable"
+	TypeMsgGrantRole     = "grant_role"
+	TypeMsgRevokeRole    = "revoke_role"
+	TypeMsgProposeAdmin  = "propose_admin"
+	TypeMsgAcceptAdmin   = "accept_admin"
+	TypeMsgCancelAdmin   = "cancel_admin_proposal"
+	TypeMsgUpdateParams  = "update_params"
 )
 
 // NewMsgAddAllowed creates a new instance of MsgAddAllowed
@@ -44,6 +56,11 @@ func (msg MsgAddAllowed) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrap(err, "invalid sender address")
 	}
+	if msg.Expiry != nil {
+		if err := msg.Expiry.Validate(); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
 	for _, addr := range msg.Allowed {
 		_, err := sdk.AccAddressFromBech32(addr)
 		if err != nil {
@@ -102,6 +119,11 @@ func (msg MsgAddAdmins) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrap(err, "invalid sender address")
 	}
+	if msg.Expiry != nil {
+		if err := msg.Expiry.Validate(); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
 	for _, addr := range msg.Admins {
 		_, err := sdk.AccAddressFromBech32(addr)
 		if err != nil {
@@ -183,3 +205,172 @@ func (msg MsgDisable) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgGrantRole creates a new instance of MsgGrantRole
+func NewMsgGrantRole(sender string, role string, addresses ...string) *MsgGrantRole { // nolint: interfacer
+	return &MsgGrantRole{
+		Sender:    sender,
+		Role:      role,
+		Addresses: addresses,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgGrantRole) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgGrantRole) Type() string { return TypeMsgGrantRole }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgGrantRole) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if err := ValidateRole(msg.Role); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	for _, addr := range msg.Addresses {
+		_, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address '%s'", addr)
+		}
+	}
+	return nil
+}
+
+// NewMsgRevokeRole creates a new instance of MsgRevokeRole
+func NewMsgRevokeRole(sender string, role string, addresses ...string) *MsgRevokeRole { // nolint: interfacer
+	return &MsgRevokeRole{
+		Sender:    sender,
+		Role:      role,
+		Addresses: addresses,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRevokeRole) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRevokeRole) Type() string { return TypeMsgRevokeRole }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRevokeRole) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if err := ValidateRole(msg.Role); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	for _, addr := range msg.Addresses {
+		_, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address '%s'", addr)
+		}
+	}
+	return nil
+}
+
+// NewMsgProposeAdmin creates a new instance of MsgProposeAdmin
+func NewMsgProposeAdmin(sender string, admin string, replace bool) *MsgProposeAdmin { // nolint: interfacer
+	return &MsgProposeAdmin{
+		Sender:  sender,
+		Admin:   admin,
+		Replace: replace,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgProposeAdmin) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgProposeAdmin) Type() string { return TypeMsgProposeAdmin }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgProposeAdmin) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address '%s'", msg.Admin)
+	}
+	if msg.Admin == msg.Sender {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot propose the sender as admin")
+	}
+	return nil
+}
+
+// NewMsgAcceptAdmin creates a new instance of MsgAcceptAdmin
+func NewMsgAcceptAdmin(sender string) *MsgAcceptAdmin { // nolint: interfacer
+	return &MsgAcceptAdmin{
+		Sender: sender,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgAcceptAdmin) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgAcceptAdmin) Type() string { return TypeMsgAcceptAdmin }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgAcceptAdmin) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	return nil
+}
+
+// NewMsgCancelAdminProposal creates a new instance of MsgCancelAdminProposal
+func NewMsgCancelAdminProposal(sender string, admin string) *MsgCancelAdminProposal { // nolint: interfacer
+	return &MsgCancelAdminProposal{
+		Sender: sender,
+		Admin:  admin,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCancelAdminProposal) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCancelAdminProposal) Type() string { return TypeMsgCancelAdmin }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelAdminProposal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address '%s'", msg.Admin)
+	}
+	return nil
+}
+
+// NewMsgUpdateParams creates a new instance of MsgUpdateParams
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams { // nolint: interfacer
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	return msg.Params.Validate()
+}
@@ -8,9 +8,17 @@ import (
 
 // Parameter store key
 var (
-	ParamStoreKeyEnable = []byte("Enable")
+	ParamStoreKeyEnable        = []byte("Enable")
+	ParamStoreKeyMinAdmins     = []byte("MinAdmins")
+	ParamStoreKeyAdminHandover = []byte("AdminHandover")
+
+	ParamStoreKeyHistoryRetention = []byte("HistoryRetention")
 )
 
+// DefaultHistoryRetention is the default number of blocks the change records
+// are kept for.
+const DefaultHistoryRetention uint64 = 1_000_000
+
 var _ paramtypes.ParamSet = &Params{}
 
 // ParamKeyTable returns the parameter key table.
@@ -22,6 +30,9 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyEnable, &p.Enable, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyMinAdmins, &p.MinAdmins, validateUint32),
+		paramtypes.NewParamSetPair(ParamStoreKeyAdminHandover, &p.AdminHandover, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyHistoryRetention, &p.HistoryRetention, validateUint64),
 	}
 }
 
@@ -48,6 +59,54 @@ func validateBool(i interface{}) error {
 	return nil
 }
 
+func validateUint32(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateUint64(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// Retention returns the number of blocks the change records are kept for,
+// the default retention if history_retention is not set.
+func (p Params) Retention() uint64 {
+	if p.HistoryRetention == 0 {
+		return DefaultHistoryRetention
+	}
+	return p.HistoryRetention
+}
+
+// MinPermanentAdmins returns the minimum number of admins without expiry:
+// min_admins, and at least one as the last one cannot be removed.
+func (p Params) MinPermanentAdmins() uint32 {
+	return max(p.MinAdmins, 1)
+}
+
+// ValidatePermanentAdmins checks that count admins without expiry are not
+// fewer than MinPermanentAdmins. An ACL without any admin and without
+// min_admins, e.g. with the default genesis, is managed by the authority alone
+// and passes.
+func (p Params) ValidatePermanentAdmins(count uint32, hasAdmins bool) error {
+	if !hasAdmins && p.MinAdmins == 0 {
+		return nil
+	}
+	if minAdmins := p.MinPermanentAdmins(); count < minAdmins {
+		return fmt.Errorf("%d admins without expiry, fewer than the minimum of %d", count, minAdmins)
+	}
+
+	return nil
+}
+
 func (p Params) Validate() error {
 	return nil
 }
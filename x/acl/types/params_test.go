@@ -49,3 +49,17 @@ func TestParamsValidateBool(t *testing.T) {
 	err = validateBool(int64(123))
 	assert.Error(t, err)
 }
+
+func TestParamsValidateUint32(t *testing.T) {
+	err := validateUint32(uint32(2))
+	assert.NoError(t, err)
+	err = validateUint32(2)
+	assert.Error(t, err)
+	err = validateUint32(uint64(2))
+	assert.Error(t, err)
+}
+
+func TestParamsRetention(t *testing.T) {
+	assert.Equal(t, DefaultHistoryRetention, Params{}.Retention())
+	assert.Equal(t, uint64(10), Params{HistoryRetention: 10}.Retention())
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -29,6 +30,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AdminKind is the kind of account an admin is.
+type AdminKind int32
+
+const (
+	ADMIN_KIND_UNSPECIFIED AdminKind = 0
+	// ADMIN_KIND_ACCOUNT is a single key account.
+	ADMIN_KIND_ACCOUNT AdminKind = 1
+	// ADMIN_KIND_GROUP_POLICY is an x/group policy account.
+	ADMIN_KIND_GROUP_POLICY AdminKind = 2
+	// ADMIN_KIND_MULTISIG is a legacy amino multisig account. It is only
+	// recognized once it has signed a transaction and its public key is known.
+	ADMIN_KIND_MULTISIG AdminKind = 3
+)
+
+var AdminKind_name = map[int32]string{
+	0: "ADMIN_KIND_UNSPECIFIED",
+	1: "ADMIN_KIND_ACCOUNT",
+	2: "ADMIN_KIND_GROUP_POLICY",
+	3: "ADMIN_KIND_MULTISIG",
+}
+
+var AdminKind_value = map[string]int32{
+	"ADMIN_KIND_UNSPECIFIED":  0,
+	"ADMIN_KIND_ACCOUNT":      1,
+	"ADMIN_KIND_GROUP_POLICY": 2,
+	"ADMIN_KIND_MULTISIG":     3,
+}
+
+func (x AdminKind) String() string {
+	return proto.EnumName(AdminKind_name, int32(x))
+}
+
+func (AdminKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0cedc311d1d5d775, []int{0}
+}
+
 type QueryParamsRequest struct {
 }
 
@@ -110,6 +147,8 @@ func (m *QueryParamsResponse) GetParams() Params {
 }
 
 type QueryListAdminsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryListAdminsRequest) Reset()         { *m = QueryListAdminsRequest{} }
@@ -145,8 +184,19 @@ func (m *QueryListAdminsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryListAdminsRequest proto.InternalMessageInfo
 
+func (m *QueryListAdminsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryListAdminsResponse struct {
 	Admins []string `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// expiring are the expiries of the listed admins that have one.
+	Expiring []ExpiringEntry `protobuf:"bytes,3,rep,name=expiring,proto3" json:"expiring"`
 }
 
 func (m *QueryListAdminsResponse) Reset()         { *m = QueryListAdminsResponse{} }
@@ -189,7 +239,23 @@ func (m *QueryListAdminsResponse) GetAdmins() []string {
 	return nil
 }
 
+func (m *QueryListAdminsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryListAdminsResponse) GetExpiring() []ExpiringEntry {
+	if m != nil {
+		return m.Expiring
+	}
+	return nil
+}
+
 type QueryListAllowedRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryListAllowedRequest) Reset()         { *m = QueryListAllowedRequest{} }
@@ -225,8 +291,19 @@ func (m *QueryListAllowedRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryListAllowedRequest proto.InternalMessageInfo
 
+func (m *QueryListAllowedRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryListAllowedResponse struct {
 	Allowed []string `protobuf:"bytes,1,rep,name=allowed,proto3" json:"allowed,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// expiring are the expiries of the listed addresses that have one.
+	Expiring []ExpiringEntry `protobuf:"bytes,3,rep,name=expiring,proto3" json:"expiring"`
 }
 
 func (m *QueryListAllowedResponse) Reset()         { *m = QueryListAllowedResponse{} }
@@ -269,458 +346,2962 @@ func (m *QueryListAllowedResponse) GetAllowed() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "saga.acl.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "saga.acl.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryListAdminsRequest)(nil), "saga.acl.v1.QueryListAdminsRequest")
-	proto.RegisterType((*QueryListAdminsResponse)(nil), "saga.acl.v1.QueryListAdminsResponse")
-	proto.RegisterType((*QueryListAllowedRequest)(nil), "saga.acl.v1.QueryListAllowedRequest")
-	proto.RegisterType((*QueryListAllowedResponse)(nil), "saga.acl.v1.QueryListAllowedResponse")
+func (m *QueryListAllowedResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("saga/acl/v1/query.proto", fileDescriptor_0cedc311d1d5d775) }
+func (m *QueryListAllowedResponse) GetExpiring() []ExpiringEntry {
+	if m != nil {
+		return m.Expiring
+	}
+	return nil
+}
 
-var fileDescriptor_0cedc311d1d5d775 = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xc1, 0x4e, 0xea, 0x40,
-	0x14, 0x6d, 0x79, 0xef, 0xf5, 0xe5, 0x0d, 0x8b, 0xa7, 0x03, 0x81, 0xd2, 0x98, 0x42, 0x2a, 0x46,
-	0x36, 0x76, 0x52, 0xf4, 0x07, 0x60, 0xe5, 0xc2, 0x85, 0xb2, 0x74, 0x37, 0xd0, 0x49, 0x6d, 0x2c,
-	0x9d, 0xc2, 0x0c, 0x08, 0x2e, 0xfd, 0x02, 0x13, 0x7f, 0x8a, 0x25, 0x89, 0x31, 0x71, 0x65, 0x0c,
-	0xf8, 0x21, 0x86, 0x99, 0x01, 0x4b, 0x1a, 0x74, 0xd5, 0xce, 0x39, 0xe7, 0x9e, 0x73, 0xef, 0x9d,
-	0x01, 0x65, 0x86, 0x03, 0x8c, 0x70, 0x2f, 0x42, 0x63, 0x0f, 0x0d, 0x46, 0x64, 0x38, 0x75, 0x93,
-	0x21, 0xe5, 0x14, 0xe6, 0x57, 0x84, 0x8b, 0x7b, 0x91, 0x3b, 0xf6, 0xac, 0x62, 0x40, 0x03, 0x2a,
-	0x70, 0xb4, 0xfa, 0x93, 0x12, 0xeb, 0x20, 0xa0, 0x34, 0x88, 0x08, 0xc2, 0x49, 0x88, 0x70, 0x1c,
-	0x53, 0x8e, 0x79, 0x48, 0x63, 0xa6, 0xd8, 0x4a, 0xda, 0x39, 0x20, 0x31, 0x61, 0xa1, 0xa2, 0x9c,
-	0x22, 0x80, 0x57, 0xab, 0xa8, 0x4b, 0x3c, 0xc4, 0x7d, 0xd6, 0x21, 0x83, 0x11, 0x61, 0xdc, 0x39,
-	0x07, 0x85, 0x2d, 0x94, 0x25, 0x34, 0x66, 0x04, 0x7a, 0xc0, 0x48, 0x04, 0x62, 0xea, 0x35, 0xbd,
-	0x91, 0x6f, 0x16, 0xdc, 0x54, 0x67, 0xae, 0x14, 0xb7, 0x7f, 0xcf, 0xde, 0xaa, 0x5a, 0x47, 0x09,
-	0x1d, 0x13, 0x94, 0x84, 0xd3, 0x45, 0xc8, 0x78, 0xcb, 0xef, 0x87, 0xf1, 0x26, 0xc3, 0x03, 0xe5,
-	0x0c, 0xa3, 0x72, 0x4a, 0xc0, 0xc0, 0x02, 0x31, 0xf5, 0xda, 0xaf, 0xc6, 0xbf, 0x8e, 0x3a, 0x39,
-	0x95, 0x74, 0x49, 0x14, 0xd1, 0x3b, 0xe2, 0xaf, 0xdd, 0xce, 0x80, 0x99, 0xa5, 0x94, 0x9d, 0x09,
-	0xfe, 0x62, 0x09, 0x29, 0xbf, 0xf5, 0xb1, 0xf9, 0x92, 0x03, 0x7f, 0x44, 0x19, 0xf4, 0x81, 0x21,
-	0xfb, 0x87, 0xd5, 0xad, 0xa1, 0xb2, 0xcb, 0xb1, 0x6a, 0xbb, 0x05, 0x32, 0xd0, 0x29, 0x3f, 0x3c,
-	0x7f, 0x3c, 0xe5, 0xf6, 0xe1, 0x7f, 0x24, 0x16, 0x3f, 0xf6, 0x90, 0xdc, 0x06, 0xe4, 0x20, 0x9f,
-	0x6a, 0x10, 0xd6, 0xb3, 0x4e, 0xd9, 0xd1, 0xac, 0xa3, 0x1f, 0x54, 0x2a, 0xd4, 0x14, 0xa1, 0x10,
-	0xee, 0x6d, 0x42, 0xd5, 0x94, 0x30, 0x01, 0xe0, 0x6b, 0xc9, 0xf0, 0x70, 0x87, 0x5d, 0xfa, 0x72,
-	0xac, 0xfa, 0xf7, 0xa2, 0x9d, 0x73, 0xca, 0x8b, 0x6a, 0xb7, 0x66, 0x0b, 0x5b, 0x9f, 0x2f, 0x6c,
-	0xfd, 0x7d, 0x61, 0xeb, 0x8f, 0x4b, 0x5b, 0x9b, 0x2f, 0x6d, 0xed, 0x75, 0x69, 0x6b, 0xd7, 0xc7,
-	0x41, 0xc8, 0x6f, 0x46, 0x5d, 0xb7, 0x47, 0xfb, 0xa2, 0x68, 0x32, 0xbd, 0x17, 0xdf, 0x13, 0xe6,
-	0xdf, 0xa2, 0x89, 0x78, 0xa3, 0x7c, 0x9a, 0x10, 0xd6, 0x35, 0xc4, 0xfb, 0x3c, 0xfd, 0x0c, 0x00,
-	0x00, 0xff, 0xff, 0xcc, 0xd4, 0x94, 0x25, 0x16, 0x03, 0x00, 0x00,
+type QueryIsAllowedRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryIsAllowedRequest) Reset()         { *m = QueryIsAllowedRequest{} }
+func (m *QueryIsAllowedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIsAllowedRequest) ProtoMessage()    {}
+func (*QueryIsAllowedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cedc311d1d5d775, []int{6}
+}
+func (m *QueryIsAllowedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsAllowedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsAllowedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIsAllowedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsAllowedRequest.Merge(m, src)
+}
+func (m *QueryIsAllowedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsAllowedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsAllowedRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryIsAllowedRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the params
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ListAllowed returns the list of addresses that are allowed to deploy EVM
-	// contracts
-	ListAllowed(ctx context.Context, in *QueryListAllowedRequest, opts ...grpc.CallOption) (*QueryListAllowedResponse, error)
-	// ListAdmins returns the list of admin addresses
-	ListAdmins(ctx context.Context, in *QueryListAdminsRequest, opts ...grpc.CallOption) (*QueryListAdminsResponse, error)
+func (m *QueryIsAllowedRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryIsAllowedResponse struct {
+	// allowed is true if the address is allowed, which is always the case when
+	// the ACL is disabled.
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryIsAllowedResponse) Reset()         { *m = QueryIsAllowedResponse{} }
+func (m *QueryIsAllowedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIsAllowedResponse) ProtoMessage()    {}
+func (*QueryIsAllowedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cedc311d1d5d775, []int{7}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/saga.acl.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryIsAllowedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) ListAllowed(ctx context.Context, in *QueryListAllowedRequest, opts ...grpc.CallOption) (*QueryListAllowedResponse, error) {
-	out := new(QueryListAllowedResponse)
-	err := c.cc.Invoke(ctx, "/saga.acl.v1.Query/ListAllowed", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIsAllowedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsAllowedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryIsAllowedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsAllowedResponse.Merge(m, src)
+}
+func (m *QueryIsAllowedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsAllowedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsAllowedResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListAdmins(ctx context.Context, in *QueryListAdminsRequest, opts ...grpc.CallOption) (*QueryListAdminsResponse, error) {
-	out := new(QueryListAdminsResponse)
-	err := c.cc.Invoke(ctx, "/saga.acl.v1.Query/ListAdmins", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIsAllowedResponse proto.InternalMessageInfo
+
+func (m *QueryIsAllowedResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
 	}
-	return out, nil
+	return false
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the params
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ListAllowed returns the list of addresses that are allowed to deploy EVM
-	// contracts
-	ListAllowed(context.Context, *QueryListAllowedRequest) (*QueryListAllowedResponse, error)
-	// ListAdmins returns the list of admin addresses
-	ListAdmins(context.Context, *QueryListAdminsRequest) (*QueryListAdminsResponse, error)
+type QueryIsAdminRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryIsAdminRequest) Reset()         { *m = QueryIsAdminRequest{} }
+func (m *QueryIsAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIsAdminRequest) ProtoMessage()    {}
+func (*QueryIsAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cedc311d1d5d775, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryIsAdminRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ListAllowed(ctx context.Context, req *QueryListAllowedRequest) (*QueryListAllowedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAllowed not implemented")
+func (m *QueryIsAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsAdminRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListAdmins(ctx context.Context, req *QueryListAdminsRequest) (*QueryListAdminsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAdmins not implemented")
+func (m *QueryIsAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsAdminRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryIsAdminRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsAdminRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/saga.acl.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryIsAdminRequest proto.InternalMessageInfo
+
+func (m *QueryIsAdminRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ListAllowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListAllowedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListAllowed(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/saga.acl.v1.Query/ListAllowed",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListAllowed(ctx, req.(*QueryListAllowedRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryIsAdminResponse struct {
+	Admin bool `protobuf:"varint,1,opt,name=admin,proto3" json:"admin,omitempty"`
 }
 
-func _Query_ListAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListAdminsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListAdmins(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/saga.acl.v1.Query/ListAdmins",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListAdmins(ctx, req.(*QueryListAdminsRequest))
+func (m *QueryIsAdminResponse) Reset()         { *m = QueryIsAdminResponse{} }
+func (m *QueryIsAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIsAdminResponse) ProtoMessage()    {}
+func (*QueryIsAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cedc311d1d5d775, []int{9}
+}
+func (m *QueryIsAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "saga.acl.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ListAllowed",
-			Handler:    _Query_ListAllowed_Handler,
-		},
-		{
-			MethodName: "ListAdmins",
-			Handler:    _Query_ListAdmins_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "saga/acl/v1/query.proto",
+func (m *QueryIsAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsAdminResponse.Merge(m, src)
+}
+func (m *QueryIsAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsAdminResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIsAdminResponse proto.InternalMessageInfo
+
+func (m *QueryIsAdminResponse) GetAdmin() bool {
+	if m != nil {
+		return m.Admin
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AdminMember is an account acting as part of an admin.
+type AdminMember struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the voting weight of the member. Multisig members all have a
+	// weight of 1.
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *AdminMember) Reset()         { *m = AdminMember{} }
+func (m *AdminMember) String() string { return proto.CompactTextString(m) }
+func (*AdminMember) ProtoMessage()    {}
+func (*AdminMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cedc311d1d5d775, []int{10}
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AdminMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AdminMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminMember.Merge(m, src)
+}
+func (m *AdminMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminMember.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+var xxx_messageInfo_AdminMember proto.InternalMessageInfo
+
+func (m *AdminMember) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryListAdminsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AdminMember) GetWeight() string {
+	if m != nil {
+		return m.Weight
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryListAdminsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAdminMembersRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryListAdminsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAdminMembersRequest) Reset()         { *m = QueryAdminMembersRequest{} }
+func (m *QueryAdminMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminMembersRequest) ProtoMessage()    {}
+func (*QueryAdminMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cedc311d1d5d775, []int{11}
+}
+func (m *QueryAdminMembersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminMembersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminMembersRequest.Merge(m, src)
+}
+func (m *QueryAdminMembersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminMembersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListAdminsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAdminMembersRequest proto.InternalMessageInfo
+
+func (m *QueryAdminMembersRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryListAdminsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAdminMembersResponse struct {
+	Kind AdminKind `protobuf:"varint,1,opt,name=kind,proto3,enum=saga.acl.v1.AdminKind" json:"kind,omitempty"`
+	// members are the accounts behind a group policy or a multisig admin.
+	Members []AdminMember `protobuf:"bytes,2,rep,name=members,proto3" json:"members"`
+	// threshold is the weight required to act as the admin. For group policies
+	// with a percentage decision policy it is the required ratio of the total
+	// weight.
+	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// group_id is the group of a group policy admin.
+	GroupId uint64 `protobuf:"varint,4,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
-func (m *QueryListAdminsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Admins) > 0 {
-		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Admins[iNdEx])
-			copy(dAtA[i:], m.Admins[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Admins[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAdminMembersResponse) Reset()         { *m = QueryAdminMembersResponse{} }
+func (m *QueryAdminMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminMembersResponse) ProtoMessage()    {}
+func (*QueryAdminMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cedc311d1d5d775, []int{12}
+}
+func (m *QueryAdminMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAdminMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminMembersResponse.Merge(m, src)
+}
+func (m *QueryAdminMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminMembersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryListAllowedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAdminMembersResponse proto.InternalMessageInfo
+
+func (m *QueryAdminMembersResponse) GetKind() AdminKind {
+	if m != nil {
+		return m.Kind
 	}
-	return dAtA[:n], nil
+	return ADMIN_KIND_UNSPECIFIED
 }
 
-func (m *QueryListAllowedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAdminMembersResponse) GetMembers() []AdminMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
 }
 
-func (m *QueryListAllowedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAdminMembersResponse) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
 }
 
-func (m *QueryListAllowedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAdminMembersResponse) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryListAllowedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryListRoleMembersRequest struct {
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
 }
 
-func (m *QueryListAllowedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Allowed) > 0 {
-		for iNdEx := len(m.Allowed) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Allowed[iNdEx])
-			copy(dAtA[i:], m.Allowed[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Allowed[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryListRoleMembersRequest) Reset()         { *m = QueryListRoleMembersRequest{} }
+func (m *QueryListRoleMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListRoleMembersRequest) ProtoMessage()    {}
+func (*QueryListRoleMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cedc311d1d5d775, []int{13}
+}
+func (m *QueryListRoleMembersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListRoleMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListRoleMembersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryListRoleMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListRoleMembersRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryListRoleMembersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListRoleMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListRoleMembersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryListRoleMembersRequest proto.InternalMessageInfo
+
+func (m *QueryListRoleMembersRequest) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryListAdminsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type QueryListRoleMembersResponse struct {
+	Members []string `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
 }
 
-func (m *QueryListAdminsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Admins) > 0 {
-		for _, s := range m.Admins {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryListRoleMembersResponse) Reset()         { *m = QueryListRoleMembersResponse{} }
+func (m *QueryListRoleMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListRoleMembersResponse) ProtoMessage()    {}
+func (*QueryListRoleMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cedc311d1d5d775, []int{14}
+}
+func (m *QueryListRoleMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListRoleMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListRoleMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryListRoleMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListRoleMembersResponse.Merge(m, src)
+}
+func (m *QueryListRoleMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListRoleMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListRoleMembersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryListAllowedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryListRoleMembersResponse proto.InternalMessageInfo
+
+func (m *QueryListRoleMembersResponse) GetMembers() []string {
+	if m != nil {
+		return m.Members
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryListAllowedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Allowed) > 0 {
-		for _, s := range m.Allowed {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+type QueryHistoryRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHistoryRequest) Reset()         { *m = QueryHistoryRequest{} }
+func (m *QueryHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoryRequest) ProtoMessage()    {}
+func (*QueryHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cedc311d1d5d775, []int{15}
+}
+func (m *QueryHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoryRequest.Merge(m, src)
+}
+func (m *QueryHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryHistoryResponse struct {
+	// history are the past changes affecting the address, ordered by height.
+	History []ChangeRecord `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHistoryResponse) Reset()         { *m = QueryHistoryResponse{} }
+func (m *QueryHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoryResponse) ProtoMessage()    {}
+func (*QueryHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cedc311d1d5d775, []int{16}
+}
+func (m *QueryHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoryResponse.Merge(m, src)
+}
+func (m *QueryHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryHistoryResponse) GetHistory() []ChangeRecord {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *QueryHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("saga.acl.v1.AdminKind", AdminKind_name, AdminKind_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "saga.acl.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "saga.acl.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryListAdminsRequest)(nil), "saga.acl.v1.QueryListAdminsRequest")
+	proto.RegisterType((*QueryListAdminsResponse)(nil), "saga.acl.v1.QueryListAdminsResponse")
+	proto.RegisterType((*QueryListAllowedRequest)(nil), "saga.acl.v1.QueryListAllowedRequest")
+	proto.RegisterType((*QueryListAllowedResponse)(nil), "saga.acl.v1.QueryListAllowedResponse")
+	proto.RegisterType((*QueryIsAllowedRequest)(nil), "saga.acl.v1.QueryIsAllowedRequest")
+	proto.RegisterType((*QueryIsAllowedResponse)(nil), "saga.acl.v1.QueryIsAllowedResponse")
+	proto.RegisterType((*QueryIsAdminRequest)(nil), "saga.acl.v1.QueryIsAdminRequest")
+	proto.RegisterType((*QueryIsAdminResponse)(nil), "saga.acl.v1.QueryIsAdminResponse")
+	proto.RegisterType((*AdminMember)(nil), "saga.acl.v1.AdminMember")
+	proto.RegisterType((*QueryAdminMembersRequest)(nil), "saga.acl.v1.QueryAdminMembersRequest")
+	proto.RegisterType((*QueryAdminMembersResponse)(nil), "saga.acl.v1.QueryAdminMembersResponse")
+	proto.RegisterType((*QueryListRoleMembersRequest)(nil), "saga.acl.v1.QueryListRoleMembersRequest")
+	proto.RegisterType((*QueryListRoleMembersResponse)(nil), "saga.acl.v1.QueryListRoleMembersResponse")
+	proto.RegisterType((*QueryHistoryRequest)(nil), "saga.acl.v1.QueryHistoryRequest")
+	proto.RegisterType((*QueryHistoryResponse)(nil), "saga.acl.v1.QueryHistoryResponse")
+}
+
+func init() { proto.RegisterFile("saga/acl/v1/query.proto", fileDescriptor_0cedc311d1d5d775) }
+
+var fileDescriptor_0cedc311d1d5d775 = []byte{
+	// 1041 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6e, 0x1b, 0x55,
+	0x14, 0xf6, 0x24, 0x6e, 0x9c, 0x1c, 0x23, 0x1a, 0x6e, 0x8c, 0x63, 0x4f, 0x82, 0xe3, 0x4e, 0x9b,
+	0xc4, 0x44, 0x74, 0x06, 0x1b, 0x16, 0x45, 0x42, 0x42, 0xa9, 0x93, 0xa6, 0xa3, 0xe6, 0xc7, 0xb8,
+	0xcd, 0x82, 0x6c, 0xc2, 0xd8, 0x73, 0x35, 0x1e, 0xd5, 0x9e, 0x71, 0xe7, 0x8e, 0x9d, 0x98, 0xd0,
+	0x0d, 0x1b, 0x40, 0xea, 0x02, 0x89, 0x1d, 0x5b, 0xc4, 0x3b, 0x20, 0x78, 0x81, 0x2e, 0x2b, 0xb1,
+	0x61, 0x85, 0x50, 0xc2, 0x83, 0xa0, 0xb9, 0x73, 0x6c, 0xcf, 0xd8, 0x13, 0x1b, 0xa1, 0x2e, 0xba,
+	0x8a, 0xef, 0xb9, 0xe7, 0xe7, 0x3b, 0xdf, 0x39, 0xf3, 0xdd, 0xc0, 0x32, 0xd3, 0x0c, 0x4d, 0xd1,
+	0xea, 0x4d, 0xa5, 0x5b, 0x54, 0x9e, 0x75, 0xa8, 0xd3, 0x93, 0xdb, 0x8e, 0xed, 0xda, 0x24, 0xe9,
+	0x5d, 0xc8, 0x5a, 0xbd, 0x29, 0x77, 0x8b, 0x62, 0xca, 0xb0, 0x0d, 0x9b, 0xdb, 0x15, 0xef, 0x97,
+	0xef, 0x22, 0xae, 0x1a, 0xb6, 0x6d, 0x34, 0xa9, 0xa2, 0xb5, 0x4d, 0x45, 0xb3, 0x2c, 0xdb, 0xd5,
+	0x5c, 0xd3, 0xb6, 0x18, 0xde, 0x6e, 0xd5, 0x6d, 0xd6, 0xb2, 0x99, 0x52, 0xd3, 0x18, 0xf5, 0x33,
+	0x2b, 0xdd, 0x62, 0x8d, 0xba, 0x5a, 0x51, 0x69, 0x6b, 0x86, 0x69, 0x71, 0x67, 0xf4, 0xcd, 0x06,
+	0x51, 0x18, 0xd4, 0xa2, 0xcc, 0xc4, 0x34, 0x52, 0x0a, 0xc8, 0xe7, 0x5e, 0x70, 0x45, 0x73, 0xb4,
+	0x16, 0xab, 0xd2, 0x67, 0x1d, 0xca, 0x5c, 0xe9, 0x21, 0x2c, 0x85, 0xac, 0xac, 0x6d, 0x5b, 0x8c,
+	0x92, 0x22, 0xcc, 0xb5, 0xb9, 0x25, 0x23, 0xe4, 0x85, 0x42, 0xb2, 0xb4, 0x24, 0x07, 0xba, 0x90,
+	0x7d, 0xe7, 0xfb, 0xf1, 0x97, 0x7f, 0xad, 0xc5, 0xaa, 0xe8, 0x28, 0x7d, 0x09, 0x69, 0x9e, 0x69,
+	0xdf, 0x64, 0xee, 0xb6, 0xde, 0x32, 0xad, 0x7e, 0x0d, 0xf2, 0x00, 0x60, 0x08, 0x14, 0x13, 0x6e,
+	0xc8, 0x7e, 0x57, 0xb2, 0xd7, 0x95, 0xec, 0xf3, 0x85, 0x5d, 0xc9, 0x15, 0xcd, 0xa0, 0x18, 0x5b,
+	0x0d, 0x44, 0x4a, 0xbf, 0x0a, 0xb0, 0x3c, 0x56, 0x02, 0x01, 0xa7, 0x61, 0x4e, 0xe3, 0x96, 0x8c,
+	0x90, 0x9f, 0x2d, 0x2c, 0x54, 0xf1, 0x44, 0xf6, 0x42, 0xb5, 0x67, 0x78, 0xed, 0xcd, 0xa9, 0xb5,
+	0xfd, 0xa4, 0xc1, 0xe2, 0xe4, 0x53, 0x98, 0xa7, 0xe7, 0x6d, 0xd3, 0x31, 0x2d, 0x23, 0x33, 0x9b,
+	0x9f, 0x2d, 0x24, 0x4b, 0x62, 0x88, 0x93, 0x5d, 0xbc, 0xdc, 0xb5, 0x5c, 0xa7, 0x87, 0xd4, 0x0c,
+	0x22, 0x24, 0x2d, 0x88, 0xbc, 0xd9, 0xb4, 0xcf, 0xa8, 0xfe, 0xba, 0xd9, 0xf9, 0x5d, 0x80, 0xcc,
+	0x78, 0x0d, 0xa4, 0x27, 0x03, 0x09, 0xcd, 0x37, 0x21, 0x3f, 0xfd, 0xe3, 0x9b, 0x42, 0x50, 0x11,
+	0xde, 0xe5, 0xe0, 0x55, 0x36, 0x42, 0x8f, 0x87, 0x5c, 0xd7, 0x1d, 0xca, 0xfc, 0x55, 0xf4, 0x90,
+	0xfb, 0x47, 0xa9, 0x84, 0x0b, 0x17, 0x08, 0x89, 0xea, 0x56, 0x28, 0xcc, 0x0f, 0xba, 0x95, 0x14,
+	0x5c, 0x77, 0x95, 0xf1, 0xfd, 0x99, 0x5e, 0xe4, 0x03, 0x48, 0x85, 0x03, 0xb0, 0x44, 0x0a, 0x6e,
+	0xf0, 0x0d, 0xc3, 0x02, 0xfe, 0x41, 0xfa, 0x0c, 0x92, 0xdc, 0xed, 0x80, 0xb6, 0x6a, 0xd4, 0xb9,
+	0x3e, 0xad, 0xb7, 0xae, 0x67, 0xd4, 0x34, 0x1a, 0x2e, 0x67, 0x7c, 0xa1, 0x8a, 0x27, 0xe9, 0x63,
+	0x9c, 0x61, 0x20, 0x0b, 0x9b, 0x0e, 0xf2, 0x37, 0x01, 0xb2, 0x11, 0x61, 0x08, 0x75, 0x0b, 0xe2,
+	0x4f, 0x4d, 0xcb, 0xa7, 0xe2, 0xed, 0x52, 0x3a, 0x34, 0x14, 0x1e, 0xf0, 0xc8, 0xb4, 0xf4, 0x2a,
+	0xf7, 0x21, 0xf7, 0x20, 0xd1, 0xf2, 0xc3, 0x33, 0x33, 0x7c, 0x86, 0x99, 0x71, 0x77, 0x3f, 0x3f,
+	0x4e, 0xb0, 0xef, 0x4e, 0x56, 0x61, 0xc1, 0x6d, 0x38, 0x94, 0x35, 0xec, 0xa6, 0x9e, 0x99, 0xe5,
+	0xf8, 0x86, 0x06, 0x92, 0x85, 0x79, 0xc3, 0xb1, 0x3b, 0xed, 0x53, 0x53, 0xcf, 0xc4, 0xf3, 0x42,
+	0x21, 0x5e, 0x4d, 0xf0, 0xb3, 0xaa, 0x4b, 0x45, 0x58, 0x19, 0xac, 0x6d, 0xd5, 0x6e, 0xd2, 0x91,
+	0xae, 0x09, 0xc4, 0x1d, 0xbb, 0x49, 0xb1, 0x65, 0xfe, 0x5b, 0xba, 0x07, 0xab, 0xd1, 0x21, 0xc3,
+	0xf9, 0xf7, 0xbb, 0xc0, 0x6d, 0xc7, 0xa3, 0x74, 0x86, 0xf3, 0x7f, 0x68, 0x32, 0xd7, 0x76, 0x7a,
+	0x53, 0xa9, 0x1d, 0xf9, 0x3a, 0x67, 0xfe, 0xf7, 0xd7, 0xf9, 0x93, 0x80, 0x8b, 0x34, 0xa8, 0x8c,
+	0x58, 0x3f, 0x81, 0x44, 0xc3, 0x37, 0x71, 0xac, 0xc9, 0x52, 0x36, 0xc4, 0x78, 0xb9, 0xa1, 0x59,
+	0x5e, 0xc6, 0xba, 0xed, 0xe8, 0x7d, 0xca, 0xd1, 0xff, 0xb5, 0x7d, 0xba, 0x5b, 0x17, 0xb0, 0x30,
+	0x58, 0x04, 0x22, 0x42, 0x7a, 0x7b, 0xe7, 0x40, 0x3d, 0x3c, 0x7d, 0xa4, 0x1e, 0xee, 0x9c, 0x1e,
+	0x1f, 0x3e, 0xae, 0xec, 0x96, 0xd5, 0x07, 0xea, 0xee, 0xce, 0x62, 0x8c, 0xa4, 0x81, 0x04, 0xee,
+	0xb6, 0xcb, 0xe5, 0xa3, 0xe3, 0xc3, 0x27, 0x8b, 0x02, 0x59, 0x81, 0xe5, 0x80, 0x7d, 0xaf, 0x7a,
+	0x74, 0x5c, 0x39, 0xad, 0x1c, 0xed, 0xab, 0xe5, 0x2f, 0x16, 0x67, 0xc8, 0x32, 0x2c, 0x05, 0x2e,
+	0x0f, 0x8e, 0xf7, 0x9f, 0xa8, 0x8f, 0xd5, 0xbd, 0xc5, 0x59, 0x31, 0xfe, 0xdd, 0xcf, 0xb9, 0x58,
+	0xe9, 0x97, 0x79, 0xb8, 0xc1, 0x99, 0x21, 0x17, 0x30, 0xe7, 0xbf, 0x2c, 0x64, 0x2d, 0xc4, 0xc1,
+	0xf8, 0xb3, 0x25, 0xe6, 0xaf, 0x77, 0xf0, 0xfb, 0x93, 0xe4, 0x6f, 0xfe, 0xf8, 0xe7, 0xc7, 0x99,
+	0xc2, 0xc9, 0x3b, 0xe4, 0xa6, 0xc2, 0x1f, 0xc5, 0xae, 0xf7, 0x5c, 0xf2, 0xec, 0x4b, 0x4a, 0xf0,
+	0x95, 0x44, 0xe3, 0x0b, 0x01, 0x92, 0x01, 0xe5, 0x24, 0x77, 0xc6, 0x2b, 0x8c, 0x8b, 0xb7, 0xb8,
+	0x3e, 0xc5, 0x0b, 0xc1, 0x7c, 0xc8, 0xc1, 0x6c, 0x9d, 0x10, 0xb2, 0x38, 0x00, 0xd3, 0x17, 0xe0,
+	0x54, 0x08, 0x4d, 0xdf, 0xfa, 0xad, 0x00, 0x30, 0x7c, 0xe6, 0xc8, 0xed, 0x6b, 0xea, 0x04, 0xdf,
+	0x59, 0xf1, 0xce, 0x64, 0xa7, 0x09, 0xc4, 0xe0, 0x63, 0x19, 0x26, 0x06, 0x8d, 0x5f, 0xc3, 0xc2,
+	0x40, 0x61, 0x89, 0x34, 0x5e, 0x62, 0x54, 0xb1, 0xc5, 0xdb, 0x13, 0x7d, 0x10, 0xc5, 0x06, 0x47,
+	0x91, 0x27, 0xb9, 0xa8, 0xee, 0x95, 0x0b, 0xfc, 0xfc, 0x9e, 0x93, 0x0e, 0x24, 0x50, 0x7a, 0x49,
+	0x3e, 0x32, 0x6f, 0x40, 0xc6, 0xc5, 0x5b, 0x13, 0x3c, 0xb0, 0xee, 0x3a, 0xaf, 0xbb, 0x46, 0xde,
+	0x8b, 0x68, 0x35, 0x50, 0xf6, 0x85, 0x00, 0x6f, 0x05, 0xc5, 0x94, 0x44, 0x0c, 0x3a, 0x42, 0xa3,
+	0xc5, 0x8d, 0x69, 0x6e, 0x08, 0xe3, 0x2e, 0x87, 0xb1, 0x49, 0xd6, 0x27, 0xc2, 0x50, 0xfa, 0xe2,
+	0xfa, 0xbd, 0x00, 0x37, 0x47, 0xc4, 0x8e, 0x14, 0xa2, 0xa7, 0x3d, 0x2e, 0xa1, 0xe2, 0xfb, 0xff,
+	0xc1, 0x13, 0x71, 0xdd, 0xe2, 0xb8, 0x56, 0x48, 0x36, 0x84, 0xcb, 0x13, 0x5d, 0xa6, 0x5c, 0x78,
+	0x7f, 0x9e, 0x93, 0x2e, 0x24, 0x50, 0xc3, 0xa2, 0x26, 0x12, 0x16, 0xd6, 0xa8, 0x89, 0x8c, 0x08,
+	0xe0, 0x35, 0x9b, 0x80, 0x1a, 0x37, 0xe4, 0xe2, 0xfe, 0xf6, 0xcb, 0xcb, 0x9c, 0xf0, 0xea, 0x32,
+	0x27, 0xfc, 0x7d, 0x99, 0x13, 0x7e, 0xb8, 0xca, 0xc5, 0x5e, 0x5d, 0xe5, 0x62, 0x7f, 0x5e, 0xe5,
+	0x62, 0x27, 0x9b, 0x86, 0xe9, 0x36, 0x3a, 0x35, 0xb9, 0x6e, 0xb7, 0x78, 0x8e, 0xf3, 0xde, 0x57,
+	0xfc, 0xef, 0x5d, 0xa6, 0x3f, 0x55, 0xce, 0x79, 0x46, 0xb7, 0xd7, 0xa6, 0xac, 0x36, 0xc7, 0xff,
+	0x13, 0xfe, 0xe8, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x51, 0xb3, 0x4c, 0x91, 0xac, 0x0b, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ListAllowed returns the list of addresses that are allowed to deploy EVM
+	// contracts
+	ListAllowed(ctx context.Context, in *QueryListAllowedRequest, opts ...grpc.CallOption) (*QueryListAllowedResponse, error)
+	// ListAdmins returns the list of admin addresses
+	ListAdmins(ctx context.Context, in *QueryListAdminsRequest, opts ...grpc.CallOption) (*QueryListAdminsResponse, error)
+	// IsAllowed returns whether an address is allowed to deploy EVM contracts
+	IsAllowed(ctx context.Context, in *QueryIsAllowedRequest, opts ...grpc.CallOption) (*QueryIsAllowedResponse, error)
+	// IsAdmin returns whether an address is an admin
+	IsAdmin(ctx context.Context, in *QueryIsAdminRequest, opts ...grpc.CallOption) (*QueryIsAdminResponse, error)
+	// AdminMembers resolves the accounts behind an admin when it is a group
+	// policy account or a multisig
+	AdminMembers(ctx context.Context, in *QueryAdminMembersRequest, opts ...grpc.CallOption) (*QueryAdminMembersResponse, error)
+	// ListRoleMembers returns the list of addresses holding a role
+	ListRoleMembers(ctx context.Context, in *QueryListRoleMembersRequest, opts ...grpc.CallOption) (*QueryListRoleMembersResponse, error)
+	// History returns the past changes of the ACL affecting an address
+	History(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*QueryHistoryResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListAllowed(ctx context.Context, in *QueryListAllowedRequest, opts ...grpc.CallOption) (*QueryListAllowedResponse, error) {
+	out := new(QueryListAllowedResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Query/ListAllowed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListAdmins(ctx context.Context, in *QueryListAdminsRequest, opts ...grpc.CallOption) (*QueryListAdminsResponse, error) {
+	out := new(QueryListAdminsResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Query/ListAdmins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IsAllowed(ctx context.Context, in *QueryIsAllowedRequest, opts ...grpc.CallOption) (*QueryIsAllowedResponse, error) {
+	out := new(QueryIsAllowedResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Query/IsAllowed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IsAdmin(ctx context.Context, in *QueryIsAdminRequest, opts ...grpc.CallOption) (*QueryIsAdminResponse, error) {
+	out := new(QueryIsAdminResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Query/IsAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AdminMembers(ctx context.Context, in *QueryAdminMembersRequest, opts ...grpc.CallOption) (*QueryAdminMembersResponse, error) {
+	out := new(QueryAdminMembersResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Query/AdminMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListRoleMembers(ctx context.Context, in *QueryListRoleMembersRequest, opts ...grpc.CallOption) (*QueryListRoleMembersResponse, error) {
+	out := new(QueryListRoleMembersResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Query/ListRoleMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) History(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*QueryHistoryResponse, error) {
+	out := new(QueryHistoryResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Query/History", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ListAllowed returns the list of addresses that are allowed to deploy EVM
+	// contracts
+	ListAllowed(context.Context, *QueryListAllowedRequest) (*QueryListAllowedResponse, error)
+	// ListAdmins returns the list of admin addresses
+	ListAdmins(context.Context, *QueryListAdminsRequest) (*QueryListAdminsResponse, error)
+	// IsAllowed returns whether an address is allowed to deploy EVM contracts
+	IsAllowed(context.Context, *QueryIsAllowedRequest) (*QueryIsAllowedResponse, error)
+	// IsAdmin returns whether an address is an admin
+	IsAdmin(context.Context, *QueryIsAdminRequest) (*QueryIsAdminResponse, error)
+	// AdminMembers resolves the accounts behind an admin when it is a group
+	// policy account or a multisig
+	AdminMembers(context.Context, *QueryAdminMembersRequest) (*QueryAdminMembersResponse, error)
+	// ListRoleMembers returns the list of addresses holding a role
+	ListRoleMembers(context.Context, *QueryListRoleMembersRequest) (*QueryListRoleMembersResponse, error)
+	// History returns the past changes of the ACL affecting an address
+	History(context.Context, *QueryHistoryRequest) (*QueryHistoryResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ListAllowed(ctx context.Context, req *QueryListAllowedRequest) (*QueryListAllowedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllowed not implemented")
+}
+func (*UnimplementedQueryServer) ListAdmins(ctx context.Context, req *QueryListAdminsRequest) (*QueryListAdminsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAdmins not implemented")
+}
+func (*UnimplementedQueryServer) IsAllowed(ctx context.Context, req *QueryIsAllowedRequest) (*QueryIsAllowedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsAllowed not implemented")
+}
+func (*UnimplementedQueryServer) IsAdmin(ctx context.Context, req *QueryIsAdminRequest) (*QueryIsAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsAdmin not implemented")
+}
+func (*UnimplementedQueryServer) AdminMembers(ctx context.Context, req *QueryAdminMembersRequest) (*QueryAdminMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminMembers not implemented")
+}
+func (*UnimplementedQueryServer) ListRoleMembers(ctx context.Context, req *QueryListRoleMembersRequest) (*QueryListRoleMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleMembers not implemented")
+}
+func (*UnimplementedQueryServer) History(ctx context.Context, req *QueryHistoryRequest) (*QueryHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListAllowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListAllowedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListAllowed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Query/ListAllowed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListAllowed(ctx, req.(*QueryListAllowedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListAdminsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListAdmins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Query/ListAdmins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListAdmins(ctx, req.(*QueryListAdminsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsAllowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsAllowedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsAllowed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Query/IsAllowed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsAllowed(ctx, req.(*QueryIsAllowedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Query/IsAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsAdmin(ctx, req.(*QueryIsAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AdminMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdminMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AdminMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Query/AdminMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AdminMembers(ctx, req.(*QueryAdminMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListRoleMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListRoleMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListRoleMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Query/ListRoleMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListRoleMembers(ctx, req.(*QueryListRoleMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).History(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Query/History",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).History(ctx, req.(*QueryHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "saga.acl.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ListAllowed",
+			Handler:    _Query_ListAllowed_Handler,
+		},
+		{
+			MethodName: "ListAdmins",
+			Handler:    _Query_ListAdmins_Handler,
+		},
+		{
+			MethodName: "IsAllowed",
+			Handler:    _Query_IsAllowed_Handler,
+		},
+		{
+			MethodName: "IsAdmin",
+			Handler:    _Query_IsAdmin_Handler,
+		},
+		{
+			MethodName: "AdminMembers",
+			Handler:    _Query_AdminMembers_Handler,
+		},
+		{
+			MethodName: "ListRoleMembers",
+			Handler:    _Query_ListRoleMembers_Handler,
+		},
+		{
+			MethodName: "History",
+			Handler:    _Query_History_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "saga/acl/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListAdminsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListAdminsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListAdminsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListAdminsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListAdminsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListAdminsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expiring) > 0 {
+		for iNdEx := len(m.Expiring) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Expiring[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admins) > 0 {
+		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Admins[iNdEx])
+			copy(dAtA[i:], m.Admins[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Admins[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListAllowedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListAllowedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListAllowedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListAllowedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListAllowedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListAllowedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expiring) > 0 {
+		for iNdEx := len(m.Expiring) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Expiring[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Allowed) > 0 {
+		for iNdEx := len(m.Allowed) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowed[iNdEx])
+			copy(dAtA[i:], m.Allowed[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Allowed[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsAllowedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsAllowedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsAllowedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsAllowedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsAllowedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsAllowedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Admin {
+		i--
+		if m.Admin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Kind != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListRoleMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListRoleMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListRoleMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListRoleMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListRoleMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListRoleMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListAdminsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListAdminsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Admins) > 0 {
+		for _, s := range m.Admins {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Expiring) > 0 {
+		for _, e := range m.Expiring {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryListAllowedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListAllowedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowed) > 0 {
+		for _, s := range m.Allowed {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Expiring) > 0 {
+		for _, e := range m.Expiring {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIsAllowedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsAllowedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryIsAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Admin {
+		n += 2
+	}
+	return n
+}
+
+func (m *AdminMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAdminMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAdminMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovQuery(uint64(m.Kind))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *QueryListRoleMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListRoleMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListAdminsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListAdminsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListAdminsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListAdminsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListAdminsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListAdminsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admins = append(m.Admins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiring", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expiring = append(m.Expiring, ExpiringEntry{})
+			if err := m.Expiring[len(m.Expiring)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListAllowedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListAllowedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListAllowedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListAllowedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListAllowedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListAllowedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowed = append(m.Allowed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiring", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expiring = append(m.Expiring, ExpiringEntry{})
+			if err := m.Expiring[len(m.Expiring)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsAllowedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsAllowedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsAllowedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsAllowedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsAllowedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsAllowedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsAdminRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsAdminRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Admin = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAdminMembersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -743,12 +3324,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAdminMembersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAdminMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -770,7 +3383,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAdminMembersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -793,15 +3406,34 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAdminMembersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAdminMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= AdminKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -828,10 +3460,62 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Members = append(m.Members, AdminMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -853,7 +3537,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListAdminsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListRoleMembersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -876,12 +3560,44 @@ func (m *QueryListAdminsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListAdminsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListRoleMembersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListAdminsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListRoleMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -903,7 +3619,7 @@ func (m *QueryListAdminsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListAdminsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListRoleMembersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -926,15 +3642,15 @@ func (m *QueryListAdminsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListAdminsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListRoleMembersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListAdminsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListRoleMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -962,7 +3678,7 @@ func (m *QueryListAdminsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admins = append(m.Admins, string(dAtA[iNdEx:postIndex]))
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -985,7 +3701,7 @@ func (m *QueryListAdminsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListAllowedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1008,12 +3724,80 @@ func (m *QueryListAllowedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListAllowedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListAllowedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1035,7 +3819,7 @@ func (m *QueryListAllowedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListAllowedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1058,17 +3842,17 @@ func (m *QueryListAllowedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListAllowedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListAllowedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1078,23 +3862,61 @@ func (m *QueryListAllowedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowed = append(m.Allowed, string(dAtA[iNdEx:postIndex]))
+			m.History = append(m.History, ChangeRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Built-in roles. Each ACL message is gated on one of them, while other
+// modules are free to check their own roles with HasRole.
+const (
+	// RoleACLManager manages the allowed list and enables or disables the
+	// access control.
+	RoleACLManager = "acl-manager"
+	// RoleAdminManager manages the admins and the members of the roles.
+	RoleAdminManager = "admin-manager"
+	// RoleMetadataSetter sets denom metadata through x/admin.
+	RoleMetadataSetter = "metadata-setter"
+	// RoleDeployer members are allowed in addition to the allowed list.
+	RoleDeployer = "deployer"
+)
+
+var roleRegex = regexp.MustCompile(`^[a-z][a-z0-9-]{0,63}$`)
+
+// ValidateRole checks that a role name is made of at most 64 lowercase
+// letters, digits and dashes, starting with a letter.
+func ValidateRole(role string) error {
+	if !roleRegex.MatchString(role) {
+		return fmt.Errorf("invalid role name %q", role)
+	}
+
+	return nil
+}
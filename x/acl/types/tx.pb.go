@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -33,6 +34,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MsgAddAdmins struct {
 	Sender string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Admins []string `protobuf:"bytes,2,rep,name=admins,proto3" json:"admins,omitempty"`
+	// expiry optionally limits the time the addresses are admins.
+	Expiry *Expiry `protobuf:"bytes,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
 }
 
 func (m *MsgAddAdmins) Reset()         { *m = MsgAddAdmins{} }
@@ -82,6 +85,13 @@ func (m *MsgAddAdmins) GetAdmins() []string {
 	return nil
 }
 
+func (m *MsgAddAdmins) GetExpiry() *Expiry {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
 type MsgAddAdminsResponse struct {
 }
 
@@ -209,6 +219,8 @@ var xxx_messageInfo_MsgRemoveAdminsResponse proto.InternalMessageInfo
 type MsgAddAllowed struct {
 	Sender  string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Allowed []string `protobuf:"bytes,2,rep,name=allowed,proto3" json:"allowed,omitempty"`
+	// expiry optionally limits the time the addresses are allowed.
+	Expiry *Expiry `protobuf:"bytes,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
 }
 
 func (m *MsgAddAllowed) Reset()         { *m = MsgAddAllowed{} }
@@ -258,6 +270,13 @@ func (m *MsgAddAllowed) GetAllowed() []string {
 	return nil
 }
 
+func (m *MsgAddAllowed) GetExpiry() *Expiry {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
 type MsgAddAllowedResponse struct {
 }
 
@@ -542,863 +561,3258 @@ func (m *MsgDisableResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDisableResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgAddAdmins)(nil), "saga.acl.v1.MsgAddAdmins")
-	proto.RegisterType((*MsgAddAdminsResponse)(nil), "saga.acl.v1.MsgAddAdminsResponse")
-	proto.RegisterType((*MsgRemoveAdmins)(nil), "saga.acl.v1.MsgRemoveAdmins")
-	proto.RegisterType((*MsgRemoveAdminsResponse)(nil), "saga.acl.v1.MsgRemoveAdminsResponse")
-	proto.RegisterType((*MsgAddAllowed)(nil), "saga.acl.v1.MsgAddAllowed")
-	proto.RegisterType((*MsgAddAllowedResponse)(nil), "saga.acl.v1.MsgAddAllowedResponse")
-	proto.RegisterType((*MsgRemoveAllowed)(nil), "saga.acl.v1.MsgRemoveAllowed")
-	proto.RegisterType((*MsgRemoveAllowedResponse)(nil), "saga.acl.v1.MsgRemoveAllowedResponse")
-	proto.RegisterType((*MsgEnable)(nil), "saga.acl.v1.MsgEnable")
-	proto.RegisterType((*MsgEnableResponse)(nil), "saga.acl.v1.MsgEnableResponse")
-	proto.RegisterType((*MsgDisable)(nil), "saga.acl.v1.MsgDisable")
-	proto.RegisterType((*MsgDisableResponse)(nil), "saga.acl.v1.MsgDisableResponse")
+type MsgGrantRole struct {
+	Sender    string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Role      string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
-func init() { proto.RegisterFile("saga/acl/v1/tx.proto", fileDescriptor_c673767cc9e331fd) }
-
-var fileDescriptor_c673767cc9e331fd = []byte{
-	// 561 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x41, 0x6f, 0x12, 0x4f,
-	0x18, 0xc6, 0xd9, 0x36, 0xa5, 0xe1, 0x6d, 0x9b, 0xff, 0xdf, 0x11, 0x61, 0x59, 0x61, 0x41, 0xb4,
-	0xda, 0x34, 0xe9, 0xae, 0xd5, 0x9b, 0x37, 0x1a, 0xbd, 0x68, 0xb8, 0xe0, 0xcd, 0x4b, 0x1d, 0x98,
-	0xc9, 0x74, 0xd3, 0xdd, 0x1d, 0x64, 0x56, 0x04, 0x13, 0x13, 0xd3, 0x4f, 0x60, 0xe2, 0x17, 0xe1,
-	0xe0, 0x87, 0xf0, 0xd8, 0xe8, 0xc5, 0xa3, 0x01, 0x93, 0x7e, 0x0d, 0xd3, 0xd9, 0x61, 0xd8, 0xdd,
-	0x40, 0x13, 0x8d, 0x3d, 0x91, 0x77, 0x9e, 0xd9, 0xe7, 0xf7, 0xbc, 0xc9, 0x33, 0x40, 0x51, 0x60,
-	0x86, 0x5d, 0xdc, 0xf3, 0xdd, 0xe1, 0xa1, 0x1b, 0x8d, 0x9c, 0xfe, 0x80, 0x47, 0x1c, 0x6d, 0x5d,
-	0x9e, 0x3a, 0xb8, 0xe7, 0x3b, 0xc3, 0x43, 0xab, 0xca, 0x38, 0x67, 0x3e, 0x75, 0x71, 0xdf, 0x73,
-	0x71, 0x18, 0xf2, 0x08, 0x47, 0x1e, 0x0f, 0x45, 0x7c, 0xd5, 0xaa, 0xf4, 0xb8, 0x08, 0xb8, 0x38,
-	0x96, 0x93, 0x1b, 0x0f, 0x4a, 0x2a, 0xc7, 0x93, 0x1b, 0x08, 0x76, 0xe9, 0x1e, 0x08, 0x16, 0x0b,
-	0x4d, 0x0a, 0xdb, 0x6d, 0xc1, 0x5a, 0x84, 0xb4, 0x48, 0xe0, 0x85, 0x02, 0x3d, 0x84, 0xbc, 0xa0,
-	0x21, 0xa1, 0x03, 0xd3, 0x68, 0x18, 0x7b, 0x85, 0x23, 0xf3, 0xdb, 0x97, 0x83, 0xa2, 0xb2, 0x6a,
-	0x11, 0x32, 0xa0, 0x42, 0xbc, 0x8c, 0x06, 0x5e, 0xc8, 0x3a, 0xea, 0x1e, 0x2a, 0x41, 0x1e, 0xcb,
-	0x6f, 0xcd, 0xb5, 0xc6, 0xfa, 0x5e, 0xa1, 0xa3, 0xa6, 0x27, 0x5b, 0x67, 0x17, 0x93, 0x7d, 0x75,
-	0xa9, 0x59, 0x82, 0x62, 0x12, 0xd3, 0xa1, 0xa2, 0xcf, 0x43, 0x41, 0x9b, 0x27, 0xf0, 0x5f, 0x5b,
-	0xb0, 0x0e, 0x0d, 0xf8, 0x90, 0x5e, 0x6f, 0x82, 0x0a, 0x94, 0x33, 0xa4, 0x44, 0x88, 0x1d, 0x15,
-	0xce, 0xf7, 0xf9, 0x3b, 0x4a, 0xfe, 0x22, 0x82, 0x09, 0x9b, 0x38, 0xfe, 0x58, 0x65, 0x98, 0x8f,
-	0xe9, 0x10, 0x65, 0xb8, 0x95, 0x22, 0xe9, 0x08, 0xa7, 0xf0, 0xff, 0x22, 0xdd, 0x75, 0xa7, 0xb0,
-	0xc0, 0xcc, 0xc2, 0x74, 0x90, 0xe7, 0x50, 0x68, 0x0b, 0xf6, 0x2c, 0xc4, 0x5d, 0x9f, 0xfe, 0x79,
-	0x82, 0x34, 0xe7, 0x26, 0xdc, 0xd0, 0x5e, 0x1a, 0xf0, 0x02, 0xa0, 0x2d, 0xd8, 0x53, 0x4f, 0xfc,
-	0x0b, 0x42, 0x11, 0xd0, 0xc2, 0x6c, 0x8e, 0x78, 0x34, 0xd9, 0x80, 0xf5, 0xb6, 0x60, 0x28, 0x80,
-	0xc2, 0xa2, 0xd8, 0x15, 0x27, 0xf1, 0x90, 0x9c, 0x64, 0x19, 0xad, 0x3b, 0x2b, 0x25, 0x9d, 0xba,
-	0x79, 0xf6, 0xfd, 0xd7, 0xe7, 0xb5, 0x2a, 0xb2, 0xdc, 0xf4, 0x23, 0x75, 0x31, 0x21, 0xc7, 0x71,
-	0xdd, 0xd0, 0x18, 0xb6, 0x53, 0x45, 0xae, 0x66, 0x6d, 0x93, 0xaa, 0x75, 0xef, 0x2a, 0x55, 0x73,
-	0x77, 0x25, 0xb7, 0x8e, 0x6a, 0x59, 0xee, 0x40, 0xde, 0x9e, 0xa3, 0xdf, 0x00, 0x24, 0xea, 0x6b,
-	0x2d, 0xdb, 0x27, 0xd6, 0xac, 0xe6, 0x6a, 0x4d, 0x43, 0xef, 0x4a, 0x68, 0x0d, 0xdd, 0x5e, 0xba,
-	0xac, 0x82, 0x7c, 0x80, 0x9d, 0x74, 0x5d, 0x6b, 0x2b, 0x16, 0x52, 0xe0, 0xdd, 0x2b, 0x65, 0xcd,
-	0xbe, 0x2f, 0xd9, 0x0d, 0x64, 0xaf, 0x5a, 0x58, 0xd1, 0x5e, 0x43, 0x5e, 0x95, 0xb4, 0x94, 0x35,
-	0x8e, 0xcf, 0x2d, 0x7b, 0xf9, 0xb9, 0x26, 0xd9, 0x92, 0x64, 0xa2, 0x52, 0x96, 0x44, 0x63, 0x5f,
-	0x02, 0x9b, 0xf3, 0x96, 0x96, 0xb3, 0x56, 0x4a, 0xb0, 0xea, 0x2b, 0x04, 0x0d, 0xa9, 0x4b, 0x48,
-	0x05, 0x95, 0xb3, 0x10, 0xa2, 0x1c, 0x36, 0x3e, 0x5e, 0x4c, 0xf6, 0x8d, 0xa3, 0xd6, 0xd7, 0xa9,
-	0x6d, 0x9c, 0x4f, 0x6d, 0xe3, 0xe7, 0xd4, 0x36, 0x3e, 0xcd, 0xec, 0xdc, 0xf9, 0xcc, 0xce, 0xfd,
-	0x98, 0xd9, 0xb9, 0x57, 0x0f, 0x98, 0x17, 0x9d, 0xbc, 0xed, 0x3a, 0x3d, 0x1e, 0x48, 0x8f, 0xd1,
-	0xf8, 0xbd, 0xfc, 0x3d, 0x10, 0xe4, 0xd4, 0x1d, 0x49, 0xc7, 0x68, 0xdc, 0xa7, 0xa2, 0x9b, 0x97,
-	0x7f, 0xe8, 0x8f, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x82, 0x9b, 0x9b, 0x9d, 0x47, 0x06, 0x00,
-	0x00,
+func (m *MsgGrantRole) Reset()         { *m = MsgGrantRole{} }
+func (m *MsgGrantRole) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantRole) ProtoMessage()    {}
+func (*MsgGrantRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c673767cc9e331fd, []int{12}
+}
+func (m *MsgGrantRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantRole.Merge(m, src)
+}
+func (m *MsgGrantRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantRole.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgGrantRole proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// AddAdmins adds addresses to the admin list.
-	AddAdmins(ctx context.Context, in *MsgAddAdmins, opts ...grpc.CallOption) (*MsgAddAdminsResponse, error)
-	// RemoveAdmins removes addresses from the admin list.
-	RemoveAdmins(ctx context.Context, in *MsgRemoveAdmins, opts ...grpc.CallOption) (*MsgRemoveAdminsResponse, error)
-	// AddAllowed adds addresses to the allowed list.
-	AddAllowed(ctx context.Context, in *MsgAddAllowed, opts ...grpc.CallOption) (*MsgAddAllowedResponse, error)
-	// RemoveAllowed removes addresses from the allowed list.
-	RemoveAllowed(ctx context.Context, in *MsgRemoveAllowed, opts ...grpc.CallOption) (*MsgRemoveAllowedResponse, error)
-	// Enable enables the access control based on the allowed list.
-	Enable(ctx context.Context, in *MsgEnable, opts ...grpc.CallOption) (*MsgEnableResponse, error)
-	// Disable disables the access control based on the allowed list.
-	Disable(ctx context.Context, in *MsgDisable, opts ...grpc.CallOption) (*MsgDisableResponse, error)
+func (m *MsgGrantRole) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgGrantRole) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgGrantRole) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
 }
 
-func (c *msgClient) AddAdmins(ctx context.Context, in *MsgAddAdmins, opts ...grpc.CallOption) (*MsgAddAdminsResponse, error) {
-	out := new(MsgAddAdminsResponse)
-	err := c.cc.Invoke(ctx, "/saga.acl.v1.Msg/AddAdmins", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgGrantRoleResponse struct {
 }
 
-func (c *msgClient) RemoveAdmins(ctx context.Context, in *MsgRemoveAdmins, opts ...grpc.CallOption) (*MsgRemoveAdminsResponse, error) {
-	out := new(MsgRemoveAdminsResponse)
-	err := c.cc.Invoke(ctx, "/saga.acl.v1.Msg/RemoveAdmins", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgGrantRoleResponse) Reset()         { *m = MsgGrantRoleResponse{} }
+func (m *MsgGrantRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantRoleResponse) ProtoMessage()    {}
+func (*MsgGrantRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c673767cc9e331fd, []int{13}
+}
+func (m *MsgGrantRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgGrantRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantRoleResponse.Merge(m, src)
+}
+func (m *MsgGrantRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantRoleResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddAllowed(ctx context.Context, in *MsgAddAllowed, opts ...grpc.CallOption) (*MsgAddAllowedResponse, error) {
-	out := new(MsgAddAllowedResponse)
-	err := c.cc.Invoke(ctx, "/saga.acl.v1.Msg/AddAllowed", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgGrantRoleResponse proto.InternalMessageInfo
+
+type MsgRevokeRole struct {
+	Sender    string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Role      string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
-func (c *msgClient) RemoveAllowed(ctx context.Context, in *MsgRemoveAllowed, opts ...grpc.CallOption) (*MsgRemoveAllowedResponse, error) {
-	out := new(MsgRemoveAllowedResponse)
-	err := c.cc.Invoke(ctx, "/saga.acl.v1.Msg/RemoveAllowed", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeRole) Reset()         { *m = MsgRevokeRole{} }
+func (m *MsgRevokeRole) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeRole) ProtoMessage()    {}
+func (*MsgRevokeRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c673767cc9e331fd, []int{14}
+}
+func (m *MsgRevokeRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRevokeRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeRole.Merge(m, src)
+}
+func (m *MsgRevokeRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeRole.DiscardUnknown(m)
 }
 
-func (c *msgClient) Enable(ctx context.Context, in *MsgEnable, opts ...grpc.CallOption) (*MsgEnableResponse, error) {
-	out := new(MsgEnableResponse)
-	err := c.cc.Invoke(ctx, "/saga.acl.v1.Msg/Enable", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRevokeRole proto.InternalMessageInfo
+
+func (m *MsgRevokeRole) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Disable(ctx context.Context, in *MsgDisable, opts ...grpc.CallOption) (*MsgDisableResponse, error) {
-	out := new(MsgDisableResponse)
-	err := c.cc.Invoke(ctx, "/saga.acl.v1.Msg/Disable", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeRole) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// AddAdmins adds addresses to the admin list.
-	AddAdmins(context.Context, *MsgAddAdmins) (*MsgAddAdminsResponse, error)
-	// RemoveAdmins removes addresses from the admin list.
-	RemoveAdmins(context.Context, *MsgRemoveAdmins) (*MsgRemoveAdminsResponse, error)
-	// AddAllowed adds addresses to the allowed list.
-	AddAllowed(context.Context, *MsgAddAllowed) (*MsgAddAllowedResponse, error)
-	// RemoveAllowed removes addresses from the allowed list.
-	RemoveAllowed(context.Context, *MsgRemoveAllowed) (*MsgRemoveAllowedResponse, error)
-	// Enable enables the access control based on the allowed list.
-	Enable(context.Context, *MsgEnable) (*MsgEnableResponse, error)
-	// Disable disables the access control based on the allowed list.
-	Disable(context.Context, *MsgDisable) (*MsgDisableResponse, error)
+func (m *MsgRevokeRole) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgRevokeRoleResponse struct {
 }
 
-func (*UnimplementedMsgServer) AddAdmins(ctx context.Context, req *MsgAddAdmins) (*MsgAddAdminsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddAdmins not implemented")
+func (m *MsgRevokeRoleResponse) Reset()         { *m = MsgRevokeRoleResponse{} }
+func (m *MsgRevokeRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeRoleResponse) ProtoMessage()    {}
+func (*MsgRevokeRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c673767cc9e331fd, []int{15}
 }
-func (*UnimplementedMsgServer) RemoveAdmins(ctx context.Context, req *MsgRemoveAdmins) (*MsgRemoveAdminsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveAdmins not implemented")
+func (m *MsgRevokeRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) AddAllowed(ctx context.Context, req *MsgAddAllowed) (*MsgAddAllowedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddAllowed not implemented")
+func (m *MsgRevokeRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RemoveAllowed(ctx context.Context, req *MsgRemoveAllowed) (*MsgRemoveAllowedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowed not implemented")
+func (m *MsgRevokeRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeRoleResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) Enable(ctx context.Context, req *MsgEnable) (*MsgEnableResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Enable not implemented")
+func (m *MsgRevokeRoleResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) Disable(ctx context.Context, req *MsgDisable) (*MsgDisableResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Disable not implemented")
+func (m *MsgRevokeRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeRoleResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgRevokeRoleResponse proto.InternalMessageInfo
+
+type MsgProposeAdmin struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Admin  string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// replace removes the sender from the admins once the proposal is accepted.
+	Replace bool `protobuf:"varint,3,opt,name=replace,proto3" json:"replace,omitempty"`
 }
 
-func _Msg_AddAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddAdmins)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddAdmins(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/saga.acl.v1.Msg/AddAdmins",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddAdmins(ctx, req.(*MsgAddAdmins))
+func (m *MsgProposeAdmin) Reset()         { *m = MsgProposeAdmin{} }
+func (m *MsgProposeAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeAdmin) ProtoMessage()    {}
+func (*MsgProposeAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c673767cc9e331fd, []int{16}
+}
+func (m *MsgProposeAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgProposeAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeAdmin.Merge(m, src)
+}
+func (m *MsgProposeAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeAdmin.DiscardUnknown(m)
 }
 
-func _Msg_RemoveAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveAdmins)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveAdmins(ctx, in)
+var xxx_messageInfo_MsgProposeAdmin proto.InternalMessageInfo
+
+func (m *MsgProposeAdmin) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/saga.acl.v1.Msg/RemoveAdmins",
+	return ""
+}
+
+func (m *MsgProposeAdmin) GetAdmin() string {
+	if m != nil {
+		return m.Admin
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveAdmins(ctx, req.(*MsgRemoveAdmins))
+	return ""
+}
+
+func (m *MsgProposeAdmin) GetReplace() bool {
+	if m != nil {
+		return m.Replace
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Msg_AddAllowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddAllowed)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddAllowed(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/saga.acl.v1.Msg/AddAllowed",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddAllowed(ctx, req.(*MsgAddAllowed))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgProposeAdminResponse struct {
 }
 
-func _Msg_RemoveAllowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveAllowed)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveAllowed(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/saga.acl.v1.Msg/RemoveAllowed",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveAllowed(ctx, req.(*MsgRemoveAllowed))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgProposeAdminResponse) Reset()         { *m = MsgProposeAdminResponse{} }
+func (m *MsgProposeAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeAdminResponse) ProtoMessage()    {}
+func (*MsgProposeAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c673767cc9e331fd, []int{17}
 }
-
-func _Msg_Enable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEnable)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Enable(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/saga.acl.v1.Msg/Enable",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Enable(ctx, req.(*MsgEnable))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgProposeAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_Disable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDisable)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Disable(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/saga.acl.v1.Msg/Disable",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Disable(ctx, req.(*MsgDisable))
+func (m *MsgProposeAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "saga.acl.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddAdmins",
-			Handler:    _Msg_AddAdmins_Handler,
-		},
-		{
-			MethodName: "RemoveAdmins",
-			Handler:    _Msg_RemoveAdmins_Handler,
-		},
-		{
-			MethodName: "AddAllowed",
-			Handler:    _Msg_AddAllowed_Handler,
-		},
-		{
-			MethodName: "RemoveAllowed",
-			Handler:    _Msg_RemoveAllowed_Handler,
-		},
-		{
-			MethodName: "Enable",
-			Handler:    _Msg_Enable_Handler,
-		},
-		{
-			MethodName: "Disable",
-			Handler:    _Msg_Disable_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "saga/acl/v1/tx.proto",
+func (m *MsgProposeAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeAdminResponse.Merge(m, src)
 }
-
-func (m *MsgAddAdmins) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgProposeAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeAdminResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddAdmins) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgProposeAdminResponse proto.InternalMessageInfo
+
+type MsgAcceptAdmin struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *MsgAddAdmins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Admins) > 0 {
-		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Admins[iNdEx])
-			copy(dAtA[i:], m.Admins[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Admins[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgAcceptAdmin) Reset()         { *m = MsgAcceptAdmin{} }
+func (m *MsgAcceptAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAdmin) ProtoMessage()    {}
+func (*MsgAcceptAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c673767cc9e331fd, []int{18}
+}
+func (m *MsgAcceptAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAcceptAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAdmin.Merge(m, src)
+}
+func (m *MsgAcceptAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAdmin.DiscardUnknown(m)
 }
 
-func (m *MsgAddAdminsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAcceptAdmin proto.InternalMessageInfo
+
+func (m *MsgAcceptAdmin) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddAdminsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgAcceptAdminResponse struct {
 }
 
-func (m *MsgAddAdminsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgAcceptAdminResponse) Reset()         { *m = MsgAcceptAdminResponse{} }
+func (m *MsgAcceptAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAdminResponse) ProtoMessage()    {}
+func (*MsgAcceptAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c673767cc9e331fd, []int{19}
 }
-
-func (m *MsgRemoveAdmins) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAcceptAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAdminResponse.Merge(m, src)
+}
+func (m *MsgAcceptAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAdminResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveAdmins) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAcceptAdminResponse proto.InternalMessageInfo
+
+type MsgCancelAdminProposal struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Admin  string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
 }
 
-func (m *MsgRemoveAdmins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Admins) > 0 {
-		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Admins[iNdEx])
-			copy(dAtA[i:], m.Admins[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Admins[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgCancelAdminProposal) Reset()         { *m = MsgCancelAdminProposal{} }
+func (m *MsgCancelAdminProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAdminProposal) ProtoMessage()    {}
+func (*MsgCancelAdminProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c673767cc9e331fd, []int{20}
+}
+func (m *MsgCancelAdminProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAdminProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAdminProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgCancelAdminProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAdminProposal.Merge(m, src)
+}
+func (m *MsgCancelAdminProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAdminProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAdminProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelAdminProposal proto.InternalMessageInfo
+
+func (m *MsgCancelAdminProposal) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRemoveAdminsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelAdminProposal) GetAdmin() string {
+	if m != nil {
+		return m.Admin
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRemoveAdminsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCancelAdminProposalResponse struct {
 }
 
-func (m *MsgRemoveAdminsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCancelAdminProposalResponse) Reset()         { *m = MsgCancelAdminProposalResponse{} }
+func (m *MsgCancelAdminProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAdminProposalResponse) ProtoMessage()    {}
+func (*MsgCancelAdminProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c673767cc9e331fd, []int{21}
 }
-
-func (m *MsgAddAllowed) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelAdminProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAdminProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAdminProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelAdminProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAdminProposalResponse.Merge(m, src)
+}
+func (m *MsgCancelAdminProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAdminProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAdminProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddAllowed) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelAdminProposalResponse proto.InternalMessageInfo
+
+// MsgUpdateParams defines a Msg for updating the x/acl module parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/acl parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgAddAllowed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Allowed) > 0 {
-		for iNdEx := len(m.Allowed) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Allowed[iNdEx])
-			copy(dAtA[i:], m.Allowed[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Allowed[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c673767cc9e331fd, []int{22}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgAddAllowedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
 }
-
-func (m *MsgAddAllowedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgAddAllowedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgRemoveAllowed) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *MsgRemoveAllowed) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgRemoveAllowed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Allowed) > 0 {
-		for iNdEx := len(m.Allowed) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Allowed[iNdEx])
-			copy(dAtA[i:], m.Allowed[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Allowed[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c673767cc9e331fd, []int{23}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgRemoveAllowedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveAllowedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAddAdmins)(nil), "saga.acl.v1.MsgAddAdmins")
+	proto.RegisterType((*MsgAddAdminsResponse)(nil), "saga.acl.v1.MsgAddAdminsResponse")
+	proto.RegisterType((*MsgRemoveAdmins)(nil), "saga.acl.v1.MsgRemoveAdmins")
+	proto.RegisterType((*MsgRemoveAdminsResponse)(nil), "saga.acl.v1.MsgRemoveAdminsResponse")
+	proto.RegisterType((*MsgAddAllowed)(nil), "saga.acl.v1.MsgAddAllowed")
+	proto.RegisterType((*MsgAddAllowedResponse)(nil), "saga.acl.v1.MsgAddAllowedResponse")
+	proto.RegisterType((*MsgRemoveAllowed)(nil), "saga.acl.v1.MsgRemoveAllowed")
+	proto.RegisterType((*MsgRemoveAllowedResponse)(nil), "saga.acl.v1.MsgRemoveAllowedResponse")
+	proto.RegisterType((*MsgEnable)(nil), "saga.acl.v1.MsgEnable")
+	proto.RegisterType((*MsgEnableResponse)(nil), "saga.acl.v1.MsgEnableResponse")
+	proto.RegisterType((*MsgDisable)(nil), "saga.acl.v1.MsgDisable")
+	proto.RegisterType((*MsgDisableResponse)(nil), "saga.acl.v1.MsgDisableResponse")
+	proto.RegisterType((*MsgGrantRole)(nil), "saga.acl.v1.MsgGrantRole")
+	proto.RegisterType((*MsgGrantRoleResponse)(nil), "saga.acl.v1.MsgGrantRoleResponse")
+	proto.RegisterType((*MsgRevokeRole)(nil), "saga.acl.v1.MsgRevokeRole")
+	proto.RegisterType((*MsgRevokeRoleResponse)(nil), "saga.acl.v1.MsgRevokeRoleResponse")
+	proto.RegisterType((*MsgProposeAdmin)(nil), "saga.acl.v1.MsgProposeAdmin")
+	proto.RegisterType((*MsgProposeAdminResponse)(nil), "saga.acl.v1.MsgProposeAdminResponse")
+	proto.RegisterType((*MsgAcceptAdmin)(nil), "saga.acl.v1.MsgAcceptAdmin")
+	proto.RegisterType((*MsgAcceptAdminResponse)(nil), "saga.acl.v1.MsgAcceptAdminResponse")
+	proto.RegisterType((*MsgCancelAdminProposal)(nil), "saga.acl.v1.MsgCancelAdminProposal")
+	proto.RegisterType((*MsgCancelAdminProposalResponse)(nil), "saga.acl.v1.MsgCancelAdminProposalResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "saga.acl.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "saga.acl.v1.MsgUpdateParamsResponse")
 }
 
-func (m *MsgRemoveAllowedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("saga/acl/v1/tx.proto", fileDescriptor_c673767cc9e331fd) }
+
+var fileDescriptor_c673767cc9e331fd = []byte{
+	// 965 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0xe3, 0xa4, 0xdd, 0xb2, 0x2f, 0x69, 0x01, 0x67, 0xd9, 0x75, 0xdc, 0x8d, 0xb3, 0xb8,
+	0x0d, 0x8d, 0x5a, 0x65, 0x4d, 0x8a, 0xc4, 0xa1, 0xb7, 0x04, 0x2a, 0x24, 0xd0, 0x8a, 0xca, 0x88,
+	0x0b, 0x97, 0x30, 0xb1, 0x47, 0x8e, 0x15, 0xdb, 0xe3, 0x7a, 0x9c, 0x90, 0x45, 0x42, 0x54, 0x3d,
+	0x73, 0x40, 0x20, 0xf8, 0x3b, 0x7a, 0xe0, 0x8f, 0xe8, 0xb1, 0x82, 0x0b, 0x27, 0x84, 0x12, 0xa4,
+	0x9e, 0xf9, 0x0f, 0x90, 0x67, 0x66, 0xc7, 0xbf, 0x03, 0x84, 0x95, 0x38, 0x65, 0xc7, 0xdf, 0x37,
+	0xef, 0xfb, 0x19, 0x7b, 0xde, 0x7b, 0x0a, 0xf4, 0x28, 0xf2, 0x90, 0x85, 0x9c, 0xc0, 0x3a, 0xd9,
+	0xb1, 0xd2, 0xd3, 0x71, 0x9c, 0x90, 0x94, 0xa8, 0xcb, 0xd9, 0xd3, 0x31, 0x72, 0x82, 0xf1, 0xc9,
+	0x8e, 0xde, 0xf3, 0x88, 0x47, 0xd8, 0x73, 0x2b, 0xfb, 0xc5, 0x43, 0xf4, 0xa1, 0x47, 0x88, 0x17,
+	0x60, 0x0b, 0xc5, 0xbe, 0x85, 0xa2, 0x88, 0xa4, 0x28, 0xf5, 0x49, 0x44, 0x85, 0xba, 0xe6, 0x10,
+	0x1a, 0x12, 0xba, 0xcf, 0xb7, 0xf1, 0x85, 0x90, 0x06, 0x7c, 0x65, 0x85, 0xd4, 0xcb, 0x3c, 0x43,
+	0xea, 0xcd, 0xf6, 0x14, 0x51, 0x3c, 0x1c, 0x61, 0xea, 0x8b, 0x3d, 0xe6, 0x77, 0x0a, 0xac, 0x4c,
+	0xa8, 0xb7, 0xeb, 0xba, 0xbb, 0x6e, 0xe8, 0x47, 0x54, 0x7d, 0x1b, 0x3a, 0x14, 0x47, 0x2e, 0x4e,
+	0x34, 0x65, 0xa4, 0x6c, 0x75, 0xf7, 0xb4, 0x9f, 0x7f, 0xda, 0xee, 0x09, 0x9b, 0x5d, 0xd7, 0x4d,
+	0x30, 0xa5, 0x9f, 0xa4, 0x89, 0x1f, 0x79, 0xb6, 0x88, 0x53, 0xfb, 0xd0, 0x41, 0x6c, 0xaf, 0xb6,
+	0x38, 0x5a, 0xda, 0xea, 0xda, 0x62, 0xa5, 0xde, 0x83, 0x0e, 0x3e, 0x8d, 0xfd, 0x64, 0xaa, 0x2d,
+	0x8d, 0x94, 0xad, 0xe5, 0xfb, 0xab, 0xe3, 0xc2, 0xd9, 0xc7, 0x0f, 0x99, 0x64, 0x8b, 0x90, 0x07,
+	0xcb, 0x4f, 0x5f, 0x3e, 0xbb, 0x2b, 0x32, 0x9a, 0x7d, 0xe8, 0x15, 0x99, 0x6c, 0x4c, 0x63, 0x12,
+	0x51, 0x6c, 0x1e, 0xc2, 0xab, 0x13, 0xea, 0xd9, 0x38, 0x24, 0x27, 0x78, 0xde, 0xb8, 0x65, 0x82,
+	0x35, 0x18, 0x54, 0x9c, 0x24, 0xc4, 0x0f, 0x0a, 0x5c, 0x17, 0x74, 0x41, 0x40, 0xbe, 0xc0, 0xee,
+	0x25, 0x18, 0x34, 0xb8, 0x86, 0xf8, 0x66, 0x01, 0x31, 0x5b, 0xfe, 0x87, 0x97, 0x36, 0x80, 0x37,
+	0x4a, 0x58, 0x12, 0xf8, 0x08, 0x5e, 0xcb, 0xcf, 0x32, 0x7f, 0xe4, 0x32, 0x85, 0x0e, 0x5a, 0xd5,
+	0x4c, 0x82, 0x7c, 0x08, 0xdd, 0x09, 0xf5, 0x1e, 0x46, 0xe8, 0x20, 0xc0, 0xff, 0x9e, 0xa0, 0xec,
+	0xb3, 0x0a, 0xaf, 0xcb, 0x5c, 0xd2, 0xe0, 0x23, 0x80, 0x09, 0xf5, 0xde, 0xf7, 0xe9, 0x3c, 0x1c,
+	0x7a, 0xa0, 0xe6, 0xc9, 0xa4, 0xc5, 0xd7, 0xac, 0x5c, 0x3e, 0x48, 0x50, 0x94, 0xda, 0xe4, 0x32,
+	0x26, 0xaa, 0x0a, 0x57, 0x12, 0x12, 0x60, 0x6d, 0x31, 0x8b, 0xb7, 0xd9, 0x6f, 0x75, 0x08, 0x5d,
+	0xc4, 0x83, 0x31, 0xd5, 0x96, 0xd8, 0xeb, 0xcd, 0x1f, 0x34, 0xd5, 0x86, 0x04, 0x90, 0x60, 0x4f,
+	0xf8, 0xb5, 0xb4, 0xf1, 0x09, 0x39, 0xc2, 0xff, 0x0f, 0x1a, 0xbf, 0x81, 0x39, 0x41, 0x91, 0x2d,
+	0x2b, 0xdc, 0x47, 0x09, 0x89, 0x09, 0xe5, 0xf5, 0x74, 0x09, 0xba, 0x1e, 0x5c, 0x65, 0xa5, 0x2a,
+	0xf0, 0xf8, 0x22, 0xbb, 0x97, 0x09, 0x8e, 0x03, 0xe4, 0x60, 0x56, 0x31, 0xaf, 0xd8, 0xb3, 0x65,
+	0x53, 0x41, 0x17, 0x09, 0x24, 0xdd, 0xc7, 0x70, 0x23, 0x2b, 0x1c, 0xc7, 0xc1, 0x71, 0x7a, 0x49,
+	0xb6, 0xb2, 0x97, 0x06, 0xfd, 0x72, 0x42, 0x69, 0x15, 0x32, 0xe5, 0x3d, 0x14, 0x39, 0x38, 0x60,
+	0x0a, 0x07, 0x42, 0xc1, 0xbc, 0x5e, 0x47, 0x19, 0x64, 0x04, 0x46, 0xb3, 0x9d, 0x04, 0xfa, 0x86,
+	0x7f, 0x99, 0x4f, 0x63, 0x17, 0xa5, 0xf8, 0x11, 0x4a, 0x50, 0x48, 0xd5, 0x77, 0xa1, 0x8b, 0x8e,
+	0xd3, 0x43, 0x92, 0xf8, 0xe9, 0xf4, 0x6f, 0x69, 0xf2, 0x50, 0x75, 0x07, 0x3a, 0x31, 0xcb, 0xc0,
+	0x88, 0xaa, 0xad, 0x8b, 0x27, 0xdf, 0xbb, 0xf2, 0xfc, 0xb7, 0x8d, 0x05, 0x5b, 0x04, 0x3e, 0xb8,
+	0x91, 0xd1, 0xe6, 0x29, 0xc4, 0x57, 0x2a, 0xd2, 0xcc, 0x48, 0xef, 0xff, 0x09, 0xb0, 0x34, 0xa1,
+	0x9e, 0x1a, 0x42, 0x37, 0x1f, 0x56, 0x6b, 0x25, 0x8b, 0xe2, 0xcc, 0xd0, 0xdf, 0x6c, 0x95, 0xe4,
+	0xe1, 0xcd, 0xa7, 0xbf, 0xfc, 0xf1, 0xfd, 0xe2, 0xd0, 0xd4, 0xad, 0xf2, 0xa8, 0xb6, 0x90, 0xeb,
+	0xee, 0x8b, 0x21, 0x36, 0x85, 0x95, 0xd2, 0xbc, 0x19, 0x56, 0xd3, 0x16, 0x55, 0xfd, 0xf6, 0x45,
+	0xaa, 0xf4, 0xdd, 0x64, 0xbe, 0x1b, 0xe6, 0x7a, 0xd5, 0x37, 0x61, 0xd1, 0x33, 0xeb, 0xc7, 0x00,
+	0x85, 0x21, 0xa3, 0x37, 0x9d, 0x87, 0x6b, 0xba, 0xd9, 0xae, 0x49, 0xd3, 0x5b, 0xcc, 0x74, 0xdd,
+	0xbc, 0xd9, 0x78, 0x58, 0x61, 0xf2, 0x15, 0x5c, 0x2f, 0xcf, 0x89, 0xf5, 0x96, 0x03, 0x09, 0xe3,
+	0xcd, 0x0b, 0x65, 0xe9, 0xfd, 0x16, 0xf3, 0x1e, 0x99, 0x46, 0xdb, 0x81, 0x85, 0xdb, 0xe7, 0xd0,
+	0x11, 0xd3, 0xa1, 0x5f, 0x4d, 0xcc, 0x9f, 0xeb, 0x46, 0xf3, 0x73, 0xe9, 0x64, 0x30, 0x27, 0xcd,
+	0xec, 0x57, 0x9d, 0x30, 0xcf, 0xeb, 0xc2, 0xb5, 0xd9, 0x78, 0x18, 0x54, 0x53, 0x09, 0x41, 0xdf,
+	0x68, 0x11, 0xa4, 0xc9, 0x06, 0x33, 0x59, 0x33, 0x07, 0x55, 0x13, 0x57, 0xa4, 0x0e, 0xa1, 0x9b,
+	0x4f, 0x88, 0xda, 0x1d, 0x95, 0x52, 0xfd, 0x8e, 0xd6, 0xdb, 0x7a, 0xeb, 0x1d, 0xf5, 0xb2, 0xd0,
+	0x7d, 0xd6, 0xa2, 0x1f, 0x03, 0x14, 0xda, 0xbe, 0x5e, 0xff, 0x26, 0x33, 0xad, 0x7e, 0x51, 0x1a,
+	0x9a, 0x75, 0xeb, 0x45, 0x49, 0x58, 0x2c, 0xb7, 0x9c, 0xc2, 0x4a, 0xa9, 0x9b, 0xd7, 0xca, 0xa2,
+	0xa8, 0xd6, 0xcb, 0xa2, 0xb1, 0x0f, 0xb7, 0x96, 0x45, 0xcc, 0xa3, 0x79, 0x5d, 0xa8, 0xc7, 0xb0,
+	0x5c, 0xec, 0xd5, 0x37, 0x6b, 0x77, 0x3f, 0x17, 0xf5, 0x5b, 0x17, 0x88, 0xd2, 0xf7, 0x36, 0xf3,
+	0x35, 0xcc, 0x61, 0xad, 0x32, 0x58, 0xb0, 0xb0, 0xfd, 0x51, 0x81, 0xd5, 0xa6, 0xc6, 0x5d, 0xb3,
+	0x68, 0x08, 0xd2, 0xef, 0xfd, 0x83, 0x20, 0xc9, 0xb3, 0xcd, 0x78, 0xee, 0x98, 0x9b, 0x55, 0x1e,
+	0x87, 0x6d, 0xe2, 0x3c, 0xfb, 0xf1, 0x0c, 0xc0, 0x86, 0x95, 0x52, 0xfb, 0xae, 0x7d, 0x8a, 0xa2,
+	0x5a, 0xff, 0x14, 0x4d, 0xcd, 0x56, 0xbf, 0xfa, 0xe4, 0xe5, 0xb3, 0xbb, 0xca, 0xde, 0xee, 0xf3,
+	0x33, 0x43, 0x79, 0x71, 0x66, 0x28, 0xbf, 0x9f, 0x19, 0xca, 0xb7, 0xe7, 0xc6, 0xc2, 0x8b, 0x73,
+	0x63, 0xe1, 0xd7, 0x73, 0x63, 0xe1, 0xb3, 0x3b, 0x9e, 0x9f, 0x1e, 0x1e, 0x1f, 0x8c, 0x1d, 0x12,
+	0x32, 0xca, 0xd3, 0xe9, 0x97, 0xec, 0xef, 0x36, 0x75, 0x8f, 0xac, 0x53, 0xc6, 0x9c, 0x4e, 0x63,
+	0x4c, 0x0f, 0x3a, 0xec, 0xdf, 0x8c, 0x77, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x22, 0x73, 0x88,
+	0x77, 0x0e, 0x0d, 0x00, 0x00,
 }
 
-func (m *MsgEnable) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// AddAdmins adds addresses to the admin list.
+	AddAdmins(ctx context.Context, in *MsgAddAdmins, opts ...grpc.CallOption) (*MsgAddAdminsResponse, error)
+	// RemoveAdmins removes addresses from the admin list.
+	RemoveAdmins(ctx context.Context, in *MsgRemoveAdmins, opts ...grpc.CallOption) (*MsgRemoveAdminsResponse, error)
+	// AddAllowed adds addresses to the allowed list.
+	AddAllowed(ctx context.Context, in *MsgAddAllowed, opts ...grpc.CallOption) (*MsgAddAllowedResponse, error)
+	// RemoveAllowed removes addresses from the allowed list.
+	RemoveAllowed(ctx context.Context, in *MsgRemoveAllowed, opts ...grpc.CallOption) (*MsgRemoveAllowedResponse, error)
+	// Enable enables the access control based on the allowed list.
+	Enable(ctx context.Context, in *MsgEnable, opts ...grpc.CallOption) (*MsgEnableResponse, error)
+	// Disable disables the access control based on the allowed list.
+	Disable(ctx context.Context, in *MsgDisable, opts ...grpc.CallOption) (*MsgDisableResponse, error)
+	// GrantRole adds addresses to the members of a role.
+	GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error)
+	// RevokeRole removes addresses from the members of a role.
+	RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error)
+	// ProposeAdmin proposes an address as admin, which it becomes once it
+	// accepts.
+	ProposeAdmin(ctx context.Context, in *MsgProposeAdmin, opts ...grpc.CallOption) (*MsgProposeAdminResponse, error)
+	// AcceptAdmin accepts the admin proposal of the sender.
+	AcceptAdmin(ctx context.Context, in *MsgAcceptAdmin, opts ...grpc.CallOption) (*MsgAcceptAdminResponse, error)
+	// CancelAdminProposal cancels a pending admin proposal.
+	CancelAdminProposal(ctx context.Context, in *MsgCancelAdminProposal, opts ...grpc.CallOption) (*MsgCancelAdminProposalResponse, error)
+	// UpdateParams defines a governance operation for updating the x/acl module
+	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
+	// account
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgEnable) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgEnable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgEnableResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AddAdmins(ctx context.Context, in *MsgAddAdmins, opts ...grpc.CallOption) (*MsgAddAdminsResponse, error) {
+	out := new(MsgAddAdminsResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Msg/AddAdmins", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgEnableResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveAdmins(ctx context.Context, in *MsgRemoveAdmins, opts ...grpc.CallOption) (*MsgRemoveAdminsResponse, error) {
+	out := new(MsgRemoveAdminsResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Msg/RemoveAdmins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgEnableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) AddAllowed(ctx context.Context, in *MsgAddAllowed, opts ...grpc.CallOption) (*MsgAddAllowedResponse, error) {
+	out := new(MsgAddAllowedResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Msg/AddAllowed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDisable) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveAllowed(ctx context.Context, in *MsgRemoveAllowed, opts ...grpc.CallOption) (*MsgRemoveAllowedResponse, error) {
+	out := new(MsgRemoveAllowedResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Msg/RemoveAllowed", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDisable) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Enable(ctx context.Context, in *MsgEnable, opts ...grpc.CallOption) (*MsgEnableResponse, error) {
+	out := new(MsgEnableResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Msg/Enable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDisable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) Disable(ctx context.Context, in *MsgDisable, opts ...grpc.CallOption) (*MsgDisableResponse, error) {
+	out := new(MsgDisableResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Msg/Disable", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDisableResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*MsgGrantRoleResponse, error) {
+	out := new(MsgGrantRoleResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Msg/GrantRole", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDisableResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*MsgRevokeRoleResponse, error) {
+	out := new(MsgRevokeRoleResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Msg/RevokeRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDisableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ProposeAdmin(ctx context.Context, in *MsgProposeAdmin, opts ...grpc.CallOption) (*MsgProposeAdminResponse, error) {
+	out := new(MsgProposeAdminResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Msg/ProposeAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) AcceptAdmin(ctx context.Context, in *MsgAcceptAdmin, opts ...grpc.CallOption) (*MsgAcceptAdminResponse, error) {
+	out := new(MsgAcceptAdminResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Msg/AcceptAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgAddAdmins) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Admins) > 0 {
-		for _, s := range m.Admins {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+
+func (c *msgClient) CancelAdminProposal(ctx context.Context, in *MsgCancelAdminProposal, opts ...grpc.CallOption) (*MsgCancelAdminProposalResponse, error) {
+	out := new(MsgCancelAdminProposalResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Msg/CancelAdminProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgAddAdminsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/saga.acl.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgRemoveAdmins) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Admins) > 0 {
-		for _, s := range m.Admins {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// AddAdmins adds addresses to the admin list.
+	AddAdmins(context.Context, *MsgAddAdmins) (*MsgAddAdminsResponse, error)
+	// RemoveAdmins removes addresses from the admin list.
+	RemoveAdmins(context.Context, *MsgRemoveAdmins) (*MsgRemoveAdminsResponse, error)
+	// AddAllowed adds addresses to the allowed list.
+	AddAllowed(context.Context, *MsgAddAllowed) (*MsgAddAllowedResponse, error)
+	// RemoveAllowed removes addresses from the allowed list.
+	RemoveAllowed(context.Context, *MsgRemoveAllowed) (*MsgRemoveAllowedResponse, error)
+	// Enable enables the access control based on the allowed list.
+	Enable(context.Context, *MsgEnable) (*MsgEnableResponse, error)
+	// Disable disables the access control based on the allowed list.
+	Disable(context.Context, *MsgDisable) (*MsgDisableResponse, error)
+	// GrantRole adds addresses to the members of a role.
+	GrantRole(context.Context, *MsgGrantRole) (*MsgGrantRoleResponse, error)
+	// RevokeRole removes addresses from the members of a role.
+	RevokeRole(context.Context, *MsgRevokeRole) (*MsgRevokeRoleResponse, error)
+	// ProposeAdmin proposes an address as admin, which it becomes once it
+	// accepts.
+	ProposeAdmin(context.Context, *MsgProposeAdmin) (*MsgProposeAdminResponse, error)
+	// AcceptAdmin accepts the admin proposal of the sender.
+	AcceptAdmin(context.Context, *MsgAcceptAdmin) (*MsgAcceptAdminResponse, error)
+	// CancelAdminProposal cancels a pending admin proposal.
+	CancelAdminProposal(context.Context, *MsgCancelAdminProposal) (*MsgCancelAdminProposalResponse, error)
+	// UpdateParams defines a governance operation for updating the x/acl module
+	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
+	// account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgRemoveAdminsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgAddAllowed) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Allowed) > 0 {
-		for _, s := range m.Allowed {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (*UnimplementedMsgServer) AddAdmins(ctx context.Context, req *MsgAddAdmins) (*MsgAddAdminsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAdmins not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAdmins(ctx context.Context, req *MsgRemoveAdmins) (*MsgRemoveAdminsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAdmins not implemented")
+}
+func (*UnimplementedMsgServer) AddAllowed(ctx context.Context, req *MsgAddAllowed) (*MsgAddAllowedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAllowed not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAllowed(ctx context.Context, req *MsgRemoveAllowed) (*MsgRemoveAllowedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowed not implemented")
+}
+func (*UnimplementedMsgServer) Enable(ctx context.Context, req *MsgEnable) (*MsgEnableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Enable not implemented")
+}
+func (*UnimplementedMsgServer) Disable(ctx context.Context, req *MsgDisable) (*MsgDisableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Disable not implemented")
+}
+func (*UnimplementedMsgServer) GrantRole(ctx context.Context, req *MsgGrantRole) (*MsgGrantRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (*UnimplementedMsgServer) RevokeRole(ctx context.Context, req *MsgRevokeRole) (*MsgRevokeRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (*UnimplementedMsgServer) ProposeAdmin(ctx context.Context, req *MsgProposeAdmin) (*MsgProposeAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeAdmin not implemented")
+}
+func (*UnimplementedMsgServer) AcceptAdmin(ctx context.Context, req *MsgAcceptAdmin) (*MsgAcceptAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAdmin not implemented")
+}
+func (*UnimplementedMsgServer) CancelAdminProposal(ctx context.Context, req *MsgCancelAdminProposal) (*MsgCancelAdminProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAdminProposal not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
 
-func (m *MsgAddAllowedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgRemoveAllowed) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAdmins)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AddAdmins(ctx, in)
 	}
-	if len(m.Allowed) > 0 {
-		for _, s := range m.Allowed {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Msg/AddAdmins",
 	}
-	return n
-}
-
-func (m *MsgRemoveAllowedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAdmins(ctx, req.(*MsgAddAdmins))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgEnable) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RemoveAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAdmins)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAdmins(ctx, in)
 	}
-	return n
-}
-
-func (m *MsgEnableResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Msg/RemoveAdmins",
 	}
-	var l int
-	_ = l
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAdmins(ctx, req.(*MsgRemoveAdmins))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDisable) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddAllowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAllowed)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AddAllowed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Msg/AddAllowed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAllowed(ctx, req.(*MsgAddAllowed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAllowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAllowed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAllowed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Msg/RemoveAllowed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAllowed(ctx, req.(*MsgRemoveAllowed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Enable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnable)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Enable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Msg/Enable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Enable(ctx, req.(*MsgEnable))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Disable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisable)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Disable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Msg/Disable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Disable(ctx, req.(*MsgDisable))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Msg/GrantRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantRole(ctx, req.(*MsgGrantRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeRole)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Msg/RevokeRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeRole(ctx, req.(*MsgRevokeRole))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Msg/ProposeAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeAdmin(ctx, req.(*MsgProposeAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Msg/AcceptAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAdmin(ctx, req.(*MsgAcceptAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAdminProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAdminProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAdminProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Msg/CancelAdminProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAdminProposal(ctx, req.(*MsgCancelAdminProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.acl.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "saga.acl.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddAdmins",
+			Handler:    _Msg_AddAdmins_Handler,
+		},
+		{
+			MethodName: "RemoveAdmins",
+			Handler:    _Msg_RemoveAdmins_Handler,
+		},
+		{
+			MethodName: "AddAllowed",
+			Handler:    _Msg_AddAllowed_Handler,
+		},
+		{
+			MethodName: "RemoveAllowed",
+			Handler:    _Msg_RemoveAllowed_Handler,
+		},
+		{
+			MethodName: "Enable",
+			Handler:    _Msg_Enable_Handler,
+		},
+		{
+			MethodName: "Disable",
+			Handler:    _Msg_Disable_Handler,
+		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _Msg_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _Msg_RevokeRole_Handler,
+		},
+		{
+			MethodName: "ProposeAdmin",
+			Handler:    _Msg_ProposeAdmin_Handler,
+		},
+		{
+			MethodName: "AcceptAdmin",
+			Handler:    _Msg_AcceptAdmin_Handler,
+		},
+		{
+			MethodName: "CancelAdminProposal",
+			Handler:    _Msg_CancelAdminProposal_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "saga/acl/v1/tx.proto",
+}
+
+func (m *MsgAddAdmins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAdmins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAdmins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiry != nil {
+		{
+			size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Admins) > 0 {
+		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Admins[iNdEx])
+			copy(dAtA[i:], m.Admins[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Admins[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAdminsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAdminsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAdminsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAdmins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAdmins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAdmins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admins) > 0 {
+		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Admins[iNdEx])
+			copy(dAtA[i:], m.Admins[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Admins[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAdminsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAdminsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAdminsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAllowed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAllowed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAllowed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiry != nil {
+		{
+			size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Allowed) > 0 {
+		for iNdEx := len(m.Allowed) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowed[iNdEx])
+			copy(dAtA[i:], m.Allowed[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Allowed[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAllowedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAllowedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAllowedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAllowed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAllowed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAllowed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowed) > 0 {
+		for iNdEx := len(m.Allowed) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowed[iNdEx])
+			copy(dAtA[i:], m.Allowed[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Allowed[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAllowedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAllowedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAllowedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Replace {
+		i--
+		if m.Replace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAdminProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAdminProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAdminProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAdminProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAdminProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAdminProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddAdmins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Admins) > 0 {
+		for _, s := range m.Admins {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Expiry != nil {
+		l = m.Expiry.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddAdminsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAdmins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Admins) > 0 {
+		for _, s := range m.Admins {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveAdminsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAllowed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Allowed) > 0 {
+		for _, s := range m.Allowed {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Expiry != nil {
+		l = m.Expiry.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddAllowedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAllowed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Allowed) > 0 {
+		for _, s := range m.Allowed {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveAllowedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEnable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEnableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGrantRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRevokeRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProposeAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Replace {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgProposeAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelAdminProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelAdminProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddAdmins) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAdmins: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAdmins: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admins = append(m.Admins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = &Expiry{}
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAdminsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAdminsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAdminsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAdmins) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAdmins: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAdmins: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admins = append(m.Admins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAdminsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAdminsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAdminsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAllowed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAllowed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAllowed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowed = append(m.Allowed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = &Expiry{}
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAllowedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAllowedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAllowedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAllowed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAllowed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAllowed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowed = append(m.Allowed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAllowedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAllowedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAllowedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgDisableResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAddAdmins) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1421,10 +3835,10 @@ func (m *MsgAddAdmins) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAdmins: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAdmins: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1461,7 +3875,7 @@ func (m *MsgAddAdmins) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1489,7 +3903,39 @@ func (m *MsgAddAdmins) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admins = append(m.Admins, string(dAtA[iNdEx:postIndex]))
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1512,7 +3958,7 @@ func (m *MsgAddAdmins) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAdminsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1535,10 +3981,10 @@ func (m *MsgAddAdminsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAdminsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAdminsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1562,7 +4008,7 @@ func (m *MsgAddAdminsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveAdmins) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1585,10 +4031,10 @@ func (m *MsgRemoveAdmins) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveAdmins: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveAdmins: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1625,7 +4071,7 @@ func (m *MsgRemoveAdmins) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1653,7 +4099,39 @@ func (m *MsgRemoveAdmins) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admins = append(m.Admins, string(dAtA[iNdEx:postIndex]))
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1676,7 +4154,7 @@ func (m *MsgRemoveAdmins) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveAdminsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeRoleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1699,10 +4177,10 @@ func (m *MsgRemoveAdminsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveAdminsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeRoleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveAdminsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1726,7 +4204,7 @@ func (m *MsgRemoveAdminsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAllowed) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1749,10 +4227,10 @@ func (m *MsgAddAllowed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAllowed: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAllowed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1789,7 +4267,7 @@ func (m *MsgAddAllowed) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1817,8 +4295,28 @@ func (m *MsgAddAllowed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowed = append(m.Allowed, string(dAtA[iNdEx:postIndex]))
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replace = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1840,7 +4338,7 @@ func (m *MsgAddAllowed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAllowedResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1863,10 +4361,10 @@ func (m *MsgAddAllowedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAllowedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAllowedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1890,7 +4388,7 @@ func (m *MsgAddAllowedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveAllowed) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1913,10 +4411,10 @@ func (m *MsgRemoveAllowed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveAllowed: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveAllowed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1951,38 +4449,6 @@ func (m *MsgRemoveAllowed) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Allowed = append(m.Allowed, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2004,7 +4470,7 @@ func (m *MsgRemoveAllowed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveAllowedResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2027,10 +4493,10 @@ func (m *MsgRemoveAllowedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveAllowedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveAllowedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2054,7 +4520,7 @@ func (m *MsgRemoveAllowedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEnable) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelAdminProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2077,10 +4543,10 @@ func (m *MsgEnable) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEnable: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelAdminProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEnable: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelAdminProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2115,6 +4581,38 @@ func (m *MsgEnable) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2136,7 +4634,7 @@ func (m *MsgEnable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEnableResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelAdminProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2159,10 +4657,10 @@ func (m *MsgEnableResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEnableResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelAdminProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEnableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelAdminProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2186,7 +4684,7 @@ func (m *MsgEnableResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisable) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2209,15 +4707,15 @@ func (m *MsgDisable) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisable: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisable: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2245,7 +4743,40 @@ func (m *MsgDisable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2268,7 +4799,7 @@ func (m *MsgDisable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2291,10 +4822,10 @@ func (m *MsgDisableResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -20,7 +20,15 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(GetParamsCmd())
+	cmd.AddCommand(
+		GetParamsCmd(),
+		GetSuperuserCmd(),
+		GetPermissionsCmd(),
+		GetDenomsCmd(),
+		GetDenomCmd(),
+		GetSupplyRecordsCmd(),
+		GetMetadataHistoryCmd(),
+	)
 	return cmd
 }
 
@@ -57,7 +65,7 @@ func GetSuperuserCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "superuser",
 		Short: "Gets superuser address",
-		Long:  "Gets superuser address",
+		Long:  "Gets superuser address, whether the acl is enabled and the acl admins and role members holding admin permissions",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -81,3 +89,176 @@ func GetSuperuserCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetPermissionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "permissions",
+		Short: "Gets the registered permissions",
+		Long:  "Gets the registered admin-gated actions and whether they are enabled for the acl admins",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPermissionsRequest{}
+
+			res, err := queryClient.Permissions(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetDenomsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denoms",
+		Short: "Gets the denoms created through the module",
+		Long:  "Gets the denoms created through the module and their admins",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Denoms(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denoms")
+	return cmd
+}
+
+func GetDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom <denom>",
+		Short: "Gets a denom created through the module",
+		Long:  "Gets a denom created through the module and its admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.Denom(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetSupplyRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-records <denom>",
+		Short: "Gets the supply records of a denom",
+		Long:  "Gets the mints and burns of a denom created through the module, ordered by height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySupplyRecordsRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.SupplyRecords(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "supply records")
+	return cmd
+}
+
+func GetMetadataHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metadata-history <denom>",
+		Short: "Gets the metadata history of a denom",
+		Long:  "Gets the metadata changes of a denom made through the module, with the metadata each change replaced, ordered by height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryMetadataHistoryRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.MetadataHistory(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "metadata history")
+	return cmd
+}
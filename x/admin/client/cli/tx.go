@@ -6,13 +6,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/spf13/cobra"
 
+	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/sagaxyz/saga-sdk/x/admin/types"
 )
 
+// FlagForce is the flag of set-metadata replacing protected metadata
+const FlagForce = "force"
+
 // NewTxCmd returns a root CLI command handler for admin transaction commands
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -27,6 +33,12 @@ func NewTxCmd() *cobra.Command {
 		NewSetMetadataCmd(),
 		NewEnableSetMetadataCmd(),
 		NewDisableSetMetadataCmd(),
+		NewSetPermissionCmd(),
+		NewCreateDenomCmd(),
+		NewMintCmd(),
+		NewBurnCmd(),
+		NewChangeDenomAdminCmd(),
+		NewExecAsAuthorityCmd(),
 	)
 
 	return txCmd
@@ -49,6 +61,9 @@ metadata.json:
   "name": "stake",
   "symbol": "stake"
 }
+
+Existing metadata can only be replaced for denoms created with create-denom,
+unless the authority sets --force.
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -75,12 +90,17 @@ metadata.json:
 			}
 
 			msg := types.NewMsgSetMetadata(clientCtx.GetFromAddress().String(), metadata)
+			msg.Force, err = cmd.Flags().GetBool(FlagForce)
+			if err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool(FlagForce, false, "Replace the metadata of a denom not created through the module, as the authority")
 
 	return cmd
 }
@@ -130,3 +150,192 @@ func NewDisableSetMetadataCmd() *cobra.Command {
 
 	return cmd
 }
+
+func NewSetPermissionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-permission <name> <true|false>",
+		Short: "Enable or disable a permission",
+		Long:  "Enable or disable a registered admin-gated action, such as set-metadata, for the acl admins.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid enabled value: %w", err)
+			}
+
+			msg := types.NewMsgSetPermission(clientCtx.GetFromAddress().String(), args[0], enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCreateDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-denom <subdenom>",
+		Short: "Create a denom",
+		Long:  "Create the denom factory/{sender}/{subdenom}, administered by the sender.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateDenom(clientCtx.GetFromAddress().String(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewMintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint <amount> <recipient>",
+		Short: "Mint a denom created through the module",
+		Long:  "Mint an amount of a denom administered by the sender to the recipient.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+
+			msg := types.NewMsgMint(clientCtx.GetFromAddress().String(), amount, args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewBurnCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn <amount> <account>",
+		Short: "Burn a denom created through the module",
+		Long:  "Burn an amount of a denom administered by the sender from the account.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+
+			msg := types.NewMsgBurn(clientCtx.GetFromAddress().String(), amount, args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewChangeDenomAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-denom-admin <denom> <new-admin>",
+		Short: "Change the admin of a denom",
+		Long:  "Change the admin of a denom created through the module.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChangeDenomAdmin(clientCtx.GetFromAddress().String(), args[0], args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewExecAsAuthorityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec-as-authority <messages-file>",
+		Short: "Execute messages as the authority",
+		Long: `Execute messages with the admin module authority as signer. The message types must be
+registered by the app and their exec:<type-url> permission enabled.
+Example:
+  $ simd tx admin exec-as-authority messages.json
+messages.json:
+{
+  "messages": [
+    {
+      "@type": "/cosmos.consensus.v1.MsgUpdateParams",
+      "authority": "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+      ...
+    }
+  ]
+}
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read messages file: %w", err)
+			}
+
+			var file struct {
+				Messages []json.RawMessage `json:"messages"`
+			}
+			if err := json.Unmarshal(bz, &file); err != nil {
+				return fmt.Errorf("failed to parse messages JSON: %w", err)
+			}
+
+			msgs := make([]sdk.Msg, len(file.Messages))
+			for i, raw := range file.Messages {
+				if err := clientCtx.Codec.UnmarshalInterfaceJSON(raw, &msgs[i]); err != nil {
+					return fmt.Errorf("failed to parse message %d: %w", i, err)
+				}
+			}
+
+			msg, err := types.NewMsgExecAsAuthority(clientCtx.GetFromAddress().String(), msgs)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
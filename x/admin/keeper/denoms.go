@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/saga-sdk/x/admin/types"
+)
+
+// GetFactoryDenom returns a denom created through the module.
+func (k Keeper) GetFactoryDenom(ctx sdk.Context, denom string) (types.FactoryDenom, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.DenomKey(denom))
+	if bz == nil {
+		return types.FactoryDenom{}, false
+	}
+
+	var factoryDenom types.FactoryDenom
+	k.cdc.MustUnmarshal(bz, &factoryDenom)
+	return factoryDenom, true
+}
+
+// SetFactoryDenom stores a denom created through the module.
+func (k Keeper) SetFactoryDenom(ctx sdk.Context, denom types.FactoryDenom) {
+	ctx.KVStore(k.storeKey).Set(types.DenomKey(denom.Denom), k.cdc.MustMarshal(&denom))
+}
+
+// ExportFactoryDenoms returns the denoms created through the module.
+func (k Keeper) ExportFactoryDenoms(ctx sdk.Context) (denoms []types.FactoryDenom) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenoms).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var denom types.FactoryDenom
+		k.cdc.MustUnmarshal(iterator.Value(), &denom)
+		denoms = append(denoms, denom)
+	}
+	return denoms
+}
+
+// GetSupplyRecords returns the supply records of a denom ordered by height.
+func (k Keeper) GetSupplyRecords(ctx sdk.Context, denom string) (records []types.SupplyRecord) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.SupplyRecordKeyPrefix(denom)).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SupplyRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// ExportSupplyRecords returns the supply records of every denom.
+func (k Keeper) ExportSupplyRecords(ctx sdk.Context) (records []types.SupplyRecord) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSupplyRecords).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SupplyRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// SetSupplyRecord stores a supply record after the previous records of the
+// denom.
+func (k Keeper) SetSupplyRecord(ctx sdk.Context, record types.SupplyRecord) {
+	key := types.SupplyRecordKey(record.Denom, record.Height, k.nextSupplyRecordSequence(ctx))
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&record))
+}
+
+// recordSupplyChange records a change of the supply of a denom made by the
+// sender at the current block.
+func (k Keeper) recordSupplyChange(ctx sdk.Context, action types.SupplyAction, coin sdk.Coin, account, sender string) {
+	k.SetSupplyRecord(ctx, types.SupplyRecord{
+		Denom:   coin.Denom,
+		Action:  action,
+		Amount:  coin.Amount,
+		Account: account,
+		Sender:  sender,
+		Height:  ctx.BlockHeight(),
+		Time:    ctx.BlockTime(),
+	})
+}
+
+// nextSupplyRecordSequence returns the sequence ordering the supply records of
+// the same denom and height, and increments it.
+func (k Keeper) nextSupplyRecordSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	var sequence uint64
+	if bz := store.Get(types.KeySupplyRecordSequence); bz != nil {
+		sequence = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.KeySupplyRecordSequence, sdk.Uint64ToBigEndian(sequence+1))
+
+	return sequence
+}
+
+// authorizedDenomAdmin returns the denom if the sender is allowed to perform
+// the action on it: the authority always is, the admin of the denom is if the
+// permission is enabled for the ACL admins.
+func (k Keeper) authorizedDenomAdmin(ctx sdk.Context, sender, denom, permission string) (types.FactoryDenom, error) {
+	factoryDenom, found := k.GetFactoryDenom(ctx, denom)
+	if !found {
+		return types.FactoryDenom{}, errorsmod.Wrapf(ErrInvalidRequest, "denom %s not created through the module", denom)
+	}
+	if sender == k.GetAuthority() {
+		return factoryDenom, nil
+	}
+	if sender != factoryDenom.Admin || !k.Authorized(ctx, sender, permission) {
+		return types.FactoryDenom{}, errorsmod.Wrapf(ErrNotAuthorized, "%s not permitted to %s %s", sender, permission, denom)
+	}
+
+	return factoryDenom, nil
+}
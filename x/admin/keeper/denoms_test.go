@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/sagaxyz/saga-sdk/x/admin/keeper"
+	"github.com/sagaxyz/saga-sdk/x/admin/types"
+)
+
+func (suite *TestSuite) enablePermissions(names ...string) {
+	for _, name := range names {
+		_, err := suite.keeper.SetPermission(suite.ctx, types.NewMsgSetPermission(suite.authority, name, true))
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *TestSuite) TestCreateDenom() {
+	other := sdk.AccAddress([]byte{222})
+	denom := "factory/" + suite.adminAddress.String() + "/token"
+
+	testCases := []struct {
+		name       string
+		sender     string
+		subdenom   string
+		permission bool
+		malleate   func()
+		expErr     error
+	}{
+		{"admin", suite.adminAddress.String(), "token", true, func() {}, nil},
+		{"authority", suite.authority, "token", false, func() {}, nil},
+		{"admin without permission", suite.adminAddress.String(), "token", false, func() {}, keeper.ErrNotAuthorized},
+		{"other", other.String(), "token", true, func() {}, keeper.ErrNotAuthorized},
+		{"invalid subdenom", suite.adminAddress.String(), "to/ken", true, func() {}, keeper.ErrInvalidRequest},
+		{
+			"existing denom",
+			suite.adminAddress.String(), "token", true,
+			func() {
+				suite.keeper.SetFactoryDenom(suite.ctx, types.FactoryDenom{Denom: denom, Admin: suite.adminAddress.String()})
+			},
+			keeper.ErrInvalidRequest,
+		},
+		{
+			"existing supply",
+			suite.adminAddress.String(), "token", true,
+			func() {
+				suite.bankKeeper.supply = sdk.NewCoins(sdk.NewInt64Coin(denom, 1))
+			},
+			keeper.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			if tc.permission {
+				suite.enablePermissions(types.PermissionCreateDenom)
+			}
+			tc.malleate()
+
+			res, err := suite.keeper.CreateDenom(suite.ctx, types.NewMsgCreateDenom(tc.sender, tc.subdenom))
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			expDenom := "factory/" + tc.sender + "/" + tc.subdenom
+			suite.Require().Equal(expDenom, res.Denom)
+			factoryDenom, found := suite.keeper.GetFactoryDenom(suite.ctx, expDenom)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.sender, factoryDenom.Admin)
+			suite.Require().Contains(suite.bankKeeper.metadata, expDenom)
+		})
+	}
+}
+
+func (suite *TestSuite) TestMintBurn() {
+	suite.SetupTest()
+	suite.enablePermissions(types.PermissionCreateDenom, types.PermissionMint, types.PermissionBurn)
+	other := sdk.AccAddress([]byte{222})
+	recipient := sdk.AccAddress([]byte{233})
+
+	res, err := suite.keeper.CreateDenom(suite.ctx, types.NewMsgCreateDenom(suite.adminAddress.String(), "token"))
+	suite.Require().NoError(err)
+	coin := sdk.NewInt64Coin(res.Denom, 100)
+
+	// Only the denom admin and the authority can mint
+	suite.aclKeeper.admins[other.String()] = true
+	_, err = suite.keeper.Mint(suite.ctx, types.NewMsgMint(other.String(), coin, recipient.String()))
+	suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+	_, err = suite.keeper.Mint(suite.ctx, types.NewMsgMint(suite.adminAddress.String(), sdk.NewInt64Coin("stake", 100), recipient.String()))
+	suite.Require().ErrorIs(err, keeper.ErrInvalidRequest)
+
+	_, err = suite.keeper.Mint(suite.ctx, types.NewMsgMint(suite.adminAddress.String(), coin, recipient.String()))
+	suite.Require().NoError(err)
+	_, err = suite.keeper.Mint(suite.ctx, types.NewMsgMint(suite.authority, coin, recipient.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(res.Denom, 200)), suite.bankKeeper.balances[recipient.String()])
+
+	_, err = suite.keeper.Burn(suite.ctx, types.NewMsgBurn(suite.adminAddress.String(), sdk.NewInt64Coin(res.Denom, 300), recipient.String()))
+	suite.Require().Error(err)
+	_, err = suite.keeper.Burn(suite.ctx, types.NewMsgBurn(suite.adminAddress.String(), sdk.NewInt64Coin(res.Denom, 50), recipient.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(res.Denom, 150)), suite.bankKeeper.supply)
+
+	// Each action is gated individually
+	_, err = suite.keeper.SetPermission(suite.ctx, types.NewMsgSetPermission(suite.authority, types.PermissionBurn, false))
+	suite.Require().NoError(err)
+	_, err = suite.keeper.Burn(suite.ctx, types.NewMsgBurn(suite.adminAddress.String(), sdk.NewInt64Coin(res.Denom, 50), recipient.String()))
+	suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+	_, err = suite.keeper.Mint(suite.ctx, types.NewMsgMint(suite.adminAddress.String(), coin, recipient.String()))
+	suite.Require().NoError(err)
+
+	records := suite.keeper.GetSupplyRecords(suite.ctx, res.Denom)
+	suite.Require().Len(records, 4)
+	suite.Require().Equal(types.SUPPLY_ACTION_MINT, records[0].Action)
+	suite.Require().Equal(suite.authority, records[1].Sender)
+	suite.Require().Equal(types.SUPPLY_ACTION_BURN, records[2].Action)
+	suite.Require().Equal(math.NewInt(50), records[2].Amount)
+	suite.Require().Equal(recipient.String(), records[2].Account)
+
+	queryRes, err := suite.queryClient.SupplyRecords(suite.ctx, &types.QuerySupplyRecordsRequest{
+		Denom:      res.Denom,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(records[:2], queryRes.Records)
+	suite.Require().Equal(uint64(4), queryRes.Pagination.Total)
+}
+
+func (suite *TestSuite) TestChangeDenomAdmin() {
+	suite.SetupTest()
+	suite.enablePermissions(types.PermissionCreateDenom, types.PermissionMint, types.PermissionChangeDenomAdmin)
+	newAdmin := sdk.AccAddress([]byte{222})
+	suite.aclKeeper.admins[newAdmin.String()] = true
+
+	res, err := suite.keeper.CreateDenom(suite.ctx, types.NewMsgCreateDenom(suite.adminAddress.String(), "token"))
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.ChangeDenomAdmin(suite.ctx, types.NewMsgChangeDenomAdmin(newAdmin.String(), res.Denom, newAdmin.String()))
+	suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+	_, err = suite.keeper.ChangeDenomAdmin(suite.ctx, types.NewMsgChangeDenomAdmin(suite.adminAddress.String(), res.Denom, newAdmin.String()))
+	suite.Require().NoError(err)
+
+	queryRes, err := suite.queryClient.Denom(suite.ctx, &types.QueryDenomRequest{Denom: res.Denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(newAdmin.String(), queryRes.Denom.Admin)
+
+	// The previous admin cannot mint anymore
+	coin := sdk.NewInt64Coin(res.Denom, 100)
+	_, err = suite.keeper.Mint(suite.ctx, types.NewMsgMint(suite.adminAddress.String(), coin, newAdmin.String()))
+	suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+	_, err = suite.keeper.Mint(suite.ctx, types.NewMsgMint(newAdmin.String(), coin, newAdmin.String()))
+	suite.Require().NoError(err)
+
+	denomsRes, err := suite.queryClient.Denoms(suite.ctx, &types.QueryDenomsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.FactoryDenom{{Denom: res.Denom, Admin: newAdmin.String()}}, denomsRes.Denoms)
+
+	_, err = suite.queryClient.Denom(suite.ctx, &types.QueryDenomRequest{Denom: "stake"})
+	suite.Require().Error(err)
+}
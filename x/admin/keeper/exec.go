@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/saga-sdk/x/admin/types"
+)
+
+// RegisterExecMsgs registers message type URLs, e.g.
+// "/cosmos.consensus.v1.MsgUpdateParams", that the ACL admins can execute as
+// the authority once their exec permission is enabled.
+func (k Keeper) RegisterExecMsgs(typeURLs ...string) {
+	for _, typeURL := range typeURLs {
+		if typeURL == sdk.MsgTypeURL(&types.MsgExecAsAuthority{}) {
+			panic(fmt.Sprintf("%s cannot be executed as the authority", typeURL))
+		}
+		k.RegisterPermission(types.ExecPermission(typeURL))
+	}
+}
+
+// IsExecMsg returns true if the messages of the type URL can be executed as
+// the authority.
+func (k Keeper) IsExecMsg(typeURL string) bool {
+	return k.IsRegisteredPermission(types.ExecPermission(typeURL))
+}
+
+// execAsAuthority checks that the sender can execute the messages as the
+// authority and executes them.
+func (k Keeper) execAsAuthority(ctx sdk.Context, sender string, msgs []sdk.Msg) ([][]byte, error) {
+	if k.router == nil {
+		return nil, errorsmod.Wrap(ErrInvalidRequest, "no message router")
+	}
+	authority, err := sdk.AccAddressFromBech32(k.GetAuthority())
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+		if !k.IsExecMsg(typeURL) {
+			return nil, errorsmod.Wrapf(ErrNotAuthorized, "%s cannot be executed as the authority", typeURL)
+		}
+		if !k.Authorized(ctx, sender, types.ExecPermission(typeURL)) {
+			return nil, errorsmod.Wrapf(ErrNotAuthorized, "%s not permitted to execute %s", sender, typeURL)
+		}
+
+		signers, _, err := k.cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return nil, err
+		}
+		if len(signers) != 1 || !bytes.Equal(signers[0], authority) {
+			return nil, errorsmod.Wrapf(ErrInvalidRequest, "%s must be signed by the authority only", typeURL)
+		}
+
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return nil, errorsmod.Wrapf(ErrInvalidRequest, "no handler for %s", typeURL)
+		}
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to execute %s", typeURL)
+		}
+		results[i] = res.Data
+
+		ctx.EventManager().EmitEvents(res.GetEvents())
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExecAsAuthority,
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, typeURL),
+				sdk.NewAttribute(types.AttributeKeySender, sender),
+			),
+		)
+	}
+
+	return results, nil
+}
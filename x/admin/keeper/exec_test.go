@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/saga-sdk/x/admin/keeper"
+	"github.com/sagaxyz/saga-sdk/x/admin/types"
+)
+
+func (suite *TestSuite) TestExecAsAuthority() {
+	other := sdk.AccAddress([]byte{222})
+	setPermissionURL := sdk.MsgTypeURL(&types.MsgSetPermission{})
+
+	testCases := []struct {
+		name       string
+		sender     string
+		msg        sdk.Msg
+		register   bool
+		permission bool
+		expErr     error
+	}{
+		{"admin", suite.adminAddress.String(), types.NewMsgSetPermission(suite.authority, "test-action", true), true, true, nil},
+		{"authority", suite.authority, types.NewMsgSetPermission(suite.authority, "test-action", true), true, false, nil},
+		{"unregistered message", suite.adminAddress.String(), types.NewMsgSetPermission(suite.authority, "test-action", true), false, false, keeper.ErrNotAuthorized},
+		{"admin without permission", suite.adminAddress.String(), types.NewMsgSetPermission(suite.authority, "test-action", true), true, false, keeper.ErrNotAuthorized},
+		{"other", other.String(), types.NewMsgSetPermission(suite.authority, "test-action", true), true, true, keeper.ErrNotAuthorized},
+		{"not signed by the authority", suite.adminAddress.String(), types.NewMsgSetPermission(suite.adminAddress.String(), "test-action", true), true, true, keeper.ErrInvalidRequest},
+		{"failed message", suite.adminAddress.String(), types.NewMsgSetPermission(suite.authority, "unknown", true), true, true, keeper.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.keeper.RegisterPermission("test-action")
+			if tc.register {
+				suite.keeper.RegisterExecMsgs(setPermissionURL)
+			}
+			if tc.permission {
+				suite.enablePermissions(types.ExecPermission(setPermissionURL))
+			}
+
+			msg, err := types.NewMsgExecAsAuthority(tc.sender, []sdk.Msg{tc.msg})
+			suite.Require().NoError(err)
+			suite.Require().NoError(msg.ValidateBasic())
+
+			res, err := suite.keeper.ExecAsAuthority(suite.ctx, msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().False(suite.keeper.PermissionEnabled(suite.ctx, "test-action"))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Results, 1)
+			suite.Require().True(suite.keeper.PermissionEnabled(suite.ctx, "test-action"))
+		})
+	}
+
+	suite.Run("nested", func() {
+		suite.SetupTest()
+		suite.Require().Panics(func() {
+			suite.keeper.RegisterExecMsgs(sdk.MsgTypeURL(&types.MsgExecAsAuthority{}))
+		})
+
+		inner, err := types.NewMsgExecAsAuthority(suite.authority, []sdk.Msg{types.NewMsgSetPermission(suite.authority, "test-action", true)})
+		suite.Require().NoError(err)
+		msg, err := types.NewMsgExecAsAuthority(suite.adminAddress.String(), []sdk.Msg{inner})
+		suite.Require().NoError(err)
+		suite.Require().Error(msg.ValidateBasic())
+	})
+}
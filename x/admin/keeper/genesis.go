@@ -6,14 +6,29 @@ import (
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
+// The deprecated set_metadata flag is moved to the set-metadata permission.
 func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
-	k.SetParams(ctx, data.Params)
+	params := data.Params
+	params.Permissions.MigrateLegacy()
+	k.SetParams(ctx, params)
 
+	for _, denom := range data.Denoms {
+		k.SetFactoryDenom(ctx, denom)
+	}
+	for _, record := range data.SupplyRecords {
+		k.SetSupplyRecord(ctx, record)
+	}
+	for _, record := range data.MetadataRecords {
+		k.SetMetadataRecord(ctx, record)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
+		Params:          k.GetParams(ctx),
+		Denoms:          k.ExportFactoryDenoms(ctx),
+		SupplyRecords:   k.ExportSupplyRecords(ctx),
+		MetadataRecords: k.ExportMetadataHistory(ctx),
 	}
 }
@@ -1 +1,71 @@
 package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/sagaxyz/saga-sdk/x/admin/keeper"
+	"github.com/sagaxyz/saga-sdk/x/admin/types"
+)
+
+func (suite *TestSuite) TestInitGenesisLegacy() {
+	suite.SetupTest()
+
+	// The deprecated set_metadata flag enables the set-metadata permission
+	suite.keeper.InitGenesis(suite.ctx, &types.GenesisState{
+		Params: types.Params{Permissions: types.Permissions{SetMetadata: true}},
+	})
+	suite.Require().Equal(types.DefaultParams(), suite.keeper.ExportGenesis(suite.ctx).Params)
+}
+
+func (suite *TestSuite) TestMigrate1to2() {
+	testCases := []struct {
+		name      string
+		params    types.Params
+		expParams types.Params
+	}{
+		{
+			"set metadata enabled",
+			types.Params{Permissions: types.Permissions{SetMetadata: true}},
+			types.DefaultParams(),
+		},
+		{
+			"set metadata disabled",
+			types.Params{Permissions: types.Permissions{SetMetadata: false}},
+			types.Params{},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.keeper.SetParams(suite.ctx, tc.params)
+
+			err := keeper.NewMigrator(suite.keeper).Migrate1to2(suite.ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expParams, suite.keeper.GetParams(suite.ctx))
+		})
+	}
+}
+
+func (suite *TestSuite) TestExportGenesisDenoms() {
+	suite.SetupTest()
+
+	denom := "factory/" + suite.adminAddress.String() + "/token"
+	genesis := types.DefaultGenesis()
+	genesis.Denoms = []types.FactoryDenom{{Denom: denom, Admin: suite.adminAddress.String()}}
+	genesis.SupplyRecords = []types.SupplyRecord{
+		{
+			Denom:   denom,
+			Action:  types.SUPPLY_ACTION_MINT,
+			Amount:  math.NewInt(100),
+			Account: suite.adminAddress.String(),
+			Sender:  suite.adminAddress.String(),
+			Height:  1,
+			Time:    suite.ctx.BlockTime().UTC(),
+		},
+	}
+	suite.Require().NoError(genesis.Validate())
+
+	suite.keeper.InitGenesis(suite.ctx, genesis)
+	suite.Require().Equal(genesis, suite.keeper.ExportGenesis(suite.ctx))
+}
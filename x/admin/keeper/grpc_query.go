@@ -3,7 +3,11 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/sagaxyz/saga-sdk/x/admin/types"
 )
@@ -21,7 +25,134 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 }
 
 func (k Keeper) Superuser(c context.Context, _ *types.QuerySuperuserRequest) (*types.QuerySuperuserResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
 	return &types.QuerySuperuserResponse{
-		Superuser: k.GetAuthority(),
+		Superuser:  k.GetAuthority(),
+		AclEnabled: k.aclKeeper != nil && k.aclKeeper.Enabled(ctx),
+		Holders:    k.PermissionHolders(ctx),
+	}, nil
+}
+
+func (k Keeper) Permissions(c context.Context, _ *types.QueryPermissionsRequest) (*types.QueryPermissionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	permissions := k.GetParams(ctx).Permissions
+
+	var res []types.Permission
+	for _, name := range k.RegisteredPermissions() {
+		res = append(res, types.Permission{
+			Name:    name,
+			Enabled: permissions.Enabled(name),
+		})
+	}
+
+	return &types.QueryPermissionsResponse{
+		Permissions: res,
+	}, nil
+}
+
+func (k Keeper) Denoms(c context.Context, req *types.QueryDenomsRequest) (*types.QueryDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenoms)
+
+	var denoms []types.FactoryDenom
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var denom types.FactoryDenom
+		if err := k.cdc.Unmarshal(value, &denom); err != nil {
+			return err
+		}
+
+		denoms = append(denoms, denom)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomsResponse{
+		Denoms:     denoms,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) Denom(c context.Context, req *types.QueryDenomRequest) (*types.QueryDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denom, found := k.GetFactoryDenom(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "denom %s not created through the module", req.Denom)
+	}
+
+	return &types.QueryDenomResponse{
+		Denom: denom,
+	}, nil
+}
+
+func (k Keeper) SupplyRecords(c context.Context, req *types.QuerySupplyRecordsRequest) (*types.QuerySupplyRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SupplyRecordKeyPrefix(req.Denom))
+
+	var records []types.SupplyRecord
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var record types.SupplyRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySupplyRecordsResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) MetadataHistory(c context.Context, req *types.QueryMetadataHistoryRequest) (*types.QueryMetadataHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MetadataRecordKeyPrefix(req.Denom))
+
+	var records []types.MetadataRecord
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var record types.MetadataRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMetadataHistoryResponse{
+		Records:    records,
+		Pagination: pageRes,
 	}, nil
 }
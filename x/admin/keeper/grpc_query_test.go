@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	acltypes "github.com/sagaxyz/saga-sdk/x/acl/types"
+	"github.com/sagaxyz/saga-sdk/x/admin/types"
+)
+
+func (suite *TestSuite) TestSuperuser() {
+	suite.SetupTest()
+	suite.enablePermissions(types.PermissionMint)
+	admin := sdk.AccAddress([]byte{111})
+	expired := sdk.AccAddress([]byte{222})
+	metadataSetter := sdk.AccAddress([]byte{233})
+	suite.aclKeeper.admins[admin.String()] = true
+	suite.aclKeeper.admins[expired.String()] = false
+	suite.aclKeeper.roles[metadataSetter.String()] = acltypes.RoleMetadataSetter
+	suite.aclKeeper.roles[admin.String()] = acltypes.RoleMetadataSetter
+
+	res, err := suite.queryClient.Superuser(suite.ctx, &types.QuerySuperuserRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.authority, res.Superuser)
+	suite.Require().True(res.AclEnabled)
+
+	var admins []types.PermissionHolder
+	for _, addr := range suite.aclKeeper.ExportAdmins(suite.ctx) {
+		admins = append(admins, types.PermissionHolder{
+			Address:     addr,
+			AclAdmin:    true,
+			Permissions: []string{types.PermissionMint, types.PermissionSetMetadata},
+		})
+	}
+	suite.Require().Equal(append(admins, types.PermissionHolder{
+		Address:     metadataSetter.String(),
+		Permissions: []string{types.PermissionSetMetadata},
+	}), res.Holders)
+
+	// No permission is held while the ACL is disabled
+	suite.aclKeeper.enabled = false
+	res, err = suite.queryClient.Superuser(suite.ctx, &types.QuerySuperuserRequest{})
+	suite.Require().NoError(err)
+	suite.Require().False(res.AclEnabled)
+	suite.Require().Len(res.Holders, 3)
+	for _, holder := range res.Holders {
+		suite.Require().Empty(holder.Permissions)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"cosmossdk.io/log"
 
 	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -17,22 +18,38 @@ type Keeper struct {
 	paramSpace paramtypes.Subspace
 	bankKeeper types.BankKeeper
 	aclKeeper  types.AclKeeper
+	router     baseapp.MessageRouter
 	authority  string
+
+	// permissions are the registered permission names
+	permissions map[string]bool
 }
 
-func New(cdc codec.Codec, storeKey storetypes.StoreKey, ps paramtypes.Subspace, bk types.BankKeeper, aclk types.AclKeeper, authority string) Keeper {
+// New returns the admin keeper. The message router is optional and only
+// required to execute messages as the authority.
+func New(cdc codec.Codec, storeKey storetypes.StoreKey, ps paramtypes.Subspace, bk types.BankKeeper, aclk types.AclKeeper, router baseapp.MessageRouter, authority string) Keeper {
 	if !ps.HasKeyTable() {
 		ps = ps.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return Keeper{
+	k := Keeper{
 		cdc:        cdc,
 		storeKey:   storeKey,
 		paramSpace: ps,
 		bankKeeper: bk,
 		aclKeeper:  aclk,
+		router:     router,
 		authority:  authority,
+
+		permissions: make(map[string]bool),
 	}
+	k.RegisterPermission(types.PermissionSetMetadata)
+	k.RegisterPermission(types.PermissionCreateDenom)
+	k.RegisterPermission(types.PermissionMint)
+	k.RegisterPermission(types.PermissionBurn)
+	k.RegisterPermission(types.PermissionChangeDenomAdmin)
+
+	return k
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
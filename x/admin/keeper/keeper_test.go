@@ -1,9 +1,195 @@
 package keeper_test
 
 import (
+	"context"
+	"sort"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/suite"
+
+	"github.com/sagaxyz/saga-sdk/x/admin"
+	"github.com/sagaxyz/saga-sdk/x/admin/keeper"
+	"github.com/sagaxyz/saga-sdk/x/admin/types"
 )
 
+type mockBankKeeper struct {
+	metadata map[string]banktypes.Metadata
+	balances map[string]sdk.Coins
+	supply   sdk.Coins
+}
+
+func (k *mockBankKeeper) GetDenomMetaData(_ context.Context, denom string) (banktypes.Metadata, bool) {
+	metadata, found := k.metadata[denom]
+	return metadata, found
+}
+
+func (k *mockBankKeeper) SetDenomMetaData(_ context.Context, metadata banktypes.Metadata) {
+	k.metadata[metadata.Base] = metadata
+}
+
+func (k *mockBankKeeper) HasSupply(_ context.Context, denom string) bool {
+	return k.supply.AmountOf(denom).IsPositive()
+}
+
+func (k *mockBankKeeper) MintCoins(_ context.Context, moduleName string, amounts sdk.Coins) error {
+	k.supply = k.supply.Add(amounts...)
+	k.balances[moduleName] = k.balances[moduleName].Add(amounts...)
+	return nil
+}
+
+func (k *mockBankKeeper) BurnCoins(_ context.Context, moduleName string, amounts sdk.Coins) error {
+	balance, negative := k.balances[moduleName].SafeSub(amounts...)
+	if negative {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	k.balances[moduleName] = balance
+	k.supply = k.supply.Sub(amounts...)
+	return nil
+}
+
+func (k *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	balance, negative := k.balances[senderModule].SafeSub(amt...)
+	if negative {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	k.balances[senderModule] = balance
+	k.balances[recipientAddr.String()] = k.balances[recipientAddr.String()].Add(amt...)
+	return nil
+}
+
+func (k *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	balance, negative := k.balances[senderAddr.String()].SafeSub(amt...)
+	if negative {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	k.balances[senderAddr.String()] = balance
+	k.balances[recipientModule] = k.balances[recipientModule].Add(amt...)
+	return nil
+}
+
+type mockAclKeeper struct {
+	enabled bool
+	admins  map[string]bool
+	roles   map[string]string
+}
+
+func (k *mockAclKeeper) IsAdmin(_ sdk.Context, addr sdk.AccAddress) bool {
+	return k.admins[addr.String()]
+}
+
+func (k *mockAclKeeper) HasRole(_ sdk.Context, addr sdk.AccAddress, role string) bool {
+	return k.roles[addr.String()] == role
+}
+
+func (k *mockAclKeeper) Enabled(_ sdk.Context) bool {
+	return k.enabled
+}
+
+// ExportAdmins returns the addresses of the admins map sorted, skipping the
+// ones mapped to false as expired admins.
+func (k *mockAclKeeper) ExportAdmins(_ sdk.Context) (addresses []string) {
+	for addr, admin := range k.admins {
+		if admin {
+			addresses = append(addresses, addr)
+		}
+	}
+	sort.Strings(addresses)
+	return addresses
+}
+
+func (k *mockAclKeeper) GetRoleMembers(_ sdk.Context, role string) (addresses []string) {
+	for addr, r := range k.roles {
+		if r == role {
+			addresses = append(addresses, addr)
+		}
+	}
+	sort.Strings(addresses)
+	return addresses
+}
+
 type TestSuite struct {
 	suite.Suite
+
+	ctx          sdk.Context
+	keeper       keeper.Keeper
+	paramsKeeper paramskeeper.Keeper //nolint:staticcheck
+	bankKeeper   *mockBankKeeper
+	aclKeeper    *mockAclKeeper
+	queryClient  types.QueryClient
+
+	authority    string
+	adminAddress sdk.AccAddress
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (suite *TestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	paramsKey := storetypes.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := storetypes.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{
+			types.StoreKey:       key,
+			paramstypes.StoreKey: paramsKey,
+		},
+		map[string]*storetypes.TransientStoreKey{
+			paramstypes.TStoreKey: paramsTKey,
+		},
+		nil)
+	suite.ctx = ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now()})
+	encCfg := moduletestutil.MakeTestEncodingConfig(admin.AppModuleBasic{})
+
+	//nolint:staticcheck
+	suite.paramsKeeper = paramskeeper.NewKeeper(
+		encCfg.Codec,
+		encCfg.Amino,
+		paramsKey,
+		paramsTKey,
+	)
+	ss := suite.paramsKeeper.Subspace(types.ModuleName)
+
+	suite.adminAddress = sdk.AccAddress([]byte{123})
+	suite.authority = sdk.AccAddress(address.Module("gov")).String()
+	suite.bankKeeper = &mockBankKeeper{
+		metadata: make(map[string]banktypes.Metadata),
+		balances: make(map[string]sdk.Coins),
+	}
+	suite.aclKeeper = &mockAclKeeper{
+		enabled: true,
+		admins:  map[string]bool{suite.adminAddress.String(): true},
+		roles:   make(map[string]string),
+	}
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	suite.keeper = keeper.New(
+		encCfg.Codec,
+		key,
+		ss,
+		suite.bankKeeper,
+		suite.aclKeeper,
+		router,
+		suite.authority,
+	)
+	suite.keeper.InitGenesis(suite.ctx, types.DefaultGenesis())
+	types.RegisterMsgServer(router, suite.keeper)
+
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, suite.keeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
 }
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/sagaxyz/saga-sdk/x/admin/types"
+)
+
+// GetMetadataHistory returns the metadata records of a denom ordered by
+// height.
+func (k Keeper) GetMetadataHistory(ctx sdk.Context, denom string) (records []types.MetadataRecord) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.MetadataRecordKeyPrefix(denom)).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.MetadataRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// ExportMetadataHistory returns the metadata records of every denom.
+func (k Keeper) ExportMetadataHistory(ctx sdk.Context) (records []types.MetadataRecord) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMetadataRecords).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.MetadataRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// SetMetadataRecord stores a metadata record after the previous records of
+// the denom.
+func (k Keeper) SetMetadataRecord(ctx sdk.Context, record types.MetadataRecord) {
+	key := types.MetadataRecordKey(record.Denom, record.Height, k.nextMetadataRecordSequence(ctx))
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&record))
+}
+
+// setDenomMetadata sets the metadata of a denom, records the metadata it
+// replaces and emits the set-metadata event.
+func (k Keeper) setDenomMetadata(ctx sdk.Context, metadata banktypes.Metadata, sender string, forced bool) {
+	record := types.MetadataRecord{
+		Denom:  metadata.Base,
+		Sender: sender,
+		Forced: forced,
+		Height: ctx.BlockHeight(),
+		Time:   ctx.BlockTime(),
+	}
+	if previous, found := k.bankKeeper.GetDenomMetaData(ctx, metadata.Base); found {
+		record.Previous = &previous
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	k.SetMetadataRecord(ctx, record)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, metadata.Base),
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyForced, strconv.FormatBool(forced)),
+		),
+	)
+}
+
+// nextMetadataRecordSequence returns the sequence ordering the metadata
+// records of the same denom and height, and increments it.
+func (k Keeper) nextMetadataRecordSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	var sequence uint64
+	if bz := store.Get(types.KeyMetadataRecordSequence); bz != nil {
+		sequence = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.KeyMetadataRecordSequence, sdk.Uint64ToBigEndian(sequence+1))
+
+	return sequence
+}
@@ -6,6 +6,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/sagaxyz/saga-sdk/x/admin/types"
 )
@@ -24,27 +25,43 @@ func (k Keeper) SetMetadata(
 	if msg.Metadata == nil {
 		return nil, errorsmod.Wrap(ErrInvalidRequest, "metadata is nil")
 	}
+	if err := msg.Metadata.Validate(); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidRequest, err.Error())
+	}
 
-	params := k.GetParams(ctx)
-	accAddr, _ := sdk.AccAddressFromBech32(msg.Authority)
-	isACLAdmin := params.Permissions.SetMetadata &&
-		k.aclKeeper != nil &&
-		k.aclKeeper.Enabled(ctx) &&
-		k.aclKeeper.IsAdmin(ctx, accAddr)
-	isModuleAuth := msg.Authority == k.GetAuthority()
-
-	if !isACLAdmin && !isModuleAuth {
+	if !k.Authorized(ctx, msg.Authority, types.PermissionSetMetadata, permissionRoles[types.PermissionSetMetadata]...) {
 		return nil, errorsmod.Wrap(ErrNotAuthorized, "authority not permitted")
 	}
+	if msg.Force && msg.Authority != k.GetAuthority() {
+		return nil, errorsmod.Wrap(ErrNotAuthorized, "only the authority can force metadata")
+	}
 
-	k.bankKeeper.SetDenomMetaData(ctx, *msg.Metadata)
+	// The metadata of factory denoms is set once they are created, by their
+	// admin
+	denom := msg.Metadata.Base
+	if types.IsFactoryDenom(denom) {
+		factoryDenom, found := k.GetFactoryDenom(ctx, denom)
+		if !found {
+			return nil, errorsmod.Wrapf(ErrInvalidRequest, "denom %s not created through the module", denom)
+		}
+		if msg.Authority != k.GetAuthority() && msg.Authority != factoryDenom.Admin {
+			return nil, errorsmod.Wrapf(ErrNotAuthorized, "%s is not the admin of %s", msg.Authority, denom)
+		}
+	}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeSetMetadata,
-			sdk.NewAttribute(types.AttributeKeyDenom, msg.Metadata.Base),
-		),
-	)
+	// Existing metadata can only be replaced for denoms created through the
+	// module, unless forced by the authority
+	var forced bool
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		if _, factory := k.GetFactoryDenom(ctx, denom); !factory {
+			if !msg.Force {
+				return nil, errorsmod.Wrapf(ErrNotAuthorized, "metadata of denom %s is protected", denom)
+			}
+			forced = true
+		}
+	}
+
+	k.setDenomMetadata(ctx, *msg.Metadata, msg.Authority, forced)
 
 	return &types.MsgSetMetadataResponse{}, nil
 }
@@ -56,9 +73,10 @@ func (k Keeper) EnableSetMetadata(goCtx context.Context, msg *types.MsgEnableSet
 		return nil, ErrNotAuthorized
 	}
 
-	p := k.GetParams(ctx)
-	p.Permissions.SetMetadata = true
-	k.SetParams(ctx, p)
+	err = k.setPermission(ctx, types.PermissionSetMetadata, true)
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.MsgEnableSetMetadataResponse{}, nil
 }
@@ -70,9 +88,184 @@ func (k Keeper) DisableSetMetadata(goCtx context.Context, msg *types.MsgDisableS
 		return nil, ErrNotAuthorized
 	}
 
-	p := k.GetParams(ctx)
-	p.Permissions.SetMetadata = false
-	k.SetParams(ctx, p)
+	err = k.setPermission(ctx, types.PermissionSetMetadata, false)
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.MsgDisableSetMetadataResponse{}, nil
 }
+
+func (k Keeper) SetPermission(goCtx context.Context, msg *types.MsgSetPermission) (*types.MsgSetPermissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, ErrNotAuthorized
+	}
+
+	err := k.setPermission(ctx, msg.Name, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPermissionResponse{}, nil
+}
+
+func (k Keeper) CreateDenom(goCtx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.Authorized(ctx, msg.Authority, types.PermissionCreateDenom) {
+		return nil, errorsmod.Wrap(ErrNotAuthorized, "authority not permitted")
+	}
+
+	denom, err := types.GetFactoryDenom(msg.Authority, msg.Subdenom)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidRequest, err.Error())
+	}
+	if _, found := k.GetFactoryDenom(ctx, denom); found || k.bankKeeper.HasSupply(ctx, denom) {
+		return nil, errorsmod.Wrapf(ErrInvalidRequest, "denom %s already exists", denom)
+	}
+
+	k.SetFactoryDenom(ctx, types.FactoryDenom{
+		Denom: denom,
+		Admin: msg.Authority,
+	})
+	k.setDenomMetadata(ctx, banktypes.Metadata{
+		Base: denom,
+		DenomUnits: []*banktypes.DenomUnit{{
+			Denom:    denom,
+			Exponent: 0,
+		}},
+		Display: denom,
+		Name:    denom,
+		Symbol:  msg.Subdenom,
+	}, msg.Authority, false)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateDenom,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyAdmin, msg.Authority),
+		),
+	)
+
+	return &types.MsgCreateDenomResponse{
+		Denom: denom,
+	}, nil
+}
+
+func (k Keeper) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, err := k.authorizedDenomAdmin(ctx, msg.Authority, msg.Amount.Denom, types.PermissionMint)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidRequest, err.Error())
+	}
+
+	coins := sdk.NewCoins(msg.Amount)
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+	if err != nil {
+		return nil, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
+	if err != nil {
+		return nil, err
+	}
+	k.recordSupplyChange(ctx, types.SUPPLY_ACTION_MINT, msg.Amount, msg.Recipient, msg.Authority)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMint,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Amount.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgMintResponse{}, nil
+}
+
+func (k Keeper) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, err := k.authorizedDenomAdmin(ctx, msg.Authority, msg.Amount.Denom, types.PermissionBurn)
+	if err != nil {
+		return nil, err
+	}
+	account, err := sdk.AccAddressFromBech32(msg.Account)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidRequest, err.Error())
+	}
+
+	coins := sdk.NewCoins(msg.Amount)
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, account, types.ModuleName, coins)
+	if err != nil {
+		return nil, err
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+	if err != nil {
+		return nil, err
+	}
+	k.recordSupplyChange(ctx, types.SUPPLY_ACTION_BURN, msg.Amount, msg.Account, msg.Authority)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurn,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Amount.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Account),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+func (k Keeper) ChangeDenomAdmin(goCtx context.Context, msg *types.MsgChangeDenomAdmin) (*types.MsgChangeDenomAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	factoryDenom, err := k.authorizedDenomAdmin(ctx, msg.Authority, msg.Denom, types.PermissionChangeDenomAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewAdmin); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidRequest, err.Error())
+	}
+
+	factoryDenom.Admin = msg.NewAdmin
+	k.SetFactoryDenom(ctx, factoryDenom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeDenomAdmin,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyAdmin, msg.NewAdmin),
+			sdk.NewAttribute(types.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgChangeDenomAdminResponse{}, nil
+}
+
+func (k Keeper) ExecAsAuthority(goCtx context.Context, msg *types.MsgExecAsAuthority) (*types.MsgExecAsAuthorityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidRequest, err.Error())
+	}
+
+	results, err := k.execAsAuthority(ctx, msg.Authority, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecAsAuthorityResponse{
+		Results: results,
+	}, nil
+}
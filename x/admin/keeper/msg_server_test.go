@@ -1 +1,196 @@
 package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	acltypes "github.com/sagaxyz/saga-sdk/x/acl/types"
+	"github.com/sagaxyz/saga-sdk/x/admin/keeper"
+	"github.com/sagaxyz/saga-sdk/x/admin/types"
+)
+
+func (suite *TestSuite) TestSetPermission() {
+	suite.SetupTest()
+	suite.keeper.RegisterPermission("test-action")
+	suite.Require().False(suite.keeper.PermissionEnabled(suite.ctx, "test-action"))
+
+	// Only the authority can set permissions
+	_, err := suite.keeper.SetPermission(suite.ctx, types.NewMsgSetPermission(suite.adminAddress.String(), "test-action", true))
+	suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+
+	_, err = suite.keeper.SetPermission(suite.ctx, types.NewMsgSetPermission(suite.authority, "test-action", true))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.keeper.PermissionEnabled(suite.ctx, "test-action"))
+	suite.Require().True(suite.keeper.Authorized(suite.ctx, suite.adminAddress.String(), "test-action"))
+
+	// Unknown permissions cannot be set
+	_, err = suite.keeper.SetPermission(suite.ctx, types.NewMsgSetPermission(suite.authority, "unknown", true))
+	suite.Require().ErrorIs(err, keeper.ErrInvalidRequest)
+
+	res, err := suite.queryClient.Permissions(suite.ctx, &types.QueryPermissionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Permission{
+		{Name: types.PermissionBurn, Enabled: false},
+		{Name: types.PermissionChangeDenomAdmin, Enabled: false},
+		{Name: types.PermissionCreateDenom, Enabled: false},
+		{Name: types.PermissionMint, Enabled: false},
+		{Name: types.PermissionSetMetadata, Enabled: true},
+		{Name: "test-action", Enabled: true},
+	}, res.Permissions)
+
+	_, err = suite.keeper.SetPermission(suite.ctx, types.NewMsgSetPermission(suite.authority, "test-action", false))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.keeper.Authorized(suite.ctx, suite.adminAddress.String(), "test-action"))
+	suite.Require().True(suite.keeper.Authorized(suite.ctx, suite.authority, "test-action"))
+
+	suite.Require().Panics(func() {
+		suite.keeper.RegisterPermission("test-action")
+	})
+}
+
+func (suite *TestSuite) TestSetMetadata() {
+	metadataSetter := sdk.AccAddress([]byte{111})
+	other := sdk.AccAddress([]byte{222})
+	metadata := banktypes.Metadata{
+		Base:       "utoken",
+		Display:    "token",
+		Name:       "Token",
+		Symbol:     "TOKEN",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "utoken"}, {Denom: "token", Exponent: 6}},
+	}
+
+	testCases := []struct {
+		name       string
+		sender     string
+		permission bool
+		aclEnabled bool
+		expErr     bool
+	}{
+		{"authority", suite.authority, false, false, false},
+		{"admin", suite.adminAddress.String(), true, true, false},
+		{"metadata setter", metadataSetter.String(), true, true, false},
+		{"other", other.String(), true, true, true},
+		{"admin without permission", suite.adminAddress.String(), false, true, true},
+		{"admin with disabled acl", suite.adminAddress.String(), true, false, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.aclKeeper.enabled = tc.aclEnabled
+			suite.aclKeeper.roles[metadataSetter.String()] = acltypes.RoleMetadataSetter
+			if !tc.permission {
+				_, err := suite.keeper.DisableSetMetadata(suite.ctx, types.NewMsgDisableSetMetadata(suite.authority))
+				suite.Require().NoError(err)
+			}
+
+			_, err := suite.keeper.SetMetadata(suite.ctx, types.NewMsgSetMetadata(tc.sender, metadata))
+			if tc.expErr {
+				suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+				suite.Require().Empty(suite.bankKeeper.metadata)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(metadata, suite.bankKeeper.metadata["utoken"])
+		})
+	}
+}
+
+func (suite *TestSuite) TestSetMetadataProtection() {
+	metadata := banktypes.Metadata{
+		Base:       "utoken",
+		Display:    "token",
+		Name:       "Token",
+		Symbol:     "TOKEN",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "utoken"}, {Denom: "token", Exponent: 6}},
+	}
+	updated := metadata
+	updated.Name = "Updated Token"
+
+	suite.Run("invalid metadata", func() {
+		suite.SetupTest()
+		invalid := metadata
+		invalid.Display = "unknown"
+		_, err := suite.keeper.SetMetadata(suite.ctx, types.NewMsgSetMetadata(suite.authority, invalid))
+		suite.Require().ErrorIs(err, keeper.ErrInvalidRequest)
+	})
+	suite.Run("protected denom", func() {
+		suite.SetupTest()
+		_, err := suite.keeper.SetMetadata(suite.ctx, types.NewMsgSetMetadata(suite.adminAddress.String(), metadata))
+		suite.Require().NoError(err)
+
+		// Existing metadata of denoms not created through the module cannot
+		// be replaced, unless forced by the authority
+		_, err = suite.keeper.SetMetadata(suite.ctx, types.NewMsgSetMetadata(suite.adminAddress.String(), updated))
+		suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+		_, err = suite.keeper.SetMetadata(suite.ctx, types.NewMsgSetMetadata(suite.authority, updated))
+		suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+
+		msg := types.NewMsgSetMetadata(suite.adminAddress.String(), updated)
+		msg.Force = true
+		_, err = suite.keeper.SetMetadata(suite.ctx, msg)
+		suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+
+		msg.Authority = suite.authority
+		_, err = suite.keeper.SetMetadata(suite.ctx, msg)
+		suite.Require().NoError(err)
+		suite.Require().Equal(updated, suite.bankKeeper.metadata["utoken"])
+
+		res, err := suite.queryClient.MetadataHistory(suite.ctx, &types.QueryMetadataHistoryRequest{Denom: "utoken"})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.Records, 2)
+		suite.Require().Nil(res.Records[0].Previous)
+		suite.Require().Equal(suite.adminAddress.String(), res.Records[0].Sender)
+		suite.Require().False(res.Records[0].Forced)
+		suite.Require().Equal(metadata, *res.Records[1].Previous)
+		suite.Require().Equal(suite.authority, res.Records[1].Sender)
+		suite.Require().True(res.Records[1].Forced)
+	})
+	suite.Run("factory denom", func() {
+		suite.SetupTest()
+		suite.enablePermissions(types.PermissionCreateDenom)
+		res, err := suite.keeper.CreateDenom(suite.ctx, types.NewMsgCreateDenom(suite.adminAddress.String(), "token"))
+		suite.Require().NoError(err)
+		created := suite.bankKeeper.metadata[res.Denom]
+
+		factoryMetadata := banktypes.Metadata{
+			Base:       res.Denom,
+			Display:    "token",
+			Name:       "Token",
+			Symbol:     "TOKEN",
+			DenomUnits: []*banktypes.DenomUnit{{Denom: res.Denom}, {Denom: "token", Exponent: 6}},
+		}
+		_, err = suite.keeper.SetMetadata(suite.ctx, types.NewMsgSetMetadata(suite.adminAddress.String(), factoryMetadata))
+		suite.Require().NoError(err)
+		suite.Require().Equal(factoryMetadata, suite.bankKeeper.metadata[res.Denom])
+
+		history := suite.keeper.GetMetadataHistory(suite.ctx, res.Denom)
+		suite.Require().Len(history, 2)
+		suite.Require().Equal(created, *history[1].Previous)
+		suite.Require().False(history[1].Forced)
+
+		// Only the admin of the denom and the authority set its metadata
+		metadataSetter := sdk.AccAddress([]byte{111})
+		suite.aclKeeper.roles[metadataSetter.String()] = acltypes.RoleMetadataSetter
+		_, err = suite.keeper.SetMetadata(suite.ctx, types.NewMsgSetMetadata(metadataSetter.String(), created))
+		suite.Require().ErrorIs(err, keeper.ErrNotAuthorized)
+		_, err = suite.keeper.SetMetadata(suite.ctx, types.NewMsgSetMetadata(suite.authority, created))
+		suite.Require().NoError(err)
+	})
+	suite.Run("factory denom not created", func() {
+		suite.SetupTest()
+		denom, err := types.GetFactoryDenom(suite.adminAddress.String(), "token")
+		suite.Require().NoError(err)
+		factoryMetadata := banktypes.Metadata{
+			Base:       denom,
+			Display:    denom,
+			DenomUnits: []*banktypes.DenomUnit{{Denom: denom}},
+		}
+
+		_, err = suite.keeper.SetMetadata(suite.ctx, types.NewMsgSetMetadata(suite.adminAddress.String(), factoryMetadata))
+		suite.Require().ErrorIs(err, keeper.ErrInvalidRequest)
+		_, err = suite.keeper.SetMetadata(suite.ctx, types.NewMsgSetMetadata(suite.authority, factoryMetadata))
+		suite.Require().ErrorIs(err, keeper.ErrInvalidRequest)
+		suite.Require().Empty(suite.bankKeeper.metadata)
+	})
+}
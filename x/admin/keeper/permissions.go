@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	acltypes "github.com/sagaxyz/saga-sdk/x/acl/types"
+	"github.com/sagaxyz/saga-sdk/x/admin/types"
+)
+
+// permissionRoles are the ACL roles holding a permission besides the ACL
+// admins.
+var permissionRoles = map[string][]string{
+	types.PermissionSetMetadata: {acltypes.RoleMetadataSetter},
+}
+
+// RegisterPermission registers an admin-gated action, which the authority can
+// then enable for the ACL admins with MsgSetPermission. Modules register their
+// actions while the app is built.
+func (k Keeper) RegisterPermission(name string) {
+	if err := types.ValidatePermissionName(name); err != nil {
+		panic(err)
+	}
+	if k.permissions[name] {
+		panic(fmt.Sprintf("permission %s already registered", name))
+	}
+
+	k.permissions[name] = true
+}
+
+// IsRegisteredPermission returns true if the permission was registered.
+func (k Keeper) IsRegisteredPermission(name string) bool {
+	return k.permissions[name]
+}
+
+// RegisteredPermissions returns the names of the registered permissions,
+// sorted.
+func (k Keeper) RegisteredPermissions() []string {
+	names := make([]string, 0, len(k.permissions))
+	for name := range k.permissions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// PermissionEnabled returns true if the permission is enabled for the ACL
+// admins.
+func (k Keeper) PermissionEnabled(ctx sdk.Context, name string) bool {
+	return k.GetParams(ctx).Permissions.Enabled(name)
+}
+
+// setPermission enables or disables a registered permission.
+func (k Keeper) setPermission(ctx sdk.Context, name string, enabled bool) error {
+	if !k.IsRegisteredPermission(name) {
+		return errorsmod.Wrapf(ErrInvalidRequest, "permission %s not registered", name)
+	}
+
+	params := k.GetParams(ctx)
+	params.Permissions.Set(name, enabled)
+	k.SetParams(ctx, params)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetPermission,
+			sdk.NewAttribute(types.AttributeKeyPermission, name),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	)
+
+	return nil
+}
+
+// Authorized returns true if the sender is the authority, or if the
+// permission is enabled and the sender is an admin of the enabled ACL or holds
+// one of the roles.
+func (k Keeper) Authorized(ctx sdk.Context, sender string, permission string, roles ...string) bool {
+	if sender == k.GetAuthority() {
+		return true
+	}
+	if k.aclKeeper == nil || !k.PermissionEnabled(ctx, permission) || !k.aclKeeper.Enabled(ctx) {
+		return false
+	}
+
+	addr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return false
+	}
+	if k.aclKeeper.IsAdmin(ctx, addr) {
+		return true
+	}
+	for _, role := range roles {
+		if k.aclKeeper.HasRole(ctx, addr, role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PermissionHolders returns the ACL admins, followed by the members of the
+// roles holding permissions, with the registered permissions they hold.
+func (k Keeper) PermissionHolders(ctx sdk.Context) (holders []types.PermissionHolder) {
+	if k.aclKeeper == nil {
+		return nil
+	}
+
+	listed := make(map[string]bool)
+	for _, admin := range k.aclKeeper.ExportAdmins(ctx) {
+		holders = append(holders, types.PermissionHolder{
+			Address:     admin,
+			AclAdmin:    true,
+			Permissions: k.heldPermissions(ctx, admin),
+		})
+		listed[admin] = true
+	}
+
+	var roles []string
+	for _, permissionRoles := range permissionRoles {
+		roles = append(roles, permissionRoles...)
+	}
+	sort.Strings(roles)
+	for _, role := range roles {
+		for _, member := range k.aclKeeper.GetRoleMembers(ctx, role) {
+			if listed[member] {
+				continue
+			}
+			holders = append(holders, types.PermissionHolder{
+				Address:     member,
+				Permissions: k.heldPermissions(ctx, member),
+			})
+			listed[member] = true
+		}
+	}
+
+	return holders
+}
+
+// heldPermissions returns the registered permissions the address holds,
+// sorted. The msg server further restricts the permissions acting on factory
+// denoms to the denoms the address administers.
+func (k Keeper) heldPermissions(ctx sdk.Context, address string) (permissions []string) {
+	for _, name := range k.RegisteredPermissions() {
+		if k.Authorized(ctx, address, name, permissionRoles[name]...) {
+			permissions = append(permissions, name)
+		}
+	}
+	return permissions
+}
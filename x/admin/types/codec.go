@@ -9,9 +9,15 @@ import (
 
 const (
 	// Amino names
-	setMetadataName    = "saga/MsgSetMetadata"
-	EnableSetMetadata  = "saga/MsgEnableSetMetadata"
-	DisableSetMetadata = "saga/MsgDisableSetMetadata"
+	setMetadataName      = "saga/MsgSetMetadata"
+	EnableSetMetadata    = "saga/MsgEnableSetMetadata"
+	DisableSetMetadata   = "saga/MsgDisableSetMetadata"
+	setPermissionName    = "saga/admin/MsgSetPermission"
+	createDenomName      = "saga/admin/MsgCreateDenom"
+	mintName             = "saga/admin/MsgMint"
+	burnName             = "saga/admin/MsgBurn"
+	changeDenomAdminName = "saga/admin/MsgChangeDenomAdmin"
+	execAsAuthorityName  = "saga/admin/MsgExecAsAuthority"
 )
 
 // RegisterInterfaces register implementations
@@ -21,6 +27,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgSetMetadata{},
 		&MsgEnableSetMetadata{},
 		&MsgDisableSetMetadata{},
+		&MsgSetPermission{},
+		&MsgCreateDenom{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgChangeDenomAdmin{},
+		&MsgExecAsAuthority{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -33,4 +45,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetMetadata{}, setMetadataName, nil)
 	cdc.RegisterConcrete(&MsgEnableSetMetadata{}, EnableSetMetadata, nil)
 	cdc.RegisterConcrete(&MsgDisableSetMetadata{}, DisableSetMetadata, nil)
+	cdc.RegisterConcrete(&MsgSetPermission{}, setPermissionName, nil)
+	cdc.RegisterConcrete(&MsgCreateDenom{}, createDenomName, nil)
+	cdc.RegisterConcrete(&MsgMint{}, mintName, nil)
+	cdc.RegisterConcrete(&MsgBurn{}, burnName, nil)
+	cdc.RegisterConcrete(&MsgChangeDenomAdmin{}, changeDenomAdminName, nil)
+	cdc.RegisterConcrete(&MsgExecAsAuthority{}, execAsAuthorityName, nil)
 }
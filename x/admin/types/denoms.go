@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DenomPrefix is the first part of the denoms created through the module.
+	DenomPrefix = "factory"
+	// MaxSubdenomLength is the maximum length of a subdenom.
+	MaxSubdenomLength = 44
+)
+
+// GetFactoryDenom returns the denom created by the creator with the subdenom,
+// formatted as factory/{creator}/{subdenom}.
+func GetFactoryDenom(creator, subdenom string) (string, error) {
+	if subdenom == "" {
+		return "", fmt.Errorf("subdenom cannot be empty")
+	}
+	if len(subdenom) > MaxSubdenomLength {
+		return "", fmt.Errorf("subdenom longer than %d characters", MaxSubdenomLength)
+	}
+	if strings.Contains(subdenom, "/") {
+		return "", fmt.Errorf("subdenom cannot contain '/'")
+	}
+
+	denom := strings.Join([]string{DenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", err
+	}
+
+	return denom, nil
+}
+
+// IsFactoryDenom returns true if the denom has the format of the denoms
+// created through the module.
+func IsFactoryDenom(denom string) bool {
+	parts := strings.Split(denom, "/")
+	return len(parts) == 3 && parts[0] == DenomPrefix
+}
+
+// Validate performs a basic validation of a denom created through the module.
+func (d FactoryDenom) Validate() error {
+	if err := sdk.ValidateDenom(d.Denom); err != nil {
+		return err
+	}
+	if !IsFactoryDenom(d.Denom) {
+		return fmt.Errorf("denom %s not created through the module", d.Denom)
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Admin); err != nil {
+		return fmt.Errorf("invalid admin of denom %s: %w", d.Denom, err)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of a supply record.
+func (r SupplyRecord) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return err
+	}
+	if r.Action != SUPPLY_ACTION_MINT && r.Action != SUPPLY_ACTION_BURN {
+		return fmt.Errorf("invalid supply action %s", r.Action)
+	}
+	if r.Amount.IsNil() || !r.Amount.IsPositive() {
+		return fmt.Errorf("supply record amount must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Account); err != nil {
+		return fmt.Errorf("invalid supply record account: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Sender); err != nil {
+		return fmt.Errorf("invalid supply record sender: %w", err)
+	}
+	if r.Height < 0 {
+		return fmt.Errorf("negative supply record height")
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of a metadata record.
+func (r MetadataRecord) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return err
+	}
+	// The previous metadata is not validated, as it may have been set before
+	// metadata was validated.
+	if r.Previous != nil && r.Previous.Base != r.Denom {
+		return fmt.Errorf("previous metadata of denom %s has base %s", r.Denom, r.Previous.Base)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Sender); err != nil {
+		return fmt.Errorf("invalid metadata record sender: %w", err)
+	}
+	if r.Height < 0 {
+		return fmt.Errorf("negative metadata record height")
+	}
+
+	return nil
+}
@@ -1,9 +1,24 @@
 package types
 
 const (
-	EventTypeSetMetadata = "set-metadata"
+	EventTypeSetMetadata   = "set-metadata"
+	EventTypeSetPermission = "set-permission"
+
+	EventTypeCreateDenom      = "create-denom"
+	EventTypeMint             = "mint"
+	EventTypeBurn             = "burn"
+	EventTypeChangeDenomAdmin = "change-denom-admin"
+	EventTypeExecAsAuthority  = "exec-as-authority"
 )
 
 const (
-	AttributeKeyDenom = "denom"
+	AttributeKeyDenom      = "denom"
+	AttributeKeyPermission = "permission"
+	AttributeKeyEnabled    = "enabled"
+	AttributeKeyAdmin      = "admin"
+	AttributeKeyAmount     = "amount"
+	AttributeKeyAccount    = "account"
+	AttributeKeySender     = "sender"
+	AttributeKeyForced     = "forced"
+	AttributeKeyMsgTypeURL = "msg-type-url"
 )
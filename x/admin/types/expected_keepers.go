@@ -7,12 +7,22 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
-// BankKeeper defines the expected interface needed to set denom metadata
+// BankKeeper defines the expected interface needed to set denom metadata and
+// to mint and burn the denoms created through the module
 type BankKeeper interface {
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
 	SetDenomMetaData(ctx context.Context, metadata banktypes.Metadata)
+	HasSupply(ctx context.Context, denom string) bool
+	MintCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
 
 type AclKeeper interface {
 	IsAdmin(ctx sdk.Context, address sdk.AccAddress) bool
+	ExportAdmins(ctx sdk.Context) []string
+	GetRoleMembers(ctx sdk.Context, role string) []string
+	HasRole(ctx sdk.Context, address sdk.AccAddress, role string) bool
 	Enabled(ctx sdk.Context) bool
 }
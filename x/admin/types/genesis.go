@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -28,5 +29,35 @@ func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.R
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	denoms := make(map[string]bool)
+	for _, denom := range gs.Denoms {
+		if denoms[denom.Denom] {
+			return fmt.Errorf("duplicate denom %s", denom.Denom)
+		}
+		if err := denom.Validate(); err != nil {
+			return err
+		}
+		denoms[denom.Denom] = true
+	}
+
+	for _, record := range gs.SupplyRecords {
+		if !denoms[record.Denom] {
+			return fmt.Errorf("supply record of unknown denom %s", record.Denom)
+		}
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range gs.MetadataRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -3,6 +3,9 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -15,6 +18,9 @@ func TestGenesisTestSuite(t *testing.T) {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
+	testAdmin := sdk.AccAddress([]byte{123}).String()
+	testDenom := "factory/" + testAdmin + "/token"
+
 	testCases := []struct {
 		name    string
 		gs      *GenesisState
@@ -43,6 +49,133 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: true,
 		},
+		{
+			name: "valid genesis - permissions",
+			gs: &GenesisState{
+				Params: Params{
+					Permissions: Permissions{
+						Entries: []Permission{
+							{Name: "mint", Enabled: true},
+							{Name: PermissionSetMetadata, Enabled: false},
+						},
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - unsorted permissions",
+			gs: &GenesisState{
+				Params: Params{
+					Permissions: Permissions{
+						Entries: []Permission{
+							{Name: PermissionSetMetadata, Enabled: true},
+							{Name: "mint", Enabled: true},
+						},
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicate permissions",
+			gs: &GenesisState{
+				Params: Params{
+					Permissions: Permissions{
+						Entries: []Permission{
+							{Name: "mint", Enabled: true},
+							{Name: "mint", Enabled: false},
+						},
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid permission name",
+			gs: &GenesisState{
+				Params: Params{
+					Permissions: Permissions{
+						Entries: []Permission{
+							{Name: "set metadata", Enabled: true},
+						},
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - denoms",
+			gs: &GenesisState{
+				Params: DefaultParams(),
+				Denoms: []FactoryDenom{{Denom: testDenom, Admin: testAdmin}},
+				SupplyRecords: []SupplyRecord{
+					{Denom: testDenom, Action: SUPPLY_ACTION_MINT, Amount: math.NewInt(1), Account: testAdmin, Sender: testAdmin},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicate denoms",
+			gs: &GenesisState{
+				Params: DefaultParams(),
+				Denoms: []FactoryDenom{
+					{Denom: testDenom, Admin: testAdmin},
+					{Denom: testDenom, Admin: testAdmin},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - denom not created through the module",
+			gs: &GenesisState{
+				Params: DefaultParams(),
+				Denoms: []FactoryDenom{{Denom: "stake", Admin: testAdmin}},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - supply record of unknown denom",
+			gs: &GenesisState{
+				Params: DefaultParams(),
+				SupplyRecords: []SupplyRecord{
+					{Denom: testDenom, Action: SUPPLY_ACTION_MINT, Amount: math.NewInt(1), Account: testAdmin, Sender: testAdmin},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - metadata records",
+			gs: &GenesisState{
+				Params: DefaultParams(),
+				MetadataRecords: []MetadataRecord{
+					{Denom: "utoken", Sender: testAdmin},
+					{Denom: "utoken", Previous: &banktypes.Metadata{Base: "utoken"}, Sender: testAdmin, Forced: true},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - metadata record of another denom",
+			gs: &GenesisState{
+				Params: DefaultParams(),
+				MetadataRecords: []MetadataRecord{
+					{Denom: "utoken", Previous: &banktypes.Metadata{Base: "stake"}, Sender: testAdmin},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - supply record without action",
+			gs: &GenesisState{
+				Params: DefaultParams(),
+				Denoms: []FactoryDenom{{Denom: testDenom, Admin: testAdmin}},
+				SupplyRecords: []SupplyRecord{
+					{Denom: testDenom, Amount: math.NewInt(1), Account: testAdmin, Sender: testAdmin},
+				},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -55,3 +188,20 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		}
 	}
 }
+
+func (suite *GenesisTestSuite) TestPermissionsSet() {
+	var p Permissions
+	p.Set("b", true)
+	p.Set("a", false)
+	p.Set("c", true)
+	p.Set("a", true)
+
+	suite.Require().Equal([]Permission{
+		{Name: "a", Enabled: true},
+		{Name: "b", Enabled: true},
+		{Name: "c", Enabled: true},
+	}, p.Entries)
+	suite.Require().NoError(p.Validate())
+	suite.Require().True(p.Enabled("a"))
+	suite.Require().False(p.Enabled("d"))
+}
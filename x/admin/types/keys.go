@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "admin"
@@ -10,3 +15,56 @@ const (
 	// RouterKey is the message route for admin module
 	RouterKey = ModuleName
 )
+
+// prefix bytes for the admin module's persistent store
+const (
+	prefixDenoms = iota + 1
+	prefixSupplyRecords
+	prefixSupplyRecordSequence
+	prefixMetadataRecords
+	prefixMetadataRecordSequence
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixDenoms           = []byte{prefixDenoms}
+	KeyPrefixSupplyRecords    = []byte{prefixSupplyRecords}
+	KeySupplyRecordSequence   = []byte{prefixSupplyRecordSequence}
+	KeyPrefixMetadataRecords  = []byte{prefixMetadataRecords}
+	KeyMetadataRecordSequence = []byte{prefixMetadataRecordSequence}
+)
+
+// DenomKey returns the store key of a denom created through the module.
+func DenomKey(denom string) []byte {
+	return append(KeyPrefixDenoms, []byte(denom)...)
+}
+
+// SupplyRecordKeyPrefix returns the store key prefix of the supply records of
+// a denom.
+func SupplyRecordKeyPrefix(denom string) []byte {
+	return append(KeyPrefixSupplyRecords, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// SupplyRecordKey returns the store key of a supply record of a denom, ordered
+// by height and then by sequence.
+func SupplyRecordKey(denom string, height int64, sequence uint64) []byte {
+	key := SupplyRecordKeyPrefix(denom)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(height))...)
+
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// MetadataRecordKeyPrefix returns the store key prefix of the metadata records
+// of a denom.
+func MetadataRecordKeyPrefix(denom string) []byte {
+	return append(KeyPrefixMetadataRecords, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// MetadataRecordKey returns the store key of a metadata record of a denom,
+// ordered by height and then by sequence.
+func MetadataRecordKey(denom string, height int64, sequence uint64) []byte {
+	key := MetadataRecordKeyPrefix(denom)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(height))...)
+
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -2,19 +2,37 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 var (
 	_ sdk.Msg = &MsgSetMetadata{}
+	_ sdk.Msg = &MsgEnableSetMetadata{}
+	_ sdk.Msg = &MsgDisableSetMetadata{}
+	_ sdk.Msg = &MsgSetPermission{}
+	_ sdk.Msg = &MsgCreateDenom{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgChangeDenomAdmin{}
+	_ sdk.Msg = &MsgExecAsAuthority{}
+
+	_ codectypes.UnpackInterfacesMessage = &MsgExecAsAuthority{}
 )
 
 const (
 	TypeMsgSetMetadata        = "set_metadata"
 	TypeMsgEnableSetMetadata  = "enable_set_metadata"
 	TypeMsgDisableSetMetadata = "disable_set_metadata"
+	TypeMsgSetPermission      = "set_permission"
+	TypeMsgCreateDenom        = "create_denom"
+	TypeMsgMint               = "mint"
+	TypeMsgBurn               = "burn"
+	TypeMsgChangeDenomAdmin   = "change_denom_admin"
+	TypeMsgExecAsAuthority    = "exec_as_authority"
 )
 
 // NewMsgSetMetadata creates a new instance of MsgSetMetadata
@@ -89,3 +107,205 @@ func (msg MsgDisableSetMetadata) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgSetPermission creates a new instance of MsgSetPermission
+func NewMsgSetPermission(authority string, name string, enabled bool) *MsgSetPermission {
+	return &MsgSetPermission{
+		Authority: authority,
+		Name:      name,
+		Enabled:   enabled,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetPermission) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetPermission) Type() string { return TypeMsgSetPermission }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetPermission) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if err := ValidatePermissionName(msg.Name); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// NewMsgCreateDenom creates a new instance of MsgCreateDenom
+func NewMsgCreateDenom(sender string, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{
+		Authority: sender,
+		Subdenom:  subdenom,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCreateDenom) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCreateDenom) Type() string { return TypeMsgCreateDenom }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCreateDenom) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if _, err := GetFactoryDenom(msg.Authority, msg.Subdenom); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// NewMsgMint creates a new instance of MsgMint
+func NewMsgMint(sender string, amount sdk.Coin, recipient string) *MsgMint { // nolint: interfacer
+	return &MsgMint{
+		Authority: sender,
+		Amount:    amount,
+		Recipient: recipient,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgMint) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgMint) Type() string { return TypeMsgMint }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgMint) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid recipient address")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	return nil
+}
+
+// NewMsgBurn creates a new instance of MsgBurn
+func NewMsgBurn(sender string, amount sdk.Coin, account string) *MsgBurn { // nolint: interfacer
+	return &MsgBurn{
+		Authority: sender,
+		Amount:    amount,
+		Account:   account,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgBurn) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgBurn) Type() string { return TypeMsgBurn }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgBurn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Account)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid account address")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	return nil
+}
+
+// NewMsgChangeDenomAdmin creates a new instance of MsgChangeDenomAdmin
+func NewMsgChangeDenomAdmin(sender string, denom string, newAdmin string) *MsgChangeDenomAdmin {
+	return &MsgChangeDenomAdmin{
+		Authority: sender,
+		Denom:     denom,
+		NewAdmin:  newAdmin,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgChangeDenomAdmin) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgChangeDenomAdmin) Type() string { return TypeMsgChangeDenomAdmin }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgChangeDenomAdmin) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	_, err = sdk.AccAddressFromBech32(msg.NewAdmin)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid new admin address")
+	}
+	if !IsFactoryDenom(msg.Denom) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "denom %s not created through the module", msg.Denom)
+	}
+	return nil
+}
+
+// NewMsgExecAsAuthority creates a new instance of MsgExecAsAuthority
+func NewMsgExecAsAuthority(sender string, msgs []sdk.Msg) (*MsgExecAsAuthority, error) {
+	anys, err := tx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgExecAsAuthority{
+		Authority: sender,
+		Msgs:      anys,
+	}, nil
+}
+
+// GetMessages returns the cached messages to execute.
+func (msg MsgExecAsAuthority) GetMessages() ([]sdk.Msg, error) {
+	return tx.GetMsgs(msg.Msgs, "MsgExecAsAuthority")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgExecAsAuthority) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return tx.UnpackInterfaces(unpacker, msg.Msgs)
+}
+
+// Route should return the name of the module
+func (msg MsgExecAsAuthority) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgExecAsAuthority) Type() string { return TypeMsgExecAsAuthority }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgExecAsAuthority) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if len(msg.Msgs) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "messages cannot be empty")
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	for _, m := range msgs {
+		if _, ok := m.(*MsgExecAsAuthority); ok {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "nested MsgExecAsAuthority")
+		}
+		if m, ok := m.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -36,20 +36,22 @@ func NewParams(permissions Permissions) Params {
 func DefaultParams() Params {
 	return Params{
 		Permissions: Permissions{
-			SetMetadata: true,
+			Entries: []Permission{
+				{Name: PermissionSetMetadata, Enabled: true},
+			},
 		},
 	}
 }
 
 func validatePermissions(i interface{}) error {
-	_, ok := i.(Permissions)
+	p, ok := i.(Permissions)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	return nil
+	return p.Validate()
 }
 
 func (p Params) Validate() error {
-	return nil
+	return p.Permissions.Validate()
 }
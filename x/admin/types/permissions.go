@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// Permissions of the ACL admins registered by the module
+const (
+	// PermissionSetMetadata allows to set denom metadata.
+	PermissionSetMetadata = "set-metadata"
+	// PermissionCreateDenom allows to create denoms.
+	PermissionCreateDenom = "create-denom"
+	// PermissionMint allows the admin of a denom to mint it.
+	PermissionMint = "mint"
+	// PermissionBurn allows the admin of a denom to burn it from accounts.
+	PermissionBurn = "burn"
+	// PermissionChangeDenomAdmin allows the admin of a denom to change it.
+	PermissionChangeDenomAdmin = "change-denom-admin"
+)
+
+// ExecPermissionPrefix prefixes the type URL of a message in the name of the
+// permission to execute it as the authority.
+const ExecPermissionPrefix = "exec:"
+
+// ExecPermission returns the name of the permission to execute the messages
+// of a type URL as the authority, e.g.
+// "exec:/cosmos.consensus.v1.MsgUpdateParams".
+func ExecPermission(typeURL string) string {
+	return ExecPermissionPrefix + typeURL
+}
+
+// MaxPermissionNameLength is the maximum length of a permission name.
+const MaxPermissionNameLength = 128
+
+var permissionNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9/._:-]*$`)
+
+// ValidatePermissionName checks that a permission name is not empty, not too
+// long and only made of letters, digits and the characters '/', '.', '_', ':'
+// and '-'.
+func ValidatePermissionName(name string) error {
+	if len(name) > MaxPermissionNameLength {
+		return fmt.Errorf("permission name longer than %d characters: %s", MaxPermissionNameLength, name)
+	}
+	if !permissionNameRegex.MatchString(name) {
+		return fmt.Errorf("invalid permission name '%s'", name)
+	}
+	return nil
+}
+
+// Enabled returns true if the permission is enabled.
+func (p Permissions) Enabled(name string) bool {
+	i, found := p.find(name)
+	return found && p.Entries[i].Enabled
+}
+
+// Set enables or disables a permission, keeping the entries sorted.
+func (p *Permissions) Set(name string, enabled bool) {
+	i, found := p.find(name)
+	if found {
+		p.Entries[i].Enabled = enabled
+		return
+	}
+
+	p.Entries = append(p.Entries, Permission{})
+	copy(p.Entries[i+1:], p.Entries[i:])
+	p.Entries[i] = Permission{Name: name, Enabled: enabled}
+}
+
+// MigrateLegacy moves the deprecated set_metadata flag to the set-metadata
+// permission.
+func (p *Permissions) MigrateLegacy() {
+	//nolint:staticcheck // the deprecated field is read only to be migrated
+	if p.SetMetadata && !p.Enabled(PermissionSetMetadata) {
+		p.Set(PermissionSetMetadata, true)
+	}
+	p.SetMetadata = false //nolint:staticcheck
+}
+
+// Validate checks that the entries have valid names and are sorted without
+// duplicates.
+func (p Permissions) Validate() error {
+	for i, entry := range p.Entries {
+		if err := ValidatePermissionName(entry.Name); err != nil {
+			return err
+		}
+		if i > 0 && p.Entries[i-1].Name >= entry.Name {
+			return fmt.Errorf("permissions not sorted by name or duplicated: %s", entry.Name)
+		}
+	}
+	return nil
+}
+
+// find returns the index of a permission, or the index it would be inserted
+// at if not found.
+func (p Permissions) find(name string) (int, bool) {
+	i := sort.Search(len(p.Entries), func(i int) bool {
+		return p.Entries[i].Name >= name
+	})
+	return i, i < len(p.Entries) && p.Entries[i].Name == name
+}
@@ -7,8 +7,11 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types2 "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	types "github.com/cosmos/cosmos-sdk/x/bank/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -34,6 +37,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MsgSetMetadata struct {
 	Authority string          `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	Metadata  *types.Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// force allows the authority to replace the metadata of a denom not created
+	// through the module.
+	Force bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
 }
 
 func (m *MsgSetMetadata) Reset()         { *m = MsgSetMetadata{} }
@@ -83,6 +89,13 @@ func (m *MsgSetMetadata) GetMetadata() *types.Metadata {
 	return nil
 }
 
+func (m *MsgSetMetadata) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
 type MsgSetMetadataResponse struct {
 }
 
@@ -279,474 +292,2983 @@ func (m *MsgDisableSetMetadataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDisableSetMetadataResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSetMetadata)(nil), "saga.admin.v1.MsgSetMetadata")
-	proto.RegisterType((*MsgSetMetadataResponse)(nil), "saga.admin.v1.MsgSetMetadataResponse")
-	proto.RegisterType((*MsgEnableSetMetadata)(nil), "saga.admin.v1.MsgEnableSetMetadata")
-	proto.RegisterType((*MsgEnableSetMetadataResponse)(nil), "saga.admin.v1.MsgEnableSetMetadataResponse")
-	proto.RegisterType((*MsgDisableSetMetadata)(nil), "saga.admin.v1.MsgDisableSetMetadata")
-	proto.RegisterType((*MsgDisableSetMetadataResponse)(nil), "saga.admin.v1.MsgDisableSetMetadataResponse")
+type MsgSetPermission struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name is the name of the registered permission.
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func init() { proto.RegisterFile("saga/admin/v1/tx.proto", fileDescriptor_1be15843df3dbf51) }
-
-var fileDescriptor_1be15843df3dbf51 = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x3f, 0x6f, 0x13, 0x31,
-	0x18, 0xc6, 0xe3, 0x56, 0x20, 0xea, 0x8a, 0x4a, 0x58, 0xa5, 0x5c, 0xaf, 0x8d, 0x09, 0xe1, 0x8f,
-	0x4a, 0xa1, 0x67, 0xa5, 0x48, 0x48, 0xb0, 0x51, 0x60, 0xbc, 0x25, 0xdd, 0x18, 0x88, 0x7c, 0x3d,
-	0xcb, 0xb5, 0xda, 0xb3, 0xa3, 0xbc, 0x6e, 0x94, 0xc0, 0x82, 0xba, 0x30, 0xb0, 0x20, 0xf5, 0x13,
-	0xf0, 0x0d, 0x3a, 0xf0, 0x21, 0x18, 0x2b, 0x58, 0x18, 0x51, 0x82, 0xd4, 0xaf, 0x81, 0xea, 0x38,
-	0xd7, 0x86, 0x3b, 0xa1, 0x0c, 0x74, 0x3a, 0xbd, 0x7e, 0x9f, 0xf7, 0x79, 0x7e, 0xb6, 0xde, 0xc3,
-	0x4b, 0xc0, 0x25, 0x67, 0x3c, 0xcd, 0x94, 0x66, 0xdd, 0x06, 0xb3, 0xbd, 0xa8, 0xdd, 0x31, 0xd6,
-	0x90, 0xeb, 0x67, 0xe7, 0x91, 0x3b, 0x8f, 0xba, 0x8d, 0x70, 0x55, 0x1a, 0x23, 0xf7, 0x05, 0xe3,
-	0x6d, 0xc5, 0xb8, 0xd6, 0xc6, 0x72, 0xab, 0x8c, 0x86, 0x91, 0x38, 0x5c, 0xde, 0x31, 0x90, 0x19,
-	0x68, 0xb9, 0x8a, 0x8d, 0x0a, 0xdf, 0xba, 0x35, 0xaa, 0x58, 0x06, 0xf2, 0xcc, 0x3f, 0x03, 0xe9,
-	0x1b, 0xd4, 0x37, 0x12, 0xae, 0xf7, 0x58, 0xb7, 0x91, 0x08, 0xcb, 0x1b, 0xae, 0xf0, 0xfd, 0x95,
-	0x49, 0x30, 0x29, 0xb4, 0x00, 0xe5, 0x5d, 0xeb, 0x47, 0x08, 0x2f, 0xc4, 0x20, 0xb7, 0x85, 0x8d,
-	0x85, 0xe5, 0x29, 0xb7, 0x9c, 0x3c, 0xc5, 0x73, 0xfc, 0xc0, 0xee, 0x9a, 0x8e, 0xb2, 0xfd, 0x00,
-	0xd5, 0xd0, 0xda, 0xdc, 0x56, 0xf0, 0xfd, 0xeb, 0xc6, 0xa2, 0xa7, 0x79, 0x91, 0xa6, 0x1d, 0x01,
-	0xb0, 0x6d, 0x3b, 0x4a, 0xcb, 0xe6, 0xb9, 0x94, 0x3c, 0xc3, 0xd7, 0x32, 0xef, 0x11, 0xcc, 0xd4,
-	0xd0, 0xda, 0xfc, 0x66, 0x35, 0xf2, 0x33, 0x8e, 0xc6, 0xa3, 0x45, 0xe3, 0xa0, 0x66, 0x2e, 0x7f,
-	0xbe, 0x70, 0x78, 0x7a, 0xbc, 0x7e, 0x6e, 0x55, 0x0f, 0xf0, 0xd2, 0x24, 0x54, 0x53, 0x40, 0xdb,
-	0x68, 0x10, 0xf5, 0xb7, 0x78, 0x31, 0x06, 0xf9, 0x5a, 0xf3, 0x64, 0x5f, 0xfc, 0x07, 0xe8, 0x42,
-	0x32, 0xc5, 0xab, 0x65, 0xfe, 0x79, 0x7e, 0x0b, 0xdf, 0x8c, 0x41, 0xbe, 0x52, 0x70, 0x59, 0x00,
-	0xb7, 0x71, 0xb5, 0x34, 0x60, 0x4c, 0xb0, 0xf9, 0x65, 0x16, 0xcf, 0xc6, 0x20, 0xc9, 0x47, 0x84,
-	0x6f, 0x14, 0xdf, 0xe1, 0x6e, 0x34, 0xb1, 0x6e, 0x51, 0xd9, 0x65, 0xc2, 0x47, 0x53, 0x88, 0xf2,
-	0x1b, 0xd7, 0x0e, 0x7f, 0xfc, 0x3e, 0x9a, 0x09, 0x49, 0xc0, 0xfe, 0x5e, 0x70, 0x26, 0xdc, 0x10,
-	0xf9, 0x84, 0x30, 0x29, 0x79, 0x91, 0x7b, 0xc5, 0x94, 0xa2, 0x2a, 0x7c, 0x3c, 0x8d, 0x2a, 0x87,
-	0xb9, 0xe3, 0x60, 0x56, 0xc8, 0x72, 0x11, 0x26, 0x1d, 0x4d, 0x91, 0xf7, 0x78, 0xfe, 0x22, 0x45,
-	0xb5, 0xe8, 0x7f, 0x31, 0xfe, 0xfe, 0x3f, 0xdb, 0x79, 0xee, 0x03, 0x97, 0x5b, 0xab, 0xd3, 0x62,
-	0x2e, 0x08, 0xdb, 0x1a, 0x2f, 0x72, 0x78, 0xe5, 0xc3, 0xe9, 0xf1, 0x3a, 0xda, 0x7a, 0xf9, 0x6d,
-	0x40, 0xd1, 0xc9, 0x80, 0xa2, 0x5f, 0x03, 0x8a, 0x3e, 0x0f, 0x69, 0xe5, 0x64, 0x48, 0x2b, 0x3f,
-	0x87, 0xb4, 0xf2, 0xe6, 0xa1, 0x54, 0x76, 0xf7, 0x20, 0x89, 0x76, 0x4c, 0xe6, 0xac, 0x7a, 0xfd,
-	0x77, 0xee, 0xbb, 0x01, 0xe9, 0x1e, 0xeb, 0x79, 0x63, 0xdb, 0x6f, 0x0b, 0x48, 0xae, 0xba, 0x3f,
-	0xf4, 0xc9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x54, 0xee, 0x49, 0x46, 0x59, 0x04, 0x00, 0x00,
+func (m *MsgSetPermission) Reset()         { *m = MsgSetPermission{} }
+func (m *MsgSetPermission) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPermission) ProtoMessage()    {}
+func (*MsgSetPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1be15843df3dbf51, []int{6}
+}
+func (m *MsgSetPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPermission.Merge(m, src)
+}
+func (m *MsgSetPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPermission.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgSetPermission proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgSetPermission) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// EnableSetMetadata enables the acl-based admin permissions to set metadata.
-	EnableSetMetadata(ctx context.Context, in *MsgEnableSetMetadata, opts ...grpc.CallOption) (*MsgEnableSetMetadataResponse, error)
-	// DisableSetMetadata disables the acl-based admin permissions to set
-	// metadata.
-	DisableSetMetadata(ctx context.Context, in *MsgDisableSetMetadata, opts ...grpc.CallOption) (*MsgDisableSetMetadataResponse, error)
-	// SetMetadata is a permissioned message that allows the admin or superuser to
-	// set metadata for a given denom. This is only available if the admin module
-	// is enabled.
-	SetMetadata(ctx context.Context, in *MsgSetMetadata, opts ...grpc.CallOption) (*MsgSetMetadataResponse, error)
+func (m *MsgSetPermission) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetPermission) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+type MsgSetPermissionResponse struct {
 }
 
-func (c *msgClient) EnableSetMetadata(ctx context.Context, in *MsgEnableSetMetadata, opts ...grpc.CallOption) (*MsgEnableSetMetadataResponse, error) {
-	out := new(MsgEnableSetMetadataResponse)
-	err := c.cc.Invoke(ctx, "/saga.admin.v1.Msg/EnableSetMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetPermissionResponse) Reset()         { *m = MsgSetPermissionResponse{} }
+func (m *MsgSetPermissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPermissionResponse) ProtoMessage()    {}
+func (*MsgSetPermissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1be15843df3dbf51, []int{7}
+}
+func (m *MsgSetPermissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPermissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPermissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetPermissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPermissionResponse.Merge(m, src)
+}
+func (m *MsgSetPermissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPermissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPermissionResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) DisableSetMetadata(ctx context.Context, in *MsgDisableSetMetadata, opts ...grpc.CallOption) (*MsgDisableSetMetadataResponse, error) {
-	out := new(MsgDisableSetMetadataResponse)
-	err := c.cc.Invoke(ctx, "/saga.admin.v1.Msg/DisableSetMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetPermissionResponse proto.InternalMessageInfo
+
+type MsgCreateDenom struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Subdenom  string `protobuf:"bytes,2,opt,name=subdenom,proto3" json:"subdenom,omitempty"`
+}
+
+func (m *MsgCreateDenom) Reset()         { *m = MsgCreateDenom{} }
+func (m *MsgCreateDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDenom) ProtoMessage()    {}
+func (*MsgCreateDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1be15843df3dbf51, []int{8}
+}
+func (m *MsgCreateDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCreateDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDenom.Merge(m, src)
+}
+func (m *MsgCreateDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDenom.DiscardUnknown(m)
 }
 
-func (c *msgClient) SetMetadata(ctx context.Context, in *MsgSetMetadata, opts ...grpc.CallOption) (*MsgSetMetadataResponse, error) {
-	out := new(MsgSetMetadataResponse)
-	err := c.cc.Invoke(ctx, "/saga.admin.v1.Msg/SetMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateDenom proto.InternalMessageInfo
+
+func (m *MsgCreateDenom) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// EnableSetMetadata enables the acl-based admin permissions to set metadata.
-	EnableSetMetadata(context.Context, *MsgEnableSetMetadata) (*MsgEnableSetMetadataResponse, error)
-	// DisableSetMetadata disables the acl-based admin permissions to set
-	// metadata.
-	DisableSetMetadata(context.Context, *MsgDisableSetMetadata) (*MsgDisableSetMetadataResponse, error)
-	// SetMetadata is a permissioned message that allows the admin or superuser to
-	// set metadata for a given denom. This is only available if the admin module
-	// is enabled.
-	SetMetadata(context.Context, *MsgSetMetadata) (*MsgSetMetadataResponse, error)
+func (m *MsgCreateDenom) GetSubdenom() string {
+	if m != nil {
+		return m.Subdenom
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgCreateDenomResponse struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (*UnimplementedMsgServer) EnableSetMetadata(ctx context.Context, req *MsgEnableSetMetadata) (*MsgEnableSetMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableSetMetadata not implemented")
+func (m *MsgCreateDenomResponse) Reset()         { *m = MsgCreateDenomResponse{} }
+func (m *MsgCreateDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDenomResponse) ProtoMessage()    {}
+func (*MsgCreateDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1be15843df3dbf51, []int{9}
 }
-func (*UnimplementedMsgServer) DisableSetMetadata(ctx context.Context, req *MsgDisableSetMetadata) (*MsgDisableSetMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableSetMetadata not implemented")
+func (m *MsgCreateDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SetMetadata(ctx context.Context, req *MsgSetMetadata) (*MsgSetMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetMetadata not implemented")
+func (m *MsgCreateDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgCreateDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDenomResponse.Merge(m, src)
+}
+func (m *MsgCreateDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDenomResponse.DiscardUnknown(m)
 }
 
-func _Msg_EnableSetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEnableSetMetadata)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).EnableSetMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/saga.admin.v1.Msg/EnableSetMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EnableSetMetadata(ctx, req.(*MsgEnableSetMetadata))
+var xxx_messageInfo_MsgCreateDenomResponse proto.InternalMessageInfo
+
+func (m *MsgCreateDenomResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DisableSetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDisableSetMetadata)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DisableSetMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/saga.admin.v1.Msg/DisableSetMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DisableSetMetadata(ctx, req.(*MsgDisableSetMetadata))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgMint struct {
+	Authority string      `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Amount    types1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// recipient is the account receiving the minted coins.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func _Msg_SetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetMetadata)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/saga.admin.v1.Msg/SetMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetMetadata(ctx, req.(*MsgSetMetadata))
+func (m *MsgMint) Reset()         { *m = MsgMint{} }
+func (m *MsgMint) String() string { return proto.CompactTextString(m) }
+func (*MsgMint) ProtoMessage()    {}
+func (*MsgMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1be15843df3dbf51, []int{10}
+}
+func (m *MsgMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "saga.admin.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "EnableSetMetadata",
-			Handler:    _Msg_EnableSetMetadata_Handler,
-		},
-		{
-			MethodName: "DisableSetMetadata",
-			Handler:    _Msg_DisableSetMetadata_Handler,
-		},
-		{
-			MethodName: "SetMetadata",
-			Handler:    _Msg_SetMetadata_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "saga/admin/v1/tx.proto",
+func (m *MsgMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMint.Merge(m, src)
+}
+func (m *MsgMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMint.DiscardUnknown(m)
 }
 
-func (m *MsgSetMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgMint proto.InternalMessageInfo
+
+func (m *MsgMint) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSetMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgMint) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
 }
 
-func (m *MsgSetMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgMint) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type MsgMintResponse struct {
 }
 
-func (m *MsgSetMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgMintResponse) Reset()         { *m = MsgMintResponse{} }
+func (m *MsgMintResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMintResponse) ProtoMessage()    {}
+func (*MsgMintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1be15843df3dbf51, []int{11}
+}
+func (m *MsgMintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintResponse.Merge(m, src)
+}
+func (m *MsgMintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgMintResponse proto.InternalMessageInfo
+
+type MsgBurn struct {
+	Authority string      `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Amount    types1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// account is the account the coins are burned from.
+	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (m *MsgEnableSetMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBurn) Reset()         { *m = MsgBurn{} }
+func (m *MsgBurn) String() string { return proto.CompactTextString(m) }
+func (*MsgBurn) ProtoMessage()    {}
+func (*MsgBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1be15843df3dbf51, []int{12}
+}
+func (m *MsgBurn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgEnableSetMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurn.Merge(m, src)
+}
+func (m *MsgBurn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurn.DiscardUnknown(m)
 }
 
-func (m *MsgEnableSetMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgBurn proto.InternalMessageInfo
+
+func (m *MsgBurn) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgEnableSetMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBurn) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return types1.Coin{}
 }
 
-func (m *MsgEnableSetMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBurn) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
 }
 
-func (m *MsgEnableSetMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgBurnResponse struct {
 }
 
-func (m *MsgDisableSetMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBurnResponse) Reset()         { *m = MsgBurnResponse{} }
+func (m *MsgBurnResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnResponse) ProtoMessage()    {}
+func (*MsgBurnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1be15843df3dbf51, []int{13}
+}
+func (m *MsgBurnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgBurnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnResponse.Merge(m, src)
+}
+func (m *MsgBurnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDisableSetMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgBurnResponse proto.InternalMessageInfo
+
+type MsgChangeDenomAdmin struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	NewAdmin  string `protobuf:"bytes,3,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty"`
 }
 
-func (m *MsgDisableSetMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgChangeDenomAdmin) Reset()         { *m = MsgChangeDenomAdmin{} }
+func (m *MsgChangeDenomAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeDenomAdmin) ProtoMessage()    {}
+func (*MsgChangeDenomAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1be15843df3dbf51, []int{14}
+}
+func (m *MsgChangeDenomAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeDenomAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeDenomAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgChangeDenomAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeDenomAdmin.Merge(m, src)
+}
+func (m *MsgChangeDenomAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeDenomAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeDenomAdmin.DiscardUnknown(m)
 }
 
-func (m *MsgDisableSetMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgChangeDenomAdmin proto.InternalMessageInfo
+
+func (m *MsgChangeDenomAdmin) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDisableSetMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgChangeDenomAdmin) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *MsgDisableSetMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgChangeDenomAdmin) GetNewAdmin() string {
+	if m != nil {
+		return m.NewAdmin
+	}
+	return ""
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+type MsgChangeDenomAdminResponse struct {
 }
-func (m *MsgSetMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovTx(uint64(l))
+
+func (m *MsgChangeDenomAdminResponse) Reset()         { *m = MsgChangeDenomAdminResponse{} }
+func (m *MsgChangeDenomAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangeDenomAdminResponse) ProtoMessage()    {}
+func (*MsgChangeDenomAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1be15843df3dbf51, []int{15}
+}
+func (m *MsgChangeDenomAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangeDenomAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangeDenomAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgChangeDenomAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangeDenomAdminResponse.Merge(m, src)
+}
+func (m *MsgChangeDenomAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangeDenomAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangeDenomAdminResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgChangeDenomAdminResponse proto.InternalMessageInfo
+
+type MsgExecAsAuthority struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// msgs are the messages to execute, signed by the module authority.
+	Msgs []*types2.Any `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
 }
 
-func (m *MsgEnableSetMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
+func (m *MsgExecAsAuthority) Reset()         { *m = MsgExecAsAuthority{} }
+func (m *MsgExecAsAuthority) String() string { return proto.CompactTextString(m) }
+func (*MsgExecAsAuthority) ProtoMessage()    {}
+func (*MsgExecAsAuthority) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1be15843df3dbf51, []int{16}
+}
+func (m *MsgExecAsAuthority) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecAsAuthority) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecAsAuthority.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecAsAuthority) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecAsAuthority.Merge(m, src)
+}
+func (m *MsgExecAsAuthority) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecAsAuthority) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecAsAuthority.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecAsAuthority proto.InternalMessageInfo
+
+func (m *MsgExecAsAuthority) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgExecAsAuthority) GetMsgs() []*types2.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+type MsgExecAsAuthorityResponse struct {
+	// results are the results of the executed messages.
+	Results [][]byte `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *MsgExecAsAuthorityResponse) Reset()         { *m = MsgExecAsAuthorityResponse{} }
+func (m *MsgExecAsAuthorityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecAsAuthorityResponse) ProtoMessage()    {}
+func (*MsgExecAsAuthorityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1be15843df3dbf51, []int{17}
+}
+func (m *MsgExecAsAuthorityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecAsAuthorityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecAsAuthorityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecAsAuthorityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecAsAuthorityResponse.Merge(m, src)
+}
+func (m *MsgExecAsAuthorityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecAsAuthorityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecAsAuthorityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecAsAuthorityResponse proto.InternalMessageInfo
+
+func (m *MsgExecAsAuthorityResponse) GetResults() [][]byte {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgSetMetadata)(nil), "saga.admin.v1.MsgSetMetadata")
+	proto.RegisterType((*MsgSetMetadataResponse)(nil), "saga.admin.v1.MsgSetMetadataResponse")
+	proto.RegisterType((*MsgEnableSetMetadata)(nil), "saga.admin.v1.MsgEnableSetMetadata")
+	proto.RegisterType((*MsgEnableSetMetadataResponse)(nil), "saga.admin.v1.MsgEnableSetMetadataResponse")
+	proto.RegisterType((*MsgDisableSetMetadata)(nil), "saga.admin.v1.MsgDisableSetMetadata")
+	proto.RegisterType((*MsgDisableSetMetadataResponse)(nil), "saga.admin.v1.MsgDisableSetMetadataResponse")
+	proto.RegisterType((*MsgSetPermission)(nil), "saga.admin.v1.MsgSetPermission")
+	proto.RegisterType((*MsgSetPermissionResponse)(nil), "saga.admin.v1.MsgSetPermissionResponse")
+	proto.RegisterType((*MsgCreateDenom)(nil), "saga.admin.v1.MsgCreateDenom")
+	proto.RegisterType((*MsgCreateDenomResponse)(nil), "saga.admin.v1.MsgCreateDenomResponse")
+	proto.RegisterType((*MsgMint)(nil), "saga.admin.v1.MsgMint")
+	proto.RegisterType((*MsgMintResponse)(nil), "saga.admin.v1.MsgMintResponse")
+	proto.RegisterType((*MsgBurn)(nil), "saga.admin.v1.MsgBurn")
+	proto.RegisterType((*MsgBurnResponse)(nil), "saga.admin.v1.MsgBurnResponse")
+	proto.RegisterType((*MsgChangeDenomAdmin)(nil), "saga.admin.v1.MsgChangeDenomAdmin")
+	proto.RegisterType((*MsgChangeDenomAdminResponse)(nil), "saga.admin.v1.MsgChangeDenomAdminResponse")
+	proto.RegisterType((*MsgExecAsAuthority)(nil), "saga.admin.v1.MsgExecAsAuthority")
+	proto.RegisterType((*MsgExecAsAuthorityResponse)(nil), "saga.admin.v1.MsgExecAsAuthorityResponse")
+}
+
+func init() { proto.RegisterFile("saga/admin/v1/tx.proto", fileDescriptor_1be15843df3dbf51) }
+
+var fileDescriptor_1be15843df3dbf51 = []byte{
+	// 997 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x24, 0x6e, 0x13, 0xbf, 0xd0, 0x5f, 0x8b, 0x49, 0x37, 0x9b, 0x66, 0xe3, 0x6e, 0x7f,
+	0xe0, 0xa6, 0xcd, 0xae, 0x12, 0x44, 0x11, 0xdc, 0xec, 0xb4, 0x47, 0x4b, 0x68, 0x7b, 0xe3, 0x10,
+	0x6b, 0xbc, 0x9e, 0x6e, 0x56, 0xcd, 0xce, 0x58, 0x3b, 0xe3, 0xd4, 0xa6, 0x17, 0x54, 0x24, 0x40,
+	0x82, 0x43, 0x25, 0xfe, 0x04, 0x4e, 0x70, 0xea, 0xa1, 0x67, 0x0e, 0x9c, 0x2a, 0x4e, 0x15, 0x5c,
+	0x38, 0x21, 0x94, 0x20, 0xf5, 0xdf, 0x40, 0x3b, 0xbb, 0x1e, 0xdb, 0xd9, 0x21, 0xae, 0x2c, 0xe8,
+	0xc9, 0x7e, 0xfb, 0xde, 0xbc, 0xef, 0xfb, 0xde, 0xcc, 0x7c, 0x03, 0x2b, 0x1c, 0x87, 0xd8, 0xc3,
+	0x9d, 0x38, 0xa2, 0xde, 0xe1, 0xb6, 0x27, 0xfa, 0x6e, 0x37, 0x61, 0x82, 0x19, 0xe7, 0xd2, 0xef,
+	0xae, 0xfc, 0xee, 0x1e, 0x6e, 0x5b, 0x57, 0x42, 0xc6, 0xc2, 0x03, 0xe2, 0xe1, 0x6e, 0xe4, 0x61,
+	0x4a, 0x99, 0xc0, 0x22, 0x62, 0x94, 0x67, 0xc5, 0xd6, 0x6a, 0xc0, 0x78, 0xcc, 0x78, 0x4b, 0x46,
+	0x5e, 0x16, 0xe4, 0xa9, 0xcb, 0x59, 0xe4, 0xc5, 0x3c, 0x4c, 0xfb, 0xc7, 0x3c, 0xcc, 0x13, 0x76,
+	0x9e, 0x68, 0x63, 0xfa, 0xc8, 0x3b, 0xdc, 0x6e, 0x13, 0x81, 0xb7, 0x65, 0x50, 0xc8, 0x73, 0xa2,
+	0xf2, 0x01, 0x8b, 0x68, 0x9e, 0xaf, 0x84, 0x2c, 0x64, 0x19, 0x60, 0xfa, 0x6f, 0xc8, 0x24, 0xe7,
+	0x29, 0xa3, 0x76, 0xef, 0xa1, 0x87, 0xe9, 0x20, 0x4f, 0xad, 0x4d, 0x2a, 0x0d, 0x09, 0x25, 0x3c,
+	0xca, 0x69, 0x3a, 0x3f, 0x22, 0x38, 0xdf, 0xe4, 0xe1, 0x03, 0x22, 0x9a, 0x44, 0xe0, 0x0e, 0x16,
+	0xd8, 0xb8, 0x0b, 0x65, 0xdc, 0x13, 0xfb, 0x2c, 0x89, 0xc4, 0xc0, 0x44, 0x55, 0x54, 0x2b, 0x37,
+	0xcc, 0xdf, 0x5e, 0x6c, 0x55, 0x72, 0x79, 0xf5, 0x4e, 0x27, 0x21, 0x9c, 0x3f, 0x10, 0x49, 0x44,
+	0x43, 0x7f, 0x54, 0x6a, 0x7c, 0x0c, 0x4b, 0x71, 0xde, 0xc3, 0x9c, 0xaf, 0xa2, 0xda, 0xf2, 0xce,
+	0xba, 0x9b, 0xaf, 0x91, 0xf2, 0x72, 0x2d, 0xee, 0x10, 0xc8, 0x57, 0xe5, 0x46, 0x05, 0xce, 0x3c,
+	0x64, 0x49, 0x40, 0xcc, 0x85, 0x2a, 0xaa, 0x2d, 0xf9, 0x59, 0xf0, 0xc9, 0xf9, 0xa7, 0xaf, 0x9f,
+	0x6f, 0x8e, 0x00, 0x1c, 0x13, 0x56, 0x26, 0xa9, 0xfa, 0x84, 0x77, 0x19, 0xe5, 0xc4, 0xd9, 0x83,
+	0x4a, 0x93, 0x87, 0xf7, 0x29, 0x6e, 0x1f, 0x90, 0xff, 0x40, 0x4a, 0x01, 0xd9, 0x86, 0x2b, 0xba,
+	0xfe, 0x0a, 0xbf, 0x05, 0xef, 0x35, 0x79, 0x78, 0x2f, 0xe2, 0xff, 0x17, 0x81, 0x0d, 0x58, 0xd7,
+	0x02, 0x28, 0x06, 0xdf, 0x20, 0xb8, 0x98, 0x0d, 0xe7, 0x53, 0x92, 0xc4, 0x11, 0xe7, 0x11, 0xa3,
+	0x33, 0xef, 0xa4, 0x01, 0x25, 0x8a, 0x63, 0x22, 0x77, 0xb1, 0xec, 0xcb, 0xff, 0x86, 0x09, 0x8b,
+	0x44, 0xea, 0xef, 0xe4, 0x9b, 0x34, 0x0c, 0x0b, 0x5c, 0x2d, 0x30, 0x4f, 0x32, 0x51, 0x34, 0x85,
+	0x3c, 0x6d, 0xbb, 0x09, 0xc1, 0x82, 0xdc, 0x23, 0x94, 0xc5, 0x33, 0x73, 0xb4, 0x60, 0x89, 0xf7,
+	0xda, 0x9d, 0xb4, 0x47, 0xce, 0x53, 0xc5, 0x05, 0x46, 0xae, 0x3c, 0x38, 0x63, 0xa8, 0x43, 0x3e,
+	0xe9, 0xc1, 0xcb, 0x5a, 0x48, 0x64, 0x3f, 0x0b, 0x9c, 0x5f, 0x10, 0x2c, 0x36, 0x79, 0xd8, 0x8c,
+	0xa8, 0x98, 0x99, 0xdf, 0x47, 0x70, 0x16, 0xc7, 0xac, 0x47, 0x45, 0x7e, 0x17, 0x56, 0x47, 0x77,
+	0x81, 0x13, 0x75, 0x17, 0x76, 0x59, 0x44, 0x1b, 0xa5, 0x97, 0x7f, 0x6e, 0xcc, 0xf9, 0x79, 0x79,
+	0x0a, 0x98, 0x90, 0x20, 0xea, 0x46, 0x84, 0x0a, 0x39, 0xea, 0x53, 0x01, 0x55, 0x69, 0x41, 0xf4,
+	0x25, 0xb8, 0x90, 0x6b, 0x50, 0xd3, 0xff, 0x39, 0xd3, 0xd5, 0xe8, 0x25, 0xf4, 0xed, 0xeb, 0xda,
+	0x81, 0x45, 0x1c, 0x04, 0x72, 0xe5, 0x34, 0x55, 0xc3, 0xc2, 0x7f, 0xd1, 0x94, 0xf2, 0x57, 0x9a,
+	0x7e, 0x42, 0xf0, 0x6e, 0xba, 0xb9, 0xfb, 0x98, 0x86, 0xd9, 0xe6, 0xd6, 0x53, 0xa7, 0x9b, 0x59,
+	0x9f, 0x3a, 0x11, 0xf3, 0x63, 0x27, 0xc2, 0xf8, 0x10, 0xca, 0x94, 0x3c, 0x6e, 0x49, 0x13, 0x9d,
+	0x4a, 0x7f, 0x89, 0x92, 0xc7, 0x92, 0x44, 0x81, 0xff, 0x3a, 0xac, 0x69, 0xb8, 0x2a, 0x2d, 0x3f,
+	0x20, 0x30, 0x52, 0x9f, 0xe9, 0x93, 0xa0, 0xce, 0xeb, 0x8a, 0xd2, 0xac, 0x52, 0xee, 0x43, 0x29,
+	0xe6, 0x21, 0x37, 0xe7, 0xab, 0x0b, 0xb5, 0xe5, 0x9d, 0x8a, 0x9b, 0x3d, 0x11, 0xee, 0xf0, 0x89,
+	0x70, 0xeb, 0x74, 0xd0, 0x58, 0xfb, 0xf5, 0xc5, 0xd6, 0x65, 0xdd, 0x0e, 0x36, 0x79, 0xe8, 0xcb,
+	0xe5, 0x05, 0x11, 0x77, 0xc1, 0x2a, 0x92, 0x54, 0x37, 0xca, 0x84, 0xc5, 0x84, 0xf0, 0xde, 0x81,
+	0xe0, 0x26, 0xaa, 0x2e, 0xd4, 0xde, 0xf1, 0x87, 0xe1, 0xce, 0x57, 0x65, 0x58, 0x68, 0xf2, 0xd0,
+	0xf8, 0x1a, 0xc1, 0xa5, 0xa2, 0x55, 0x5f, 0x73, 0x27, 0x1e, 0x5e, 0x57, 0xe7, 0xb7, 0xd6, 0xed,
+	0x37, 0x28, 0x52, 0xd3, 0xac, 0x3e, 0xfd, 0xfd, 0xef, 0xef, 0xe7, 0x2d, 0xc7, 0xf4, 0x4e, 0x3e,
+	0xf5, 0x5e, 0x66, 0x5d, 0xc6, 0xb7, 0x08, 0x0c, 0x8d, 0x69, 0x5f, 0x2f, 0xa2, 0x14, 0xab, 0xac,
+	0x3b, 0x6f, 0x52, 0xa5, 0xc8, 0x5c, 0x95, 0x64, 0xd6, 0x9c, 0xd5, 0x22, 0x99, 0x4e, 0xb6, 0xca,
+	0x78, 0x02, 0xcb, 0xe3, 0x2c, 0xd6, 0x8b, 0xfd, 0xc7, 0xe1, 0x6f, 0x9c, 0x9a, 0x56, 0xb8, 0x37,
+	0x25, 0x6e, 0xd5, 0xb1, 0x8b, 0xb8, 0x9c, 0x88, 0x96, 0x7a, 0x81, 0xbf, 0x44, 0x70, 0x6e, 0xf2,
+	0xf1, 0xd8, 0xd0, 0x02, 0x8c, 0x0a, 0xac, 0xf7, 0xa7, 0x14, 0x28, 0x0e, 0x35, 0xc9, 0xc1, 0x71,
+	0xaa, 0x7a, 0x0e, 0xdd, 0x11, 0xe6, 0x13, 0x58, 0x1e, 0x7f, 0x1b, 0x34, 0x23, 0x18, 0x4b, 0xeb,
+	0x46, 0xa0, 0xf1, 0xf8, 0xd3, 0x46, 0x10, 0xc8, 0xf2, 0x56, 0x76, 0xc7, 0xf7, 0xa0, 0x24, 0x1d,
+	0x7f, 0xa5, 0xd8, 0x36, 0xfd, 0x6e, 0xd9, 0xfa, 0xef, 0x0a, 0xc7, 0x96, 0x38, 0xa6, 0xb3, 0x52,
+	0xc4, 0x89, 0xd3, 0xbe, 0x7b, 0x50, 0x92, 0xce, 0xab, 0xe9, 0x9f, 0x7e, 0xd7, 0xf5, 0x9f, 0x70,
+	0xba, 0x53, 0xfa, 0xb7, 0xd3, 0xbe, 0xcf, 0x10, 0x5c, 0x2c, 0xd8, 0xa0, 0xa3, 0x99, 0xd1, 0x89,
+	0x1a, 0x6b, 0x73, 0x7a, 0x8d, 0x22, 0x71, 0x47, 0x92, 0xb8, 0xe9, 0x5c, 0xd7, 0x0c, 0x53, 0xae,
+	0xc9, 0x86, 0x99, 0x39, 0xa5, 0xf1, 0x1d, 0x82, 0x0b, 0x27, 0xdd, 0xec, 0xaa, 0xe6, 0x0e, 0x4f,
+	0x96, 0x58, 0xb7, 0xa6, 0x96, 0x28, 0x3e, 0xb7, 0x25, 0x9f, 0x1b, 0xce, 0x35, 0xcd, 0x25, 0xef,
+	0x93, 0xa0, 0x85, 0x79, 0x4b, 0x39, 0x97, 0x75, 0xe6, 0x8b, 0xd7, 0xcf, 0x37, 0x51, 0x63, 0xf7,
+	0xe5, 0x91, 0x8d, 0x5e, 0x1d, 0xd9, 0xe8, 0xaf, 0x23, 0x1b, 0x3d, 0x3b, 0xb6, 0xe7, 0x5e, 0x1d,
+	0xdb, 0x73, 0x7f, 0x1c, 0xdb, 0x73, 0x9f, 0xdd, 0x0a, 0x23, 0xb1, 0xdf, 0x6b, 0xbb, 0x01, 0x8b,
+	0x65, 0xbf, 0xfe, 0xe0, 0x73, 0xf9, 0xbb, 0xc5, 0x3b, 0x8f, 0xbc, 0x7e, 0xde, 0x5d, 0x0c, 0xba,
+	0x84, 0xb7, 0xcf, 0x4a, 0x1b, 0xfd, 0xe0, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x7b, 0x07,
+	0x22, 0x48, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// EnableSetMetadata enables the acl-based admin permissions to set metadata.
+	// Deprecated: use SetPermission with the set-metadata permission.
+	EnableSetMetadata(ctx context.Context, in *MsgEnableSetMetadata, opts ...grpc.CallOption) (*MsgEnableSetMetadataResponse, error)
+	// DisableSetMetadata disables the acl-based admin permissions to set
+	// metadata.
+	// Deprecated: use SetPermission with the set-metadata permission.
+	DisableSetMetadata(ctx context.Context, in *MsgDisableSetMetadata, opts ...grpc.CallOption) (*MsgDisableSetMetadataResponse, error)
+	// SetMetadata is a permissioned message that allows the admin or superuser to
+	// set metadata for a given denom. This is only available if the admin module
+	// is enabled. Existing metadata can only be replaced for denoms created
+	// through the module, or by the superuser with force.
+	SetMetadata(ctx context.Context, in *MsgSetMetadata, opts ...grpc.CallOption) (*MsgSetMetadataResponse, error)
+	// SetPermission enables or disables a registered admin-gated action for the
+	// ACL admins.
+	SetPermission(ctx context.Context, in *MsgSetPermission, opts ...grpc.CallOption) (*MsgSetPermissionResponse, error)
+	// CreateDenom creates the factory/{sender}/{subdenom} denom, administered by
+	// the sender.
+	CreateDenom(ctx context.Context, in *MsgCreateDenom, opts ...grpc.CallOption) (*MsgCreateDenomResponse, error)
+	// Mint mints coins of a denom created through the module to an account.
+	Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error)
+	// Burn burns coins of a denom created through the module from an account.
+	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
+	// ChangeDenomAdmin changes the admin of a denom created through the module.
+	ChangeDenomAdmin(ctx context.Context, in *MsgChangeDenomAdmin, opts ...grpc.CallOption) (*MsgChangeDenomAdminResponse, error)
+	// ExecAsAuthority executes messages with the module authority as signer.
+	// Each message type must be registered by the app and enabled for the ACL
+	// admins with its exec permission.
+	ExecAsAuthority(ctx context.Context, in *MsgExecAsAuthority, opts ...grpc.CallOption) (*MsgExecAsAuthorityResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) EnableSetMetadata(ctx context.Context, in *MsgEnableSetMetadata, opts ...grpc.CallOption) (*MsgEnableSetMetadataResponse, error) {
+	out := new(MsgEnableSetMetadataResponse)
+	err := c.cc.Invoke(ctx, "/saga.admin.v1.Msg/EnableSetMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisableSetMetadata(ctx context.Context, in *MsgDisableSetMetadata, opts ...grpc.CallOption) (*MsgDisableSetMetadataResponse, error) {
+	out := new(MsgDisableSetMetadataResponse)
+	err := c.cc.Invoke(ctx, "/saga.admin.v1.Msg/DisableSetMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMetadata(ctx context.Context, in *MsgSetMetadata, opts ...grpc.CallOption) (*MsgSetMetadataResponse, error) {
+	out := new(MsgSetMetadataResponse)
+	err := c.cc.Invoke(ctx, "/saga.admin.v1.Msg/SetMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPermission(ctx context.Context, in *MsgSetPermission, opts ...grpc.CallOption) (*MsgSetPermissionResponse, error) {
+	out := new(MsgSetPermissionResponse)
+	err := c.cc.Invoke(ctx, "/saga.admin.v1.Msg/SetPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateDenom(ctx context.Context, in *MsgCreateDenom, opts ...grpc.CallOption) (*MsgCreateDenomResponse, error) {
+	out := new(MsgCreateDenomResponse)
+	err := c.cc.Invoke(ctx, "/saga.admin.v1.Msg/CreateDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error) {
+	out := new(MsgMintResponse)
+	err := c.cc.Invoke(ctx, "/saga.admin.v1.Msg/Mint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
+	out := new(MsgBurnResponse)
+	err := c.cc.Invoke(ctx, "/saga.admin.v1.Msg/Burn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ChangeDenomAdmin(ctx context.Context, in *MsgChangeDenomAdmin, opts ...grpc.CallOption) (*MsgChangeDenomAdminResponse, error) {
+	out := new(MsgChangeDenomAdminResponse)
+	err := c.cc.Invoke(ctx, "/saga.admin.v1.Msg/ChangeDenomAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExecAsAuthority(ctx context.Context, in *MsgExecAsAuthority, opts ...grpc.CallOption) (*MsgExecAsAuthorityResponse, error) {
+	out := new(MsgExecAsAuthorityResponse)
+	err := c.cc.Invoke(ctx, "/saga.admin.v1.Msg/ExecAsAuthority", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// EnableSetMetadata enables the acl-based admin permissions to set metadata.
+	// Deprecated: use SetPermission with the set-metadata permission.
+	EnableSetMetadata(context.Context, *MsgEnableSetMetadata) (*MsgEnableSetMetadataResponse, error)
+	// DisableSetMetadata disables the acl-based admin permissions to set
+	// metadata.
+	// Deprecated: use SetPermission with the set-metadata permission.
+	DisableSetMetadata(context.Context, *MsgDisableSetMetadata) (*MsgDisableSetMetadataResponse, error)
+	// SetMetadata is a permissioned message that allows the admin or superuser to
+	// set metadata for a given denom. This is only available if the admin module
+	// is enabled. Existing metadata can only be replaced for denoms created
+	// through the module, or by the superuser with force.
+	SetMetadata(context.Context, *MsgSetMetadata) (*MsgSetMetadataResponse, error)
+	// SetPermission enables or disables a registered admin-gated action for the
+	// ACL admins.
+	SetPermission(context.Context, *MsgSetPermission) (*MsgSetPermissionResponse, error)
+	// CreateDenom creates the factory/{sender}/{subdenom} denom, administered by
+	// the sender.
+	CreateDenom(context.Context, *MsgCreateDenom) (*MsgCreateDenomResponse, error)
+	// Mint mints coins of a denom created through the module to an account.
+	Mint(context.Context, *MsgMint) (*MsgMintResponse, error)
+	// Burn burns coins of a denom created through the module from an account.
+	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
+	// ChangeDenomAdmin changes the admin of a denom created through the module.
+	ChangeDenomAdmin(context.Context, *MsgChangeDenomAdmin) (*MsgChangeDenomAdminResponse, error)
+	// ExecAsAuthority executes messages with the module authority as signer.
+	// Each message type must be registered by the app and enabled for the ACL
+	// admins with its exec permission.
+	ExecAsAuthority(context.Context, *MsgExecAsAuthority) (*MsgExecAsAuthorityResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) EnableSetMetadata(ctx context.Context, req *MsgEnableSetMetadata) (*MsgEnableSetMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableSetMetadata not implemented")
+}
+func (*UnimplementedMsgServer) DisableSetMetadata(ctx context.Context, req *MsgDisableSetMetadata) (*MsgDisableSetMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableSetMetadata not implemented")
+}
+func (*UnimplementedMsgServer) SetMetadata(ctx context.Context, req *MsgSetMetadata) (*MsgSetMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMetadata not implemented")
+}
+func (*UnimplementedMsgServer) SetPermission(ctx context.Context, req *MsgSetPermission) (*MsgSetPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPermission not implemented")
+}
+func (*UnimplementedMsgServer) CreateDenom(ctx context.Context, req *MsgCreateDenom) (*MsgCreateDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDenom not implemented")
+}
+func (*UnimplementedMsgServer) Mint(ctx context.Context, req *MsgMint) (*MsgMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+}
+func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurn) (*MsgBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+}
+func (*UnimplementedMsgServer) ChangeDenomAdmin(ctx context.Context, req *MsgChangeDenomAdmin) (*MsgChangeDenomAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeDenomAdmin not implemented")
+}
+func (*UnimplementedMsgServer) ExecAsAuthority(ctx context.Context, req *MsgExecAsAuthority) (*MsgExecAsAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecAsAuthority not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_EnableSetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnableSetMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnableSetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.admin.v1.Msg/EnableSetMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnableSetMetadata(ctx, req.(*MsgEnableSetMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisableSetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableSetMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableSetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.admin.v1.Msg/DisableSetMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableSetMetadata(ctx, req.(*MsgDisableSetMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.admin.v1.Msg/SetMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMetadata(ctx, req.(*MsgSetMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPermission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.admin.v1.Msg/SetPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPermission(ctx, req.(*MsgSetPermission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.admin.v1.Msg/CreateDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDenom(ctx, req.(*MsgCreateDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Mint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.admin.v1.Msg/Mint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Mint(ctx, req.(*MsgMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Burn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.admin.v1.Msg/Burn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Burn(ctx, req.(*MsgBurn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeDenomAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeDenomAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeDenomAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.admin.v1.Msg/ChangeDenomAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeDenomAdmin(ctx, req.(*MsgChangeDenomAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExecAsAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecAsAuthority)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecAsAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.admin.v1.Msg/ExecAsAuthority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecAsAuthority(ctx, req.(*MsgExecAsAuthority))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "saga.admin.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EnableSetMetadata",
+			Handler:    _Msg_EnableSetMetadata_Handler,
+		},
+		{
+			MethodName: "DisableSetMetadata",
+			Handler:    _Msg_DisableSetMetadata_Handler,
+		},
+		{
+			MethodName: "SetMetadata",
+			Handler:    _Msg_SetMetadata_Handler,
+		},
+		{
+			MethodName: "SetPermission",
+			Handler:    _Msg_SetPermission_Handler,
+		},
+		{
+			MethodName: "CreateDenom",
+			Handler:    _Msg_CreateDenom_Handler,
+		},
+		{
+			MethodName: "Mint",
+			Handler:    _Msg_Mint_Handler,
+		},
+		{
+			MethodName: "Burn",
+			Handler:    _Msg_Burn_Handler,
+		},
+		{
+			MethodName: "ChangeDenomAdmin",
+			Handler:    _Msg_ChangeDenomAdmin_Handler,
+		},
+		{
+			MethodName: "ExecAsAuthority",
+			Handler:    _Msg_ExecAsAuthority_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "saga/admin/v1/tx.proto",
+}
+
+func (m *MsgSetMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableSetMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableSetMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableSetMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableSetMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableSetMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableSetMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableSetMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableSetMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableSetMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableSetMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableSetMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableSetMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPermissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPermissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subdenom) > 0 {
+		i -= len(m.Subdenom)
+		copy(dAtA[i:], m.Subdenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Subdenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeDenomAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeDenomAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeDenomAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeDenomAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeDenomAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeDenomAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecAsAuthority) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecAsAuthority) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecAsAuthority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecAsAuthorityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecAsAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecAsAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Results[iNdEx])
+			copy(dAtA[i:], m.Results[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Results[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEnableSetMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEnableSetMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisableSetMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisableSetMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetPermissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Subdenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBurnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeDenomAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChangeDenomAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecAsAuthority) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecAsAuthorityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, b := range m.Results {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &types.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnableSetMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableSetMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableSetMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnableSetMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableSetMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableSetMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisableSetMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableSetMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableSetMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisableSetMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableSetMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableSetMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPermissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPermissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPermissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subdenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subdenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgEnableSetMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgDisableSetMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgDisableSetMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSetMetadata) Unmarshal(dAtA []byte) error {
+func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -769,10 +3291,10 @@ func (m *MsgSetMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -809,7 +3331,7 @@ func (m *MsgSetMetadata) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -836,13 +3358,42 @@ func (m *MsgSetMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &types.Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -864,7 +3415,7 @@ func (m *MsgSetMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -887,10 +3438,10 @@ func (m *MsgSetMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -914,7 +3465,7 @@ func (m *MsgSetMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEnableSetMetadata) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeDenomAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -937,10 +3488,10 @@ func (m *MsgEnableSetMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEnableSetMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeDenomAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEnableSetMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeDenomAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -975,6 +3526,70 @@ func (m *MsgEnableSetMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -996,7 +3611,7 @@ func (m *MsgEnableSetMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEnableSetMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeDenomAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1019,10 +3634,10 @@ func (m *MsgEnableSetMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEnableSetMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeDenomAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEnableSetMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeDenomAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1046,7 +3661,7 @@ func (m *MsgEnableSetMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableSetMetadata) Unmarshal(dAtA []byte) error {
+func (m *MsgExecAsAuthority) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1069,10 +3684,10 @@ func (m *MsgDisableSetMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableSetMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecAsAuthority: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableSetMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecAsAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1107,6 +3722,40 @@ func (m *MsgDisableSetMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types2.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1128,7 +3777,7 @@ func (m *MsgDisableSetMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableSetMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecAsAuthorityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1151,12 +3800,44 @@ func (m *MsgDisableSetMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableSetMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecAsAuthorityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableSetMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecAsAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
+			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14,12 +14,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err != nil {
 		panic(err)
 	}
+	for _, request := range genState.UpgradeRequests {
+		k.SetUpgradeRequest(ctx, request)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.UpgradeRequests = k.ExportUpgradeRequests(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -28,7 +28,7 @@ func (k Keeper) TransmitConfirmUpgradePacket(
 		return 0, errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: %s", err)
 	}
 
-	return k.ibcKeeperFn().ChannelKeeper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
+	return k.channelKeeper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
 }
 
 // OnAcknowledgementConfirmUpgradePacket responds to the success or failure of a packet
@@ -57,6 +57,66 @@ func (k Keeper) OnTimeoutConfirmUpgradePacket(ctx sdk.Context, packet channeltyp
 	return nil
 }
 
+// TransmitRequestUpgradePacket transmits the packet over IBC with the specified source port and source channel
+func (k Keeper) TransmitRequestUpgradePacket(
+	ctx sdk.Context,
+	packetData types.RequestUpgradePacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	packetBytes, err := packetData.GetBytes()
+	if err != nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrJSONMarshal, "cannot marshal the packet: %s", err)
+	}
+
+	return k.channelKeeper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
+}
+
+// OnAcknowledgementRequestUpgradePacket records whether the provider accepted
+// the upgrade request.
+func (k Keeper) OnAcknowledgementRequestUpgradePacket(ctx sdk.Context, packet channeltypes.Packet, data types.RequestUpgradePacketData, ack channeltypes.Acknowledgement) error {
+	request, found := k.GetUpgradeRequest(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return fmt.Errorf("upgrade request %s/%d not found", packet.SourceChannel, packet.Sequence)
+	}
+
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		request.Status = types.UPGRADE_REQUEST_STATUS_REJECTED
+		request.Error = dispatchedAck.Error
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		var packetAck types.RequestUpgradePacketAck
+
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+		request.Status = types.UPGRADE_REQUEST_STATUS_ACCEPTED
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return errors.New("invalid acknowledgment format")
+	}
+
+	k.SetUpgradeRequest(ctx, request)
+	k.Logger(ctx).Info(fmt.Sprintf("upgrade request %s %s by the provider", request.Name, request.Status))
+	return nil
+}
+
+// OnTimeoutRequestUpgradePacket records that the upgrade request did not reach the provider
+func (k Keeper) OnTimeoutRequestUpgradePacket(ctx sdk.Context, packet channeltypes.Packet, data types.RequestUpgradePacketData) error {
+	request, found := k.GetUpgradeRequest(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return fmt.Errorf("upgrade request %s/%d not found", packet.SourceChannel, packet.Sequence)
+	}
+
+	request.Status = types.UPGRADE_REQUEST_STATUS_TIMED_OUT
+	k.SetUpgradeRequest(ctx, request)
+	return nil
+}
+
 // OnRecvCreateUpgradePacket processes packet reception
 func (k Keeper) OnRecvCreateUpgradePacket(ctx sdk.Context, packet channeltypes.Packet, data types.CreateUpgradePacketData) (packetAck types.CreateUpgradePacketAck, err error) {
 	// validate packet data upon receiving
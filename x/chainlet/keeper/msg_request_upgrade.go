@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/saga-sdk/x/chainlet/types"
+)
+
+// RequestUpgrade asks the provider to schedule an upgrade of the chainlet.
+// The provider answers with the acknowledgement of the packet.
+func (k msgServer) RequestUpgrade(goCtx context.Context, req *types.MsgRequestUpgrade) (*types.MsgRequestUpgradeResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.Height <= uint64(ctx.BlockHeight()) {
+		return nil, fmt.Errorf("upgrade height %d not after the current height %d", req.Height, ctx.BlockHeight())
+	}
+
+	packetData := types.RequestUpgradePacketData{
+		ChainId: ctx.ChainID(),
+		Name:    req.Name,
+		Height:  req.Height,
+		Info:    req.Info,
+	}
+	err := packetData.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sourceChannel, connectionID, err := k.getProviderChannel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	timeoutHeight, timeoutTimestamp, err := k.packetTimeout(ctx, connectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, err := k.TransmitRequestUpgradePacket(ctx, packetData, types.PortID, sourceChannel, timeoutHeight, timeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	k.SetUpgradeRequest(ctx, types.UpgradeRequest{
+		ChannelId: sourceChannel,
+		Sequence:  sequence,
+		Name:      req.Name,
+		Height:    req.Height,
+		Info:      req.Info,
+		Status:    types.UPGRADE_REQUEST_STATUS_PENDING,
+	})
+	k.Logger(ctx).Info(fmt.Sprintf("requested upgrade %s at height %d from the provider", req.Name, req.Height))
+
+	return &types.MsgRequestUpgradeResponse{
+		ChannelId: sourceChannel,
+		Sequence:  sequence,
+	}, nil
+}
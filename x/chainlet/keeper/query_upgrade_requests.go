@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sagaxyz/saga-sdk/x/chainlet/types"
+)
+
+func (k Keeper) UpgradeRequests(goCtx context.Context, req *types.QueryUpgradeRequestsRequest) (*types.QueryUpgradeRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var requests []types.UpgradeRequest
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UpgradeRequestKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var request types.UpgradeRequest
+		if err := k.cdc.Unmarshal(value, &request); err != nil {
+			return err
+		}
+		requests = append(requests, request)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUpgradeRequestsResponse{UpgradeRequests: requests, Pagination: pageRes}, nil
+}
@@ -33,6 +33,57 @@ func (k *Keeper) getConsumerConnectionID(ctx sdk.Context) (connectionID string,
 	return
 }
 
+// getProviderChannel returns an open channel of the module on the connection
+// to the provider chain.
+func (k *Keeper) getProviderChannel(ctx sdk.Context) (channelID, connectionID string, err error) {
+	connectionID, err = k.getConsumerConnectionID(ctx)
+	if err != nil {
+		return
+	}
+	channels := k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, types.PortID)
+	for _, channel := range channels {
+		if channel.State != channeltypes.OPEN {
+			continue
+		}
+		if len(channel.ConnectionHops) == 0 || channel.ConnectionHops[0] != connectionID {
+			continue
+		}
+
+		channelID = channel.ChannelId
+	}
+	if channelID == "" {
+		err = errors.New("no channel open")
+	}
+	return
+}
+
+// packetTimeout returns the timeout of a packet sent on the connection, as
+// configured by the module parameters.
+func (k *Keeper) packetTimeout(ctx sdk.Context, connectionID string) (timeoutHeight clienttypes.Height, timeoutTimestamp uint64, err error) {
+	connEnd, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		err = fmt.Errorf("connection %s not found", connectionID)
+		return
+	}
+	latestHeight := k.clientKeeper.GetClientLatestHeight(ctx, connEnd.ClientId)
+	p := k.GetParams(ctx)
+	if p.TimeoutTime > 0 {
+		un := ctx.BlockTime().Add(p.TimeoutTime).UnixNano()
+		if un < 0 {
+			err = errors.New("timeout negative")
+			return
+		}
+		timeoutTimestamp = uint64(un)
+	}
+	if p.TimeoutHeight > 0 {
+		timeoutHeight = clienttypes.Height{
+			RevisionNumber: latestHeight.GetRevisionNumber(),
+			RevisionHeight: latestHeight.GetRevisionHeight() + p.TimeoutHeight,
+		}
+	}
+	return
+}
+
 func (k Keeper) Send(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -49,25 +100,10 @@ func (k Keeper) Send(ctx context.Context) error {
 	}
 
 	// Find a channel for the provider chain
-	var sourceChannel *channeltypes.IdentifiedChannel
-	ccvConnectionID, err := k.getConsumerConnectionID(sdkCtx)
+	sourceChannel, ccvConnectionID, err := k.getProviderChannel(sdkCtx)
 	if err != nil {
 		return err
 	}
-	channels := k.channelKeeper.GetAllChannelsWithPortPrefix(sdkCtx, types.PortID)
-	for _, channel := range channels {
-		if channel.State != channeltypes.OPEN {
-			continue
-		}
-		if len(channel.ConnectionHops) == 0 || channel.ConnectionHops[0] != ccvConnectionID {
-			continue
-		}
-
-		sourceChannel = &channel
-	}
-	if sourceChannel == nil {
-		return errors.New("no channel open")
-	}
 
 	// Create the packet data
 	packetData := types.ConfirmUpgradePacketData{
@@ -81,29 +117,12 @@ func (k Keeper) Send(ctx context.Context) error {
 	}
 
 	// Timeout
-	connEnd, found := k.connectionKeeper.GetConnection(sdkCtx, ccvConnectionID)
-	if !found {
-		return fmt.Errorf("connection %s not found", ccvConnectionID)
-	}
-	latestHeight := k.clientKeeper.GetClientLatestHeight(sdkCtx, connEnd.ClientId)
-	p := k.GetParams(sdkCtx)
-	var timeoutTimestamp uint64
-	if p.TimeoutTime > 0 {
-		un := sdkCtx.BlockTime().Add(p.TimeoutTime).UnixNano()
-		if un < 0 {
-			return errors.New("timeout negative")
-		}
-		timeoutTimestamp = uint64(un)
-	}
-	var timeoutHeight clienttypes.Height
-	if p.TimeoutHeight > 0 {
-		timeoutHeight = clienttypes.Height{
-			RevisionNumber: latestHeight.GetRevisionNumber(),
-			RevisionHeight: latestHeight.GetRevisionHeight() + p.TimeoutHeight,
-		}
+	timeoutHeight, timeoutTimestamp, err := k.packetTimeout(sdkCtx, ccvConnectionID)
+	if err != nil {
+		return err
 	}
 
-	_, err = k.TransmitConfirmUpgradePacket(sdkCtx, packetData, types.PortID, sourceChannel.ChannelId, timeoutHeight, timeoutTimestamp)
+	_, err = k.TransmitConfirmUpgradePacket(sdkCtx, packetData, types.PortID, sourceChannel, timeoutHeight, timeoutTimestamp)
 	if err != nil {
 		return err
 	}
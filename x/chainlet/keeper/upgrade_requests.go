@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/saga-sdk/x/chainlet/types"
+)
+
+// GetUpgradeRequest returns the upgrade request sent in the packet with the
+// sequence on the channel.
+func (k Keeper) GetUpgradeRequest(ctx sdk.Context, channelID string, sequence uint64) (types.UpgradeRequest, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.UpgradeRequestKey(channelID, sequence))
+	if bz == nil {
+		return types.UpgradeRequest{}, false
+	}
+
+	var request types.UpgradeRequest
+	k.cdc.MustUnmarshal(bz, &request)
+	return request, true
+}
+
+// SetUpgradeRequest stores an upgrade request.
+func (k Keeper) SetUpgradeRequest(ctx sdk.Context, request types.UpgradeRequest) {
+	ctx.KVStore(k.storeKey).Set(types.UpgradeRequestKey(request.ChannelId, request.Sequence), k.cdc.MustMarshal(&request))
+}
+
+// ExportUpgradeRequests returns all the upgrade requests.
+func (k Keeper) ExportUpgradeRequests(ctx sdk.Context) (requests []types.UpgradeRequest) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.UpgradeRequestKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var request types.UpgradeRequest
+		k.cdc.MustUnmarshal(iterator.Value(), &request)
+		requests = append(requests, request)
+	}
+	return requests
+}
@@ -0,0 +1,264 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/sagaxyz/saga-sdk/x/chainlet"
+	"github.com/sagaxyz/saga-sdk/x/chainlet/keeper"
+	"github.com/sagaxyz/saga-sdk/x/chainlet/types"
+)
+
+const (
+	ccvChannelID   = "channel-0"
+	chainletChanID = "channel-1"
+	connectionID   = "connection-0"
+)
+
+type mockChannelKeeper struct {
+	channels []channeltypes.IdentifiedChannel
+	sequence uint64
+	sent     [][]byte
+}
+
+func (k *mockChannelKeeper) GetChannel(_ sdk.Context, portID, channelID string) (channeltypes.Channel, bool) {
+	if portID != ccvtypes.ConsumerPortID || channelID != ccvChannelID {
+		return channeltypes.Channel{}, false
+	}
+	return channeltypes.Channel{State: channeltypes.OPEN, ConnectionHops: []string{connectionID}}, true
+}
+
+func (k *mockChannelKeeper) GetNextSequenceSend(sdk.Context, string, string) (uint64, bool) {
+	return k.sequence + 1, true
+}
+
+func (k *mockChannelKeeper) SendPacket(_ sdk.Context, _, _ string, _ clienttypes.Height, _ uint64, data []byte) (uint64, error) {
+	k.sequence++
+	k.sent = append(k.sent, data)
+	return k.sequence, nil
+}
+
+func (k *mockChannelKeeper) ChanCloseInit(sdk.Context, string, string) error {
+	return nil
+}
+
+func (k *mockChannelKeeper) GetAllChannelsWithPortPrefix(sdk.Context, string) []channeltypes.IdentifiedChannel {
+	return k.channels
+}
+
+type mockConsumerKeeper struct {
+	channelID string
+}
+
+func (k mockConsumerKeeper) GetProviderChannel(sdk.Context) (string, bool) {
+	return k.channelID, k.channelID != ""
+}
+
+type mockClientKeeper struct{}
+
+func (mockClientKeeper) GetClientState(sdk.Context, string) (ibcexported.ClientState, bool) {
+	return nil, false
+}
+
+func (mockClientKeeper) GetClientLatestHeight(sdk.Context, string) clienttypes.Height {
+	return clienttypes.NewHeight(1, 100)
+}
+
+type mockConnectionKeeper struct{}
+
+func (mockConnectionKeeper) GetConnection(_ sdk.Context, id string) (connectiontypes.ConnectionEnd, bool) {
+	return connectiontypes.ConnectionEnd{ClientId: "07-tendermint-0"}, id == connectionID
+}
+
+type UpgradeRequestsTestSuite struct {
+	suite.Suite
+
+	ctx            sdk.Context
+	keeper         keeper.Keeper
+	msgServer      types.MsgServer
+	channelKeeper  *mockChannelKeeper
+	consumerKeeper *mockConsumerKeeper
+	authority      string
+}
+
+func TestUpgradeRequestsTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeRequestsTestSuite))
+}
+
+func (suite *UpgradeRequestsTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	suite.ctx = testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")).
+		WithBlockHeight(10).
+		WithBlockTime(time.Now()).
+		WithChainID("chainlet-1")
+	encCfg := moduletestutil.MakeTestEncodingConfig(chainlet.AppModuleBasic{})
+
+	suite.authority = sdk.AccAddress(address.Module("gov")).String()
+	suite.channelKeeper = &mockChannelKeeper{
+		channels: []channeltypes.IdentifiedChannel{{
+			State:          channeltypes.OPEN,
+			ConnectionHops: []string{connectionID},
+			PortId:         types.PortID,
+			ChannelId:      chainletChanID,
+		}},
+	}
+	suite.consumerKeeper = &mockConsumerKeeper{channelID: ccvChannelID}
+	suite.keeper = keeper.New(encCfg.Codec, key, suite.authority, nil, nil, suite.channelKeeper, suite.consumerKeeper, mockClientKeeper{}, mockConnectionKeeper{})
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, types.DefaultParams()))
+	suite.msgServer = keeper.NewMsgServerImpl(suite.keeper)
+}
+
+func (suite *UpgradeRequestsTestSuite) TestRequestUpgrade() {
+	testCases := []struct {
+		name     string
+		msg      *types.MsgRequestUpgrade
+		malleate func()
+		expErr   string
+	}{
+		{"valid", &types.MsgRequestUpgrade{Authority: suite.authority, Name: "v2", Height: 20, Info: "info"}, func() {}, ""},
+		{"empty info", &types.MsgRequestUpgrade{Authority: suite.authority, Name: "v2", Height: 20}, func() {}, ""},
+		{"invalid authority", &types.MsgRequestUpgrade{Authority: sdk.AccAddress([]byte{1}).String(), Name: "v2", Height: 20}, func() {}, "invalid authority"},
+		{"current height", &types.MsgRequestUpgrade{Authority: suite.authority, Name: "v2", Height: 10}, func() {}, "not after the current height"},
+		{"no provider channel", &types.MsgRequestUpgrade{Authority: suite.authority, Name: "v2", Height: 20}, func() { suite.consumerKeeper.channelID = "" }, "channel ID for consumer not found"},
+		{"no chainlet channel", &types.MsgRequestUpgrade{Authority: suite.authority, Name: "v2", Height: 20}, func() { suite.channelKeeper.channels = nil }, "no channel open"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			suite.Require().NoError(tc.msg.ValidateBasic())
+			res, err := suite.msgServer.RequestUpgrade(suite.ctx, tc.msg)
+			if tc.expErr != "" {
+				suite.Require().ErrorContains(err, tc.expErr)
+				suite.Require().Empty(suite.channelKeeper.sent)
+				suite.Require().Empty(suite.keeper.ExportUpgradeRequests(suite.ctx))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(&types.MsgRequestUpgradeResponse{ChannelId: chainletChanID, Sequence: 1}, res)
+
+			var packet types.ChainletPacketData
+			suite.Require().Len(suite.channelKeeper.sent, 1)
+			suite.Require().NoError(packet.Unmarshal(suite.channelKeeper.sent[0]))
+			suite.Require().Equal(&types.RequestUpgradePacketData{
+				ChainId: "chainlet-1",
+				Name:    tc.msg.Name,
+				Height:  tc.msg.Height,
+				Info:    tc.msg.Info,
+			}, packet.GetRequestUpgradePacket())
+
+			request, found := suite.keeper.GetUpgradeRequest(suite.ctx, chainletChanID, 1)
+			suite.Require().True(found)
+			suite.Require().Equal(types.UPGRADE_REQUEST_STATUS_PENDING, request.Status)
+			suite.Require().Equal(tc.msg.Name, request.Name)
+		})
+	}
+}
+
+func (suite *UpgradeRequestsTestSuite) TestTransmitConfirmUpgradePacket() {
+	data := types.ConfirmUpgradePacketData{ChainId: "chainlet-1", Height: 20, Plan: "v2"}
+	sequence, err := suite.keeper.TransmitConfirmUpgradePacket(suite.ctx, data, types.PortID, chainletChanID, clienttypes.ZeroHeight(), 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), sequence)
+
+	var packet types.ChainletPacketData
+	suite.Require().Len(suite.channelKeeper.sent, 1)
+	suite.Require().NoError(packet.Unmarshal(suite.channelKeeper.sent[0]))
+	suite.Require().Equal(&data, packet.GetConfirmUpgradePacket())
+}
+
+func (suite *UpgradeRequestsTestSuite) TestOnAcknowledgementRequestUpgradePacket() {
+	packet := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: chainletChanID, Sequence: 1}
+	rejection := channeltypes.NewErrorAcknowledgement(errors.New("plan exists"))
+
+	testCases := []struct {
+		name      string
+		ack       channeltypes.Acknowledgement
+		exists    bool
+		expErr    bool
+		expStatus types.UpgradeRequestStatus
+		expError  string
+	}{
+		{"accepted", channeltypes.NewResultAcknowledgement([]byte("{}")), true, false, types.UPGRADE_REQUEST_STATUS_ACCEPTED, ""},
+		{"rejected", rejection, true, false, types.UPGRADE_REQUEST_STATUS_REJECTED, rejection.GetError()},
+		{"malformed result", channeltypes.NewResultAcknowledgement([]byte("invalid")), true, true, types.UPGRADE_REQUEST_STATUS_PENDING, ""},
+		{"no response", channeltypes.Acknowledgement{}, true, true, types.UPGRADE_REQUEST_STATUS_PENDING, ""},
+		{"missing request", channeltypes.NewResultAcknowledgement([]byte("{}")), false, true, types.UPGRADE_REQUEST_STATUS_UNSPECIFIED, ""},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			if tc.exists {
+				_, err := suite.msgServer.RequestUpgrade(suite.ctx, &types.MsgRequestUpgrade{Authority: suite.authority, Name: "v2", Height: 20})
+				suite.Require().NoError(err)
+			}
+
+			err := suite.keeper.OnAcknowledgementRequestUpgradePacket(suite.ctx, packet, types.RequestUpgradePacketData{}, tc.ack)
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			request, _ := suite.keeper.GetUpgradeRequest(suite.ctx, chainletChanID, 1)
+			suite.Require().Equal(tc.expStatus, request.Status)
+			suite.Require().Equal(tc.expError, request.Error)
+		})
+	}
+}
+
+func (suite *UpgradeRequestsTestSuite) TestOnTimeoutRequestUpgradePacket() {
+	packet := channeltypes.Packet{SourcePort: types.PortID, SourceChannel: chainletChanID, Sequence: 1}
+
+	err := suite.keeper.OnTimeoutRequestUpgradePacket(suite.ctx, packet, types.RequestUpgradePacketData{})
+	suite.Require().Error(err)
+
+	_, err = suite.msgServer.RequestUpgrade(suite.ctx, &types.MsgRequestUpgrade{Authority: suite.authority, Name: "v2", Height: 20})
+	suite.Require().NoError(err)
+	err = suite.keeper.OnTimeoutRequestUpgradePacket(suite.ctx, packet, types.RequestUpgradePacketData{})
+	suite.Require().NoError(err)
+
+	request, found := suite.keeper.GetUpgradeRequest(suite.ctx, chainletChanID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(types.UPGRADE_REQUEST_STATUS_TIMED_OUT, request.Status)
+}
+
+func (suite *UpgradeRequestsTestSuite) TestUpgradeRequestsGenesisAndQuery() {
+	for i := 0; i < 3; i++ {
+		_, err := suite.msgServer.RequestUpgrade(suite.ctx, &types.MsgRequestUpgrade{Authority: suite.authority, Name: "v2", Height: 20})
+		suite.Require().NoError(err)
+	}
+
+	res, err := suite.keeper.UpgradeRequests(suite.ctx, &types.QueryUpgradeRequestsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.UpgradeRequests, 2)
+	suite.Require().Equal(uint64(1), res.UpgradeRequests[0].Sequence)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	genesis := chainlet.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Len(genesis.UpgradeRequests, 3)
+
+	suite.SetupTest()
+	chainlet.InitGenesis(suite.ctx, suite.keeper, *genesis)
+	suite.Require().Equal(genesis, chainlet.ExportGenesis(suite.ctx, suite.keeper))
+}
@@ -224,6 +224,12 @@ func (im IBCModule) OnAcknowledgementPacket(
 		eventType = types.EventTypeConfirmUpgradePacket
 	case *types.ChainletPacketData_CreateUpgradePacket:
 		return nil
+	case *types.ChainletPacketData_RequestUpgradePacket:
+		err := im.keeper.OnAcknowledgementRequestUpgradePacket(ctx, modulePacket, *packet.RequestUpgradePacket, ack)
+		if err != nil {
+			return err
+		}
+		eventType = types.EventTypeRequestUpgradePacket
 	// this line is used by starport scaffolding # ibc/packet/module/ack
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
@@ -284,6 +290,11 @@ func (im IBCModule) OnTimeoutPacket(
 		}
 	case *types.ChainletPacketData_CreateUpgradePacket:
 		return nil
+	case *types.ChainletPacketData_RequestUpgradePacket:
+		err := im.keeper.OnTimeoutRequestUpgradePacket(ctx, modulePacket, *packet.RequestUpgradePacket)
+		if err != nil {
+			return err
+		}
 		// this line is used by starport scaffolding # ibc/packet/module/timeout
 	default:
 		errMsg := fmt.Sprintf("unrecognized %s packet type: %T", types.ModuleName, packet)
@@ -16,6 +16,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 	cdc.RegisterConcrete(Params{}, "saga-sdk/x/chainlet/Params", nil)
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "saga-sdk/x/chainlet/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgRequestUpgrade{}, "saga-sdk/x/chainlet/MsgRequestUpgrade")
 }
 
 
@@ -26,6 +27,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgRequestUpgrade{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -6,6 +6,7 @@ const (
 	EventTypeCreateUpgradePacket  = "create_upgrade_packet"
 	EventTypeConfirmUpgradePacket = "confirm_upgrade_packet"
 	EventTypeCancelUpgradePacket  = "cancel_upgrade_packet"
+	EventTypeRequestUpgradePacket = "request_upgrade_packet"
 	// this line is used by starport scaffolding # ibc/packet/event
 
 	AttributeKeyAckSuccess = "success"
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	// this line is used by starport scaffolding # genesis/types/import
 )
 
@@ -20,5 +21,17 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
 
+	requests := make(map[string]bool)
+	for _, request := range gs.UpgradeRequests {
+		key := string(UpgradeRequestKey(request.ChannelId, request.Sequence))
+		if requests[key] {
+			return fmt.Errorf("duplicate upgrade request %s/%d", request.ChannelId, request.Sequence)
+		}
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		requests[key] = true
+	}
+
 	return gs.Params.Validate()
 }
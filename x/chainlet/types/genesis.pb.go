@@ -24,10 +24,52 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// UpgradeRequestStatus is the status of an upgrade requested from the
+// provider.
+type UpgradeRequestStatus int32
+
+const (
+	UPGRADE_REQUEST_STATUS_UNSPECIFIED UpgradeRequestStatus = 0
+	// The request packet was sent and is not acknowledged yet.
+	UPGRADE_REQUEST_STATUS_PENDING UpgradeRequestStatus = 1
+	// The provider accepted the request.
+	UPGRADE_REQUEST_STATUS_ACCEPTED UpgradeRequestStatus = 2
+	// The provider rejected the request.
+	UPGRADE_REQUEST_STATUS_REJECTED UpgradeRequestStatus = 3
+	// The request packet timed out.
+	UPGRADE_REQUEST_STATUS_TIMED_OUT UpgradeRequestStatus = 4
+)
+
+var UpgradeRequestStatus_name = map[int32]string{
+	0: "UPGRADE_REQUEST_STATUS_UNSPECIFIED",
+	1: "UPGRADE_REQUEST_STATUS_PENDING",
+	2: "UPGRADE_REQUEST_STATUS_ACCEPTED",
+	3: "UPGRADE_REQUEST_STATUS_REJECTED",
+	4: "UPGRADE_REQUEST_STATUS_TIMED_OUT",
+}
+
+var UpgradeRequestStatus_value = map[string]int32{
+	"UPGRADE_REQUEST_STATUS_UNSPECIFIED": 0,
+	"UPGRADE_REQUEST_STATUS_PENDING":     1,
+	"UPGRADE_REQUEST_STATUS_ACCEPTED":    2,
+	"UPGRADE_REQUEST_STATUS_REJECTED":    3,
+	"UPGRADE_REQUEST_STATUS_TIMED_OUT":   4,
+}
+
+func (x UpgradeRequestStatus) String() string {
+	return proto.EnumName(UpgradeRequestStatus_name, int32(x))
+}
+
+func (UpgradeRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_75b1729851777c46, []int{0}
+}
+
 // GenesisState defines the chainlet module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// upgrade_requests are the upgrades requested from the provider.
+	UpgradeRequests []UpgradeRequest `protobuf:"bytes,2,rep,name=upgrade_requests,json=upgradeRequests,proto3" json:"upgrade_requests"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,28 +112,150 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetUpgradeRequests() []UpgradeRequest {
+	if m != nil {
+		return m.UpgradeRequests
+	}
+	return nil
+}
+
+// UpgradeRequest is an upgrade requested from the provider, identified by the
+// channel and sequence of its packet.
+type UpgradeRequest struct {
+	ChannelId string               `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence  uint64               `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Name      string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Height    uint64               `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Info      string               `protobuf:"bytes,5,opt,name=info,proto3" json:"info,omitempty"`
+	Status    UpgradeRequestStatus `protobuf:"varint,6,opt,name=status,proto3,enum=saga.chainlet.v1.UpgradeRequestStatus" json:"status,omitempty"`
+	// error is the error acknowledged by the provider when rejecting the
+	// request.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *UpgradeRequest) Reset()         { *m = UpgradeRequest{} }
+func (m *UpgradeRequest) String() string { return proto.CompactTextString(m) }
+func (*UpgradeRequest) ProtoMessage()    {}
+func (*UpgradeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75b1729851777c46, []int{1}
+}
+func (m *UpgradeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeRequest.Merge(m, src)
+}
+func (m *UpgradeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeRequest proto.InternalMessageInfo
+
+func (m *UpgradeRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *UpgradeRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *UpgradeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpgradeRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *UpgradeRequest) GetInfo() string {
+	if m != nil {
+		return m.Info
+	}
+	return ""
+}
+
+func (m *UpgradeRequest) GetStatus() UpgradeRequestStatus {
+	if m != nil {
+		return m.Status
+	}
+	return UPGRADE_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (m *UpgradeRequest) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("saga.chainlet.v1.UpgradeRequestStatus", UpgradeRequestStatus_name, UpgradeRequestStatus_value)
 	proto.RegisterType((*GenesisState)(nil), "saga.chainlet.v1.GenesisState")
+	proto.RegisterType((*UpgradeRequest)(nil), "saga.chainlet.v1.UpgradeRequest")
 }
 
 func init() { proto.RegisterFile("saga/chainlet/v1/genesis.proto", fileDescriptor_75b1729851777c46) }
 
 var fileDescriptor_75b1729851777c46 = []byte{
-	// 214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0x4e, 0x4c, 0x4f,
-	0xd4, 0x4f, 0xce, 0x48, 0xcc, 0xcc, 0xcb, 0x49, 0x2d, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x00, 0xc9, 0xeb, 0xc1,
-	0xe4, 0xf5, 0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x91,
-	0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0x65, 0x31, 0x8c, 0x2e,
-	0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x9a, 0xac, 0xe4, 0xcd, 0xc5, 0xe3, 0x0e, 0xb1, 0x2a, 0xb8, 0x24,
-	0xb1, 0x24, 0x55, 0xc8, 0x9a, 0x8b, 0x0d, 0x22, 0x2f, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24,
-	0xa1, 0x87, 0x6e, 0xb5, 0x5e, 0x00, 0x58, 0xde, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf,
-	0x37, 0x68, 0x31, 0x06, 0x41, 0xb5, 0x38, 0xb9, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c,
-	0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1,
-	0x1c, 0x43, 0x94, 0x4e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xc8,
-	0xc0, 0x8a, 0xca, 0x2a, 0x30, 0xad, 0x5b, 0x9c, 0x92, 0xad, 0x5f, 0x81, 0x70, 0x5e, 0x49, 0x65,
-	0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x6d, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x78, 0x71,
-	0x0d, 0x4a, 0x17, 0x01, 0x00, 0x00,
+	// 490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4d, 0x6f, 0xd3, 0x30,
+	0x1c, 0xc6, 0xe3, 0xb5, 0x0b, 0xd4, 0x43, 0x23, 0x58, 0x15, 0x8a, 0x2a, 0x2d, 0x8b, 0x0a, 0x9a,
+	0xaa, 0x09, 0x12, 0xad, 0x1c, 0x91, 0x90, 0xba, 0xc6, 0xab, 0x82, 0x44, 0x09, 0x79, 0xe1, 0xc0,
+	0x25, 0xf2, 0x5a, 0x93, 0x44, 0xac, 0x49, 0x89, 0x9d, 0x69, 0xe3, 0x13, 0x70, 0xe4, 0x3b, 0xec,
+	0xc2, 0x91, 0x8f, 0xb1, 0xe3, 0x8e, 0x3b, 0x21, 0x68, 0x0f, 0x7c, 0x0d, 0x14, 0x27, 0xbc, 0x8c,
+	0x51, 0xb8, 0x24, 0xcf, 0xdf, 0xcf, 0xef, 0x79, 0x9c, 0x58, 0x86, 0x1a, 0x23, 0x11, 0x31, 0x27,
+	0x31, 0x49, 0xd2, 0x23, 0xca, 0xcd, 0xe3, 0x3d, 0x33, 0xa2, 0x29, 0x65, 0x09, 0x33, 0xe6, 0x79,
+	0xc6, 0x33, 0xa4, 0x94, 0xbe, 0xf1, 0xc3, 0x37, 0x8e, 0xf7, 0x3a, 0x77, 0xc8, 0x2c, 0x49, 0x33,
+	0x53, 0x3c, 0x2b, 0xa8, 0xd3, 0x8e, 0xb2, 0x28, 0x13, 0xd2, 0x2c, 0x55, 0xbd, 0xba, 0x75, 0xad,
+	0x7a, 0x4e, 0x72, 0x32, 0xab, 0x9b, 0xbb, 0x67, 0x00, 0xde, 0x1a, 0x55, 0x7b, 0x79, 0x9c, 0x70,
+	0x8a, 0x1e, 0x43, 0xb9, 0x02, 0x54, 0xa0, 0x83, 0xde, 0x46, 0x5f, 0x35, 0xfe, 0xdc, 0xdb, 0x70,
+	0x84, 0xbf, 0xdf, 0x3a, 0xff, 0xbc, 0x2d, 0x7d, 0xfc, 0xf6, 0x69, 0x17, 0xb8, 0x75, 0x04, 0xbd,
+	0x84, 0x4a, 0x31, 0x8f, 0x72, 0x32, 0xa5, 0x61, 0x4e, 0xdf, 0x16, 0x94, 0x71, 0xa6, 0xae, 0xe9,
+	0x8d, 0xde, 0x46, 0x5f, 0xbf, 0x5e, 0x13, 0x54, 0xa4, 0x5b, 0x81, 0xbf, 0xd7, 0xdd, 0x2e, 0xae,
+	0x58, 0xac, 0xfb, 0x15, 0xc0, 0xcd, 0xab, 0x38, 0xda, 0x82, 0x70, 0x12, 0x93, 0x34, 0xa5, 0x47,
+	0x61, 0x32, 0x15, 0xdf, 0xda, 0x72, 0x5b, 0xf5, 0x8a, 0x3d, 0x45, 0x1d, 0x78, 0x93, 0x95, 0x64,
+	0x3a, 0xa1, 0xea, 0x9a, 0x0e, 0x7a, 0x4d, 0xf7, 0xe7, 0x8c, 0x10, 0x6c, 0xa6, 0x64, 0x46, 0xd5,
+	0x86, 0x08, 0x09, 0x8d, 0xee, 0x42, 0x39, 0xa6, 0x49, 0x14, 0x73, 0xb5, 0x29, 0xe8, 0x7a, 0x2a,
+	0xd9, 0x24, 0x7d, 0x9d, 0xa9, 0xeb, 0x15, 0x5b, 0x6a, 0xf4, 0x04, 0xca, 0x8c, 0x13, 0x5e, 0x30,
+	0x55, 0xd6, 0x41, 0x6f, 0xb3, 0xbf, 0xf3, 0xbf, 0x7f, 0xf3, 0x04, 0xed, 0xd6, 0x29, 0xd4, 0x86,
+	0xeb, 0x34, 0xcf, 0xb3, 0x5c, 0xbd, 0x21, 0x4a, 0xab, 0x61, 0xf7, 0x12, 0xc0, 0xf6, 0xdf, 0x62,
+	0x68, 0x07, 0x76, 0x03, 0x67, 0xe4, 0x0e, 0x2c, 0x1c, 0xba, 0xf8, 0x45, 0x80, 0x3d, 0x3f, 0xf4,
+	0xfc, 0x81, 0x1f, 0x78, 0x61, 0x30, 0xf6, 0x1c, 0x3c, 0xb4, 0x0f, 0x6c, 0x6c, 0x29, 0x12, 0xea,
+	0x42, 0x6d, 0x05, 0xe7, 0xe0, 0xb1, 0x65, 0x8f, 0x47, 0x0a, 0x40, 0xf7, 0xe0, 0xf6, 0x0a, 0x66,
+	0x30, 0x1c, 0x62, 0xc7, 0xc7, 0x96, 0xb2, 0xf6, 0x0f, 0xc8, 0xc5, 0x4f, 0xf1, 0xb0, 0x84, 0x1a,
+	0xe8, 0x3e, 0xd4, 0x57, 0x40, 0xbe, 0xfd, 0x0c, 0x5b, 0xe1, 0xf3, 0xc0, 0x57, 0x9a, 0x9d, 0xe6,
+	0xfb, 0x33, 0x4d, 0xda, 0x3f, 0x38, 0x5f, 0x68, 0xe0, 0x62, 0xa1, 0x81, 0x2f, 0x0b, 0x0d, 0x7c,
+	0x58, 0x6a, 0xd2, 0xc5, 0x52, 0x93, 0x2e, 0x97, 0x9a, 0xf4, 0xea, 0x41, 0x94, 0xf0, 0xb8, 0x38,
+	0x34, 0x26, 0xd9, 0xcc, 0x2c, 0x0f, 0xf1, 0xe4, 0xf4, 0x9d, 0x78, 0x3f, 0x64, 0xd3, 0x37, 0xe6,
+	0xc9, 0xaf, 0x6b, 0xcb, 0x4f, 0xe7, 0x94, 0x1d, 0xca, 0xe2, 0xce, 0x3e, 0xfa, 0x1e, 0x00, 0x00,
+	0xff, 0xff, 0xae, 0xbc, 0xae, 0xa3, 0x2f, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +278,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UpgradeRequests) > 0 {
+		for iNdEx := len(m.UpgradeRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpgradeRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -127,6 +305,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UpgradeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Info) > 0 {
+		i -= len(m.Info)
+		copy(dAtA[i:], m.Info)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Info)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -146,6 +390,46 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.UpgradeRequests) > 0 {
+		for _, e := range m.UpgradeRequests {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpgradeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = len(m.Info)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovGenesis(uint64(m.Status))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -217,6 +501,275 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradeRequests = append(m.UpgradeRequests, UpgradeRequest{})
+			if err := m.UpgradeRequests[len(m.UpgradeRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= UpgradeRequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "chainlet"
@@ -27,8 +32,20 @@ var (
 var (
 	// PortKey defines the key to store the port ID in store
 	PortKey = KeyPrefix("chainlet-port-")
+
+	// UpgradeRequestKeyPrefix defines the prefix of the upgrades requested
+	// from the provider
+	UpgradeRequestKeyPrefix = KeyPrefix("upgrade-request-")
 )
 
+// UpgradeRequestKey returns the store key of an upgrade request, ordered by
+// channel and then by packet sequence.
+func UpgradeRequestKey(channelID string, sequence uint64) []byte {
+	key := append([]byte{}, UpgradeRequestKeyPrefix...)
+	key = append(key, address.MustLengthPrefix([]byte(channelID))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
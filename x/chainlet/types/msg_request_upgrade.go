@@ -0,0 +1,37 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgRequestUpgrade{}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRequestUpgrade) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRequestUpgrade message.
+func (m *MsgRequestUpgrade) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRequestUpgrade) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if m.Name == "" {
+		return errors.New("name cannot be empty")
+	}
+	if m.Height == 0 {
+		return errors.New("height has to be positive")
+	}
+
+	return nil
+}
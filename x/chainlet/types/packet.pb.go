@@ -29,6 +29,7 @@ type ChainletPacketData struct {
 	//	*ChainletPacketData_ConfirmUpgradePacket
 	//	*ChainletPacketData_CreateUpgradePacket
 	//	*ChainletPacketData_CancelUpgradePacket
+	//	*ChainletPacketData_RequestUpgradePacket
 	Packet isChainletPacketData_Packet `protobuf_oneof:"packet"`
 }
 
@@ -83,11 +84,15 @@ type ChainletPacketData_CreateUpgradePacket struct {
 type ChainletPacketData_CancelUpgradePacket struct {
 	CancelUpgradePacket *CancelUpgradePacketData `protobuf:"bytes,4,opt,name=cancelUpgradePacket,proto3,oneof" json:"cancelUpgradePacket,omitempty"`
 }
+type ChainletPacketData_RequestUpgradePacket struct {
+	RequestUpgradePacket *RequestUpgradePacketData `protobuf:"bytes,5,opt,name=requestUpgradePacket,proto3,oneof" json:"requestUpgradePacket,omitempty"`
+}
 
 func (*ChainletPacketData_NoData) isChainletPacketData_Packet()               {}
 func (*ChainletPacketData_ConfirmUpgradePacket) isChainletPacketData_Packet() {}
 func (*ChainletPacketData_CreateUpgradePacket) isChainletPacketData_Packet()  {}
 func (*ChainletPacketData_CancelUpgradePacket) isChainletPacketData_Packet()  {}
+func (*ChainletPacketData_RequestUpgradePacket) isChainletPacketData_Packet() {}
 
 func (m *ChainletPacketData) GetPacket() isChainletPacketData_Packet {
 	if m != nil {
@@ -124,6 +129,13 @@ func (m *ChainletPacketData) GetCancelUpgradePacket() *CancelUpgradePacketData {
 	return nil
 }
 
+func (m *ChainletPacketData) GetRequestUpgradePacket() *RequestUpgradePacketData {
+	if x, ok := m.GetPacket().(*ChainletPacketData_RequestUpgradePacket); ok {
+		return x.RequestUpgradePacket
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ChainletPacketData) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -131,6 +143,7 @@ func (*ChainletPacketData) XXX_OneofWrappers() []interface{} {
 		(*ChainletPacketData_ConfirmUpgradePacket)(nil),
 		(*ChainletPacketData_CreateUpgradePacket)(nil),
 		(*ChainletPacketData_CancelUpgradePacket)(nil),
+		(*ChainletPacketData_RequestUpgradePacket)(nil),
 	}
 }
 
@@ -464,6 +477,112 @@ func (m *CancelUpgradePacketAck) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CancelUpgradePacketAck proto.InternalMessageInfo
 
+// RequestUpgradePacketData defines a struct for the packet payload
+type RequestUpgradePacketData struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Height  uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Info    string `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (m *RequestUpgradePacketData) Reset()         { *m = RequestUpgradePacketData{} }
+func (m *RequestUpgradePacketData) String() string { return proto.CompactTextString(m) }
+func (*RequestUpgradePacketData) ProtoMessage()    {}
+func (*RequestUpgradePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed84d6c959cf7815, []int{8}
+}
+func (m *RequestUpgradePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestUpgradePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestUpgradePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestUpgradePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestUpgradePacketData.Merge(m, src)
+}
+func (m *RequestUpgradePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestUpgradePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestUpgradePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestUpgradePacketData proto.InternalMessageInfo
+
+func (m *RequestUpgradePacketData) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *RequestUpgradePacketData) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RequestUpgradePacketData) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RequestUpgradePacketData) GetInfo() string {
+	if m != nil {
+		return m.Info
+	}
+	return ""
+}
+
+// RequestUpgradePacketAck defines a struct for the packet acknowledgment
+type RequestUpgradePacketAck struct {
+}
+
+func (m *RequestUpgradePacketAck) Reset()         { *m = RequestUpgradePacketAck{} }
+func (m *RequestUpgradePacketAck) String() string { return proto.CompactTextString(m) }
+func (*RequestUpgradePacketAck) ProtoMessage()    {}
+func (*RequestUpgradePacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed84d6c959cf7815, []int{9}
+}
+func (m *RequestUpgradePacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestUpgradePacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestUpgradePacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestUpgradePacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestUpgradePacketAck.Merge(m, src)
+}
+func (m *RequestUpgradePacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestUpgradePacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestUpgradePacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestUpgradePacketAck proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ChainletPacketData)(nil), "saga.chainlet.v1.ChainletPacketData")
 	proto.RegisterType((*NoData)(nil), "saga.chainlet.v1.NoData")
@@ -473,37 +592,41 @@ func init() {
 	proto.RegisterType((*CreateUpgradePacketAck)(nil), "saga.chainlet.v1.CreateUpgradePacketAck")
 	proto.RegisterType((*CancelUpgradePacketData)(nil), "saga.chainlet.v1.CancelUpgradePacketData")
 	proto.RegisterType((*CancelUpgradePacketAck)(nil), "saga.chainlet.v1.CancelUpgradePacketAck")
+	proto.RegisterType((*RequestUpgradePacketData)(nil), "saga.chainlet.v1.RequestUpgradePacketData")
+	proto.RegisterType((*RequestUpgradePacketAck)(nil), "saga.chainlet.v1.RequestUpgradePacketAck")
 }
 
 func init() { proto.RegisterFile("saga/chainlet/v1/packet.proto", fileDescriptor_ed84d6c959cf7815) }
 
 var fileDescriptor_ed84d6c959cf7815 = []byte{
-	// 387 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcf, 0x6e, 0xa2, 0x50,
-	0x14, 0xc6, 0x41, 0x09, 0x23, 0x67, 0x36, 0x93, 0x3b, 0x13, 0x65, 0x16, 0x43, 0x26, 0xac, 0xda,
-	0xa6, 0x85, 0x68, 0x9f, 0x40, 0x6d, 0xfa, 0x67, 0xd3, 0x34, 0x24, 0xdd, 0x34, 0x69, 0xe2, 0xf5,
-	0x7a, 0x05, 0x82, 0x02, 0x01, 0x6a, 0xb4, 0x4f, 0xd1, 0xc7, 0xea, 0xd2, 0x65, 0x97, 0x8d, 0xbe,
-	0x41, 0x9f, 0xa0, 0xe1, 0x80, 0xad, 0x0a, 0xb8, 0xe2, 0xdc, 0x9c, 0x8f, 0xdf, 0x77, 0xee, 0x77,
-	0x72, 0xe1, 0x5f, 0x4c, 0x6d, 0x6a, 0x32, 0x87, 0xba, 0xfe, 0x84, 0x27, 0xe6, 0xac, 0x6d, 0x86,
-	0x94, 0x79, 0x3c, 0x31, 0xc2, 0x28, 0x48, 0x02, 0xf2, 0x2b, 0x6d, 0x1b, 0x9b, 0xb6, 0x31, 0x6b,
-	0xeb, 0x1f, 0x35, 0x20, 0xfd, 0xfc, 0x7c, 0x87, 0xd2, 0x0b, 0x9a, 0x50, 0xd2, 0x01, 0xd9, 0x0f,
-	0xd2, 0x4a, 0x15, 0xff, 0x8b, 0x47, 0x3f, 0x3b, 0xaa, 0xb1, 0xff, 0xa7, 0x71, 0x8b, 0xfd, 0x6b,
-	0xc1, 0xca, 0x95, 0x64, 0x00, 0x7f, 0x58, 0xe0, 0x8f, 0xdd, 0x68, 0x7a, 0x1f, 0xda, 0x11, 0x1d,
-	0xf1, 0x8c, 0xa7, 0xd6, 0x90, 0x70, 0x52, 0x24, 0xf4, 0x4b, 0xd4, 0x39, 0xb3, 0x94, 0x44, 0x1e,
-	0xe1, 0x37, 0x8b, 0x38, 0x4d, 0xf8, 0xae, 0x41, 0x1d, 0x0d, 0x8e, 0x4b, 0x0c, 0x8a, 0xe2, 0x9c,
-	0x5f, 0xc6, 0x41, 0x3c, 0xf5, 0x19, 0x9f, 0xec, 0xe2, 0xa5, 0x4a, 0x7c, 0x51, 0xfc, 0x85, 0x2f,
-	0xb6, 0x7a, 0x0d, 0x90, 0xb3, 0x65, 0xe8, 0x0d, 0x90, 0xb3, 0xf4, 0xf4, 0x01, 0xa8, 0x55, 0x29,
-	0x10, 0x15, 0x7e, 0xa0, 0xdb, 0xcd, 0x08, 0x97, 0xa0, 0x58, 0x9b, 0x23, 0x69, 0x82, 0xec, 0x70,
-	0xd7, 0x76, 0xb2, 0x6c, 0x25, 0x2b, 0x3f, 0x11, 0x02, 0x52, 0x38, 0xa1, 0x3e, 0x06, 0xa2, 0x58,
-	0x58, 0xeb, 0x7f, 0xa1, 0x55, 0xe6, 0xd0, 0x65, 0x9e, 0x1e, 0x43, 0xab, 0x22, 0xa1, 0x03, 0xde,
-	0x04, 0x24, 0x9f, 0x4e, 0x39, 0x3a, 0x2b, 0x16, 0xd6, 0x5b, 0xf3, 0xd4, 0xf7, 0xe7, 0x71, 0xfd,
-	0x71, 0x80, 0x09, 0x2a, 0x16, 0xd6, 0xba, 0x0a, 0xcd, 0x12, 0xd3, 0x74, 0x9c, 0x2b, 0x68, 0x55,
-	0x24, 0x7a, 0x78, 0x1c, 0xbc, 0x72, 0x6d, 0xeb, 0xca, 0xa9, 0x45, 0x11, 0xd4, 0x65, 0x5e, 0xef,
-	0xf2, 0x75, 0xa5, 0x89, 0xcb, 0x95, 0x26, 0xbe, 0xaf, 0x34, 0xf1, 0x65, 0xad, 0x09, 0xcb, 0xb5,
-	0x26, 0xbc, 0xad, 0x35, 0xe1, 0xe1, 0xd4, 0x76, 0x13, 0xe7, 0x69, 0x68, 0xb0, 0x60, 0x6a, 0xa6,
-	0x8b, 0x9e, 0x2f, 0x9e, 0xf1, 0x7b, 0x16, 0x8f, 0x3c, 0x73, 0xfe, 0xfd, 0xa2, 0x92, 0x45, 0xc8,
-	0xe3, 0xa1, 0x8c, 0xcf, 0xe9, 0xfc, 0x33, 0x00, 0x00, 0xff, 0xff, 0xf6, 0xf4, 0xb1, 0xa5, 0x6f,
-	0x03, 0x00, 0x00,
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0xae, 0xd2, 0x40,
+	0x14, 0xc6, 0xdb, 0x4b, 0xad, 0xb7, 0xc7, 0x8d, 0x19, 0x0d, 0x2d, 0x0b, 0x1b, 0xd3, 0x95, 0x1a,
+	0x6d, 0x03, 0x3e, 0x01, 0x60, 0xfc, 0xb3, 0x31, 0xa6, 0x89, 0x1b, 0x13, 0x13, 0x86, 0x61, 0x68,
+	0x9b, 0x42, 0x5b, 0xdb, 0x81, 0x80, 0x4f, 0xe1, 0x63, 0xb9, 0x64, 0xe1, 0xc2, 0xa5, 0x81, 0x17,
+	0x31, 0x3d, 0x2d, 0x0a, 0x74, 0xca, 0xee, 0xae, 0x38, 0x93, 0xf3, 0xf1, 0xfb, 0xce, 0x9c, 0xaf,
+	0x19, 0x78, 0x52, 0xd0, 0x80, 0x7a, 0x2c, 0xa4, 0x51, 0xb2, 0xe0, 0xc2, 0x5b, 0xf7, 0xbd, 0x8c,
+	0xb2, 0x98, 0x0b, 0x37, 0xcb, 0x53, 0x91, 0x92, 0x87, 0x65, 0xdb, 0x3d, 0xb6, 0xdd, 0x75, 0xdf,
+	0xf9, 0xd5, 0x01, 0x32, 0xae, 0xcf, 0x9f, 0x50, 0xfa, 0x86, 0x0a, 0x4a, 0x06, 0xa0, 0x27, 0x69,
+	0x59, 0x59, 0xea, 0x53, 0xf5, 0xd9, 0x83, 0x81, 0xe5, 0x5e, 0xfe, 0xd3, 0xfd, 0x88, 0xfd, 0xf7,
+	0x8a, 0x5f, 0x2b, 0xc9, 0x04, 0x1e, 0xb3, 0x34, 0x99, 0x47, 0xf9, 0xf2, 0x73, 0x16, 0xe4, 0x74,
+	0xc6, 0x2b, 0x9e, 0x75, 0x83, 0x84, 0x17, 0x4d, 0xc2, 0x58, 0xa2, 0xae, 0x99, 0x52, 0x12, 0xf9,
+	0x0a, 0x8f, 0x58, 0xce, 0xa9, 0xe0, 0xe7, 0x06, 0x1d, 0x34, 0x78, 0x2e, 0x31, 0x68, 0x8a, 0x6b,
+	0xbe, 0x8c, 0x83, 0x78, 0x9a, 0x30, 0xbe, 0x38, 0xc7, 0x6b, 0xad, 0xf8, 0xa6, 0xf8, 0x1f, 0xbe,
+	0xd9, 0x2a, 0xf7, 0x93, 0xf3, 0x6f, 0x2b, 0x5e, 0x88, 0x73, 0xfe, 0xbd, 0xb6, 0xfd, 0xf8, 0x12,
+	0xf5, 0x71, 0x3f, 0x32, 0xd2, 0xe8, 0x16, 0xf4, 0x2a, 0x6e, 0xe7, 0x16, 0xf4, 0x2a, 0x1f, 0x67,
+	0x02, 0x56, 0xdb, 0x9e, 0x89, 0x05, 0xf7, 0xd1, 0xef, 0xc3, 0x0c, 0x63, 0x36, 0xfc, 0xe3, 0x91,
+	0x74, 0x41, 0x0f, 0x79, 0x14, 0x84, 0x55, 0x7a, 0x9a, 0x5f, 0x9f, 0x08, 0x01, 0x2d, 0x5b, 0xd0,
+	0x04, 0x57, 0x6e, 0xf8, 0x58, 0x3b, 0x3d, 0x30, 0x65, 0x0e, 0x43, 0x16, 0x3b, 0x05, 0x98, 0x2d,
+	0x19, 0x5c, 0xf1, 0x26, 0xa0, 0x25, 0x74, 0xc9, 0xd1, 0xd9, 0xf0, 0xb1, 0x3e, 0x99, 0xa7, 0x73,
+	0x39, 0x4f, 0x94, 0xcc, 0x53, 0xcc, 0xc8, 0xf0, 0xb1, 0x76, 0x2c, 0xe8, 0x4a, 0x4c, 0xcb, 0x71,
+	0xde, 0x81, 0xd9, 0x92, 0xd9, 0xf5, 0x71, 0xf0, 0xca, 0x37, 0x27, 0x57, 0x2e, 0x2d, 0x9a, 0xa0,
+	0xd2, 0x42, 0x80, 0xd5, 0x16, 0xdb, 0x1d, 0x5e, 0xb9, 0x07, 0xa6, 0xcc, 0x75, 0xc8, 0xe2, 0xd1,
+	0xdb, 0x9f, 0x7b, 0x5b, 0xdd, 0xed, 0x6d, 0xf5, 0xcf, 0xde, 0x56, 0x7f, 0x1c, 0x6c, 0x65, 0x77,
+	0xb0, 0x95, 0xdf, 0x07, 0x5b, 0xf9, 0xf2, 0x32, 0x88, 0x44, 0xb8, 0x9a, 0xba, 0x2c, 0x5d, 0x7a,
+	0xe5, 0xb7, 0xb7, 0xd9, 0x7e, 0xc7, 0xdf, 0x57, 0xc5, 0x2c, 0xf6, 0x36, 0xff, 0x1f, 0x11, 0xb1,
+	0xcd, 0x78, 0x31, 0xd5, 0xf1, 0x05, 0x79, 0xfd, 0x37, 0x00, 0x00, 0xff, 0xff, 0xce, 0x8a, 0x79,
+	0x88, 0x62, 0x04, 0x00, 0x00,
 }
 
 func (m *ChainletPacketData) Marshal() (dAtA []byte, err error) {
@@ -622,6 +745,27 @@ func (m *ChainletPacketData_CancelUpgradePacket) MarshalToSizedBuffer(dAtA []byt
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ChainletPacketData_RequestUpgradePacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainletPacketData_RequestUpgradePacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RequestUpgradePacket != nil {
+		{
+			size, err := m.RequestUpgradePacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *NoData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -842,6 +986,78 @@ func (m *CancelUpgradePacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *RequestUpgradePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestUpgradePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestUpgradePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Info) > 0 {
+		i -= len(m.Info)
+		copy(dAtA[i:], m.Info)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Info)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequestUpgradePacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestUpgradePacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestUpgradePacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPacket(v)
 	base := offset
@@ -913,6 +1129,18 @@ func (m *ChainletPacketData_CancelUpgradePacket) Size() (n int) {
 	}
 	return n
 }
+func (m *ChainletPacketData_RequestUpgradePacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestUpgradePacket != nil {
+		l = m.RequestUpgradePacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
 func (m *NoData) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1010,6 +1238,39 @@ func (m *CancelUpgradePacketAck) Size() (n int) {
 	return n
 }
 
+func (m *RequestUpgradePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
+	}
+	l = len(m.Info)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *RequestUpgradePacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovPacket(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1185,6 +1446,41 @@ func (m *ChainletPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Packet = &ChainletPacketData_CancelUpgradePacket{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestUpgradePacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestUpgradePacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &ChainletPacketData_RequestUpgradePacket{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -1818,6 +2114,221 @@ func (m *CancelUpgradePacketAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RequestUpgradePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestUpgradePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestUpgradePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestUpgradePacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestUpgradePacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestUpgradePacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPacket(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -71,3 +71,26 @@ func (p CancelUpgradePacketData) GetBytes() ([]byte, error) {
 
 	return modulePacket.Marshal()
 }
+
+// ValidateBasic is used for validating the packet
+func (p RequestUpgradePacketData) ValidateBasic() error {
+	if p.ChainId == "" {
+		return errors.New("chainId cannot be empty")
+	}
+	if p.Name == "" {
+		return errors.New("name cannot be empty")
+	}
+	if p.Height == 0 {
+		return errors.New("height has to be positive")
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (p RequestUpgradePacketData) GetBytes() ([]byte, error) {
+	var modulePacket ChainletPacketData
+
+	modulePacket.Packet = &ChainletPacketData_RequestUpgradePacket{&p}
+
+	return modulePacket.Marshal()
+}
@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -114,36 +114,150 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryUpgradeRequestsRequest is request type for the Query/UpgradeRequests
+// RPC method.
+type QueryUpgradeRequestsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUpgradeRequestsRequest) Reset()         { *m = QueryUpgradeRequestsRequest{} }
+func (m *QueryUpgradeRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeRequestsRequest) ProtoMessage()    {}
+func (*QueryUpgradeRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21f679b85b5afc12, []int{2}
+}
+func (m *QueryUpgradeRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeRequestsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeRequestsRequest.Merge(m, src)
+}
+func (m *QueryUpgradeRequestsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeRequestsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeRequestsRequest proto.InternalMessageInfo
+
+func (m *QueryUpgradeRequestsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryUpgradeRequestsResponse is response type for the Query/UpgradeRequests
+// RPC method.
+type QueryUpgradeRequestsResponse struct {
+	// upgrade_requests are the requested upgrades, ordered by channel and
+	// sequence.
+	UpgradeRequests []UpgradeRequest `protobuf:"bytes,1,rep,name=upgrade_requests,json=upgradeRequests,proto3" json:"upgrade_requests"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUpgradeRequestsResponse) Reset()         { *m = QueryUpgradeRequestsResponse{} }
+func (m *QueryUpgradeRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeRequestsResponse) ProtoMessage()    {}
+func (*QueryUpgradeRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21f679b85b5afc12, []int{3}
+}
+func (m *QueryUpgradeRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeRequestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeRequestsResponse.Merge(m, src)
+}
+func (m *QueryUpgradeRequestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeRequestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeRequestsResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradeRequestsResponse) GetUpgradeRequests() []UpgradeRequest {
+	if m != nil {
+		return m.UpgradeRequests
+	}
+	return nil
+}
+
+func (m *QueryUpgradeRequestsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "saga.chainlet.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "saga.chainlet.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryUpgradeRequestsRequest)(nil), "saga.chainlet.v1.QueryUpgradeRequestsRequest")
+	proto.RegisterType((*QueryUpgradeRequestsResponse)(nil), "saga.chainlet.v1.QueryUpgradeRequestsResponse")
 }
 
 func init() { proto.RegisterFile("saga/chainlet/v1/query.proto", fileDescriptor_21f679b85b5afc12) }
 
 var fileDescriptor_21f679b85b5afc12 = []byte{
-	// 326 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x4e, 0x4c, 0x4f,
-	0xd4, 0x4f, 0xce, 0x48, 0xcc, 0xcc, 0xcb, 0x49, 0x2d, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d,
-	0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x00, 0xc9, 0xea, 0xc1, 0x64, 0xf5,
-	0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x91, 0x94, 0x48,
-	0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0x65, 0xd2, 0xf3, 0xf3, 0xd3, 0x73,
-	0x52, 0xf5, 0x13, 0x0b, 0x32, 0xf5, 0x13, 0xf3, 0xf2, 0xf2, 0x4b, 0x12, 0x4b, 0x32, 0xf3, 0xf3,
-	0x8a, 0xa1, 0xb2, 0x5a, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0x49, 0x89, 0xc5, 0xa9, 0x10,
-	0x1b, 0xf5, 0xcb, 0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5, 0x0b, 0x12, 0xd3, 0x33, 0xf3, 0xc0,
-	0x8a, 0xa1, 0x6a, 0x65, 0x31, 0x9c, 0x58, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x35, 0x4a, 0x49, 0x84,
-	0x4b, 0x28, 0x10, 0x64, 0x40, 0x00, 0x58, 0x30, 0x28, 0xb5, 0xb0, 0x34, 0xb5, 0xb8, 0x44, 0x29,
-	0x88, 0x4b, 0x18, 0x45, 0xb4, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0xc8, 0x9a, 0x8b, 0x0d, 0xa2,
-	0x59, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x42, 0x0f, 0xdd, 0x87, 0x7a, 0x10, 0x1d, 0x4e,
-	0x9c, 0x27, 0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08, 0xaa, 0xc5, 0xa8, 0x83,
-	0x91, 0x8b, 0x15, 0x6c, 0xa8, 0x50, 0x3d, 0x17, 0x1b, 0x44, 0x99, 0x90, 0x0a, 0xa6, 0x01, 0x98,
-	0xae, 0x91, 0x52, 0x25, 0xa0, 0x0a, 0xe2, 0x3a, 0x25, 0x8d, 0xa6, 0xcb, 0x4f, 0x26, 0x33, 0x29,
-	0x09, 0x29, 0xe8, 0x83, 0x94, 0x57, 0x54, 0x56, 0xe9, 0xe3, 0xf0, 0xba, 0x93, 0xdb, 0x89, 0x47,
-	0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85,
-	0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9,
-	0x25, 0xe7, 0xe7, 0xa2, 0x98, 0xa2, 0x5b, 0x9c, 0x92, 0xad, 0x5f, 0x81, 0x30, 0xab, 0xa4, 0xb2,
-	0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x86, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0x6b,
-	0xef, 0x35, 0x07, 0x02, 0x00, 0x00,
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x6e, 0xd3, 0x30,
+	0x1c, 0xaf, 0x8b, 0xa8, 0x84, 0x77, 0xd8, 0x30, 0x3b, 0x54, 0xa5, 0x84, 0x28, 0xe2, 0xa3, 0xaa,
+	0x36, 0x5b, 0x09, 0x47, 0x6e, 0x3b, 0x8c, 0xeb, 0x88, 0x04, 0x07, 0x2e, 0xc8, 0xe9, 0x2c, 0x2f,
+	0x62, 0xb1, 0xb3, 0xd8, 0xa9, 0x56, 0x2e, 0x48, 0x3c, 0x01, 0x12, 0xaf, 0xc0, 0x81, 0x1b, 0xbc,
+	0x01, 0xd7, 0x1d, 0x27, 0x71, 0xe1, 0x84, 0x50, 0x8b, 0xc4, 0x6b, 0xa0, 0xd8, 0x8e, 0xd6, 0x34,
+	0xab, 0xba, 0x4b, 0x65, 0xf9, 0xf7, 0xff, 0xff, 0xbe, 0xdc, 0xc0, 0xa1, 0xa2, 0x9c, 0x92, 0xc9,
+	0x09, 0x4d, 0xc5, 0x29, 0xd3, 0x64, 0x1a, 0x92, 0xb3, 0x92, 0x15, 0x33, 0x9c, 0x17, 0x52, 0x4b,
+	0xb4, 0x53, 0xa1, 0xb8, 0x46, 0xf1, 0x34, 0x1c, 0xdc, 0xa5, 0x59, 0x2a, 0x24, 0x31, 0xbf, 0x76,
+	0x68, 0xb0, 0xcb, 0x25, 0x97, 0xe6, 0x48, 0xaa, 0x93, 0xbb, 0x1d, 0x72, 0x29, 0xf9, 0x29, 0x23,
+	0x34, 0x4f, 0x09, 0x15, 0x42, 0x6a, 0xaa, 0x53, 0x29, 0x94, 0x43, 0xc7, 0x13, 0xa9, 0x32, 0xa9,
+	0x48, 0x42, 0x15, 0xb3, 0x8a, 0x64, 0x1a, 0x26, 0x4c, 0xd3, 0x90, 0xe4, 0x94, 0xa7, 0xc2, 0x0c,
+	0xbb, 0xd9, 0x07, 0x2d, 0x8b, 0x39, 0x2d, 0x68, 0x56, 0x53, 0x79, 0x2d, 0x98, 0x33, 0xc1, 0x54,
+	0xea, 0xf0, 0x60, 0x17, 0xa2, 0x97, 0x95, 0xc0, 0x91, 0x59, 0x8a, 0xd9, 0x59, 0xc9, 0x94, 0x0e,
+	0x62, 0x78, 0xaf, 0x71, 0xab, 0x72, 0x29, 0x14, 0x43, 0xcf, 0x61, 0xcf, 0x92, 0xf7, 0x81, 0x0f,
+	0x46, 0x5b, 0x51, 0x1f, 0xaf, 0x36, 0x80, 0xed, 0xc6, 0xc1, 0x9d, 0x8b, 0xdf, 0x0f, 0x3b, 0x5f,
+	0xff, 0x7d, 0x1f, 0x83, 0xd8, 0xad, 0x04, 0x0c, 0xde, 0x37, 0x9c, 0xaf, 0x72, 0x5e, 0xd0, 0x63,
+	0xe6, 0xa4, 0x6a, 0x49, 0x74, 0x08, 0xe1, 0x55, 0x36, 0xc7, 0xff, 0x04, 0xdb, 0x22, 0x70, 0x55,
+	0x04, 0xb6, 0xd5, 0xbb, 0x22, 0xf0, 0x11, 0xe5, 0x35, 0x47, 0xbc, 0xb4, 0x19, 0xfc, 0x00, 0x70,
+	0x78, 0xbd, 0x8e, 0x0b, 0xf1, 0x1a, 0xee, 0x94, 0x16, 0x7a, 0x5b, 0x38, 0xac, 0x0f, 0xfc, 0x5b,
+	0xa3, 0xad, 0xc8, 0x6f, 0xc7, 0x69, 0x92, 0x2c, 0xc7, 0xda, 0x2e, 0x9b, 0xfc, 0xe8, 0x45, 0x23,
+	0x40, 0xd7, 0x04, 0x78, 0xba, 0x31, 0x80, 0x35, 0xb5, 0x9c, 0x20, 0xfa, 0xd6, 0x85, 0xb7, 0x4d,
+	0x02, 0xf4, 0x01, 0xf6, 0x6c, 0x9f, 0xe8, 0x51, 0xdb, 0x5a, 0xfb, 0xd9, 0x06, 0x8f, 0x37, 0x4c,
+	0x59, 0xb1, 0x60, 0xf4, 0xf1, 0xe7, 0xdf, 0xcf, 0xdd, 0x00, 0xf9, 0xa4, 0x1a, 0x3f, 0x9f, 0xbd,
+	0x27, 0x6b, 0xfe, 0x43, 0xe8, 0x0b, 0x80, 0xdb, 0x2b, 0x3d, 0xa2, 0xfd, 0x35, 0x22, 0xd7, 0xbf,
+	0xeb, 0x00, 0xdf, 0x74, 0xdc, 0x99, 0x8b, 0x8c, 0xb9, 0x3d, 0x34, 0x5e, 0x6f, 0x6e, 0xf5, 0xf9,
+	0x0e, 0x0e, 0x2f, 0xe6, 0x1e, 0xb8, 0x9c, 0x7b, 0xe0, 0xcf, 0xdc, 0x03, 0x9f, 0x16, 0x5e, 0xe7,
+	0x72, 0xe1, 0x75, 0x7e, 0x2d, 0xbc, 0xce, 0x9b, 0x3d, 0x9e, 0xea, 0x93, 0x32, 0xc1, 0x13, 0x99,
+	0x35, 0xf8, 0xf6, 0xd5, 0xf1, 0x3b, 0x72, 0x7e, 0xc5, 0xaa, 0x67, 0x39, 0x53, 0x49, 0xcf, 0x7c,
+	0x13, 0xcf, 0xfe, 0x07, 0x00, 0x00, 0xff, 0xff, 0x2b, 0xea, 0x76, 0x85, 0xf7, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -160,6 +274,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// UpgradeRequests queries the upgrades requested from the provider.
+	UpgradeRequests(ctx context.Context, in *QueryUpgradeRequestsRequest, opts ...grpc.CallOption) (*QueryUpgradeRequestsResponse, error)
 }
 
 type queryClient struct {
@@ -179,10 +295,21 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) UpgradeRequests(ctx context.Context, in *QueryUpgradeRequestsRequest, opts ...grpc.CallOption) (*QueryUpgradeRequestsResponse, error) {
+	out := new(QueryUpgradeRequestsResponse)
+	err := c.cc.Invoke(ctx, "/saga.chainlet.v1.Query/UpgradeRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// UpgradeRequests queries the upgrades requested from the provider.
+	UpgradeRequests(context.Context, *QueryUpgradeRequestsRequest) (*QueryUpgradeRequestsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -192,6 +319,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) UpgradeRequests(ctx context.Context, req *QueryUpgradeRequestsRequest) (*QueryUpgradeRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeRequests not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -215,6 +345,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UpgradeRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.chainlet.v1.Query/UpgradeRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeRequests(ctx, req.(*QueryUpgradeRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "saga.chainlet.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -223,6 +371,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "UpgradeRequests",
+			Handler:    _Query_UpgradeRequests_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "saga/chainlet/v1/query.proto",
@@ -284,6 +436,90 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryUpgradeRequestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeRequestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UpgradeRequests) > 0 {
+		for iNdEx := len(m.UpgradeRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpgradeRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -315,6 +551,38 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryUpgradeRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUpgradeRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UpgradeRequests) > 0 {
+		for _, e := range m.UpgradeRequests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -454,6 +722,212 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryUpgradeRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradeRequests = append(m.UpgradeRequests, UpgradeRequest{})
+			if err := m.UpgradeRequests[len(m.UpgradeRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
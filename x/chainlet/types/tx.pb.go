@@ -129,37 +129,178 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRequestUpgrade is the Msg/RequestUpgrade request type.
+type MsgRequestUpgrade struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name is the name of the upgrade plan.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// height is the height of the upgrade.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// info is the info of the upgrade plan.
+	Info string `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (m *MsgRequestUpgrade) Reset()         { *m = MsgRequestUpgrade{} }
+func (m *MsgRequestUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestUpgrade) ProtoMessage()    {}
+func (*MsgRequestUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c53a415dd95a858, []int{2}
+}
+func (m *MsgRequestUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestUpgrade.Merge(m, src)
+}
+func (m *MsgRequestUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestUpgrade proto.InternalMessageInfo
+
+func (m *MsgRequestUpgrade) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRequestUpgrade) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgRequestUpgrade) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MsgRequestUpgrade) GetInfo() string {
+	if m != nil {
+		return m.Info
+	}
+	return ""
+}
+
+// MsgRequestUpgradeResponse defines the response structure for executing a
+// MsgRequestUpgrade message.
+type MsgRequestUpgradeResponse struct {
+	// channel_id is the channel the request was sent on.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the sequence of the request packet.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *MsgRequestUpgradeResponse) Reset()         { *m = MsgRequestUpgradeResponse{} }
+func (m *MsgRequestUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestUpgradeResponse) ProtoMessage()    {}
+func (*MsgRequestUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c53a415dd95a858, []int{3}
+}
+func (m *MsgRequestUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestUpgradeResponse.Merge(m, src)
+}
+func (m *MsgRequestUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestUpgradeResponse proto.InternalMessageInfo
+
+func (m *MsgRequestUpgradeResponse) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MsgRequestUpgradeResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "saga.chainlet.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "saga.chainlet.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRequestUpgrade)(nil), "saga.chainlet.v1.MsgRequestUpgrade")
+	proto.RegisterType((*MsgRequestUpgradeResponse)(nil), "saga.chainlet.v1.MsgRequestUpgradeResponse")
 }
 
 func init() { proto.RegisterFile("saga/chainlet/v1/tx.proto", fileDescriptor_7c53a415dd95a858) }
 
 var fileDescriptor_7c53a415dd95a858 = []byte{
-	// 348 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0x4e, 0x4c, 0x4f,
-	0xd4, 0x4f, 0xce, 0x48, 0xcc, 0xcc, 0xcb, 0x49, 0x2d, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x00, 0x49, 0xe9, 0xc1, 0xa4, 0xf4, 0xca, 0x0c, 0xa5,
-	0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x91, 0x94, 0x78, 0x72, 0x7e, 0x71,
-	0x6e, 0x7e, 0xb1, 0x7e, 0x6e, 0x71, 0x3a, 0x48, 0x73, 0x6e, 0x71, 0x3a, 0x54, 0x42, 0x12, 0x22,
-	0x11, 0x0f, 0xe6, 0xe9, 0x43, 0x38, 0x50, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x88, 0x38, 0x88,
-	0x05, 0x15, 0x95, 0xc5, 0x70, 0x49, 0x41, 0x62, 0x51, 0x62, 0x2e, 0x54, 0x93, 0xd2, 0x5e, 0x46,
-	0x2e, 0x7e, 0xdf, 0xe2, 0xf4, 0xd0, 0x82, 0x94, 0xc4, 0x92, 0xd4, 0x00, 0xb0, 0x8c, 0x90, 0x19,
-	0x17, 0x67, 0x62, 0x69, 0x49, 0x46, 0x7e, 0x51, 0x66, 0x49, 0xa5, 0x04, 0xa3, 0x02, 0xa3, 0x06,
-	0xa7, 0x93, 0xc4, 0xa5, 0x2d, 0xba, 0x22, 0x50, 0xdb, 0x1c, 0x53, 0x52, 0x8a, 0x52, 0x8b, 0x8b,
-	0x83, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0x85, 0xac, 0xb9, 0xd8, 0x20, 0x66, 0x4b,
-	0x30, 0x29, 0x30, 0x6a, 0x70, 0x1b, 0x49, 0xe8, 0xa1, 0x7b, 0x55, 0x0f, 0x62, 0x83, 0x13, 0xe7,
-	0x89, 0x7b, 0xf2, 0x0c, 0x2b, 0x9e, 0x6f, 0xd0, 0x62, 0x0c, 0x82, 0x6a, 0xb1, 0x32, 0x6d, 0x7a,
-	0xbe, 0x41, 0x0b, 0x61, 0x58, 0xd7, 0xf3, 0x0d, 0x5a, 0x4a, 0x60, 0xa7, 0x57, 0xa0, 0x38, 0x1e,
-	0xcd, 0xad, 0x4a, 0x92, 0x5c, 0xe2, 0x68, 0x42, 0x41, 0xa9, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9,
-	0x46, 0x59, 0x5c, 0xcc, 0xbe, 0xc5, 0xe9, 0x42, 0x31, 0x5c, 0x3c, 0x28, 0xbe, 0x53, 0xc4, 0x74,
-	0x15, 0x9a, 0x09, 0x52, 0x9a, 0x04, 0x95, 0xc0, 0x2c, 0x91, 0x62, 0x6d, 0x00, 0xf9, 0xc2, 0xc9,
-	0xed, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x74, 0xd2, 0x33, 0x4b, 0x32,
-	0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x41, 0xa6, 0x56, 0x54, 0x56, 0x81, 0x69, 0xdd, 0xe2,
-	0x94, 0x6c, 0x64, 0xbf, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x63, 0xc5, 0x18, 0x10,
-	0x00, 0x00, 0xff, 0xff, 0x42, 0x8f, 0xf6, 0x4c, 0x40, 0x02, 0x00, 0x00,
+	// 490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0xd1, 0x10, 0xe1, 0x03, 0x01, 0xb5, 0x2a, 0xea, 0x58, 0xaa, 0x09, 0x86, 0x21, 0x04,
+	0x6a, 0xab, 0x45, 0x80, 0x54, 0x26, 0x32, 0x20, 0x31, 0x44, 0x42, 0x46, 0x65, 0x40, 0x48, 0xd5,
+	0xc5, 0x3e, 0xce, 0x16, 0xf5, 0x9d, 0xf1, 0xbb, 0x54, 0x09, 0x13, 0x62, 0x64, 0xe2, 0x67, 0x30,
+	0x66, 0x60, 0x65, 0x63, 0xe8, 0x84, 0x2a, 0x26, 0x26, 0x84, 0x92, 0x21, 0x7f, 0x03, 0xd9, 0x77,
+	0x69, 0x89, 0x53, 0x09, 0xd4, 0xc5, 0xbe, 0xf7, 0xbe, 0xef, 0xbd, 0xf7, 0x7d, 0xf7, 0x6c, 0xdc,
+	0x04, 0xc2, 0x88, 0x1f, 0xc6, 0x24, 0xe1, 0xfb, 0x54, 0xfa, 0x07, 0x5b, 0xbe, 0x1c, 0x7a, 0x59,
+	0x2e, 0xa4, 0x30, 0xaf, 0x16, 0x90, 0x37, 0x87, 0xbc, 0x83, 0x2d, 0x7b, 0x95, 0xa4, 0x09, 0x17,
+	0x7e, 0xf9, 0x54, 0x24, 0x7b, 0x3d, 0x14, 0x90, 0x0a, 0xf0, 0x53, 0x60, 0x45, 0x71, 0x0a, 0x4c,
+	0x03, 0x4d, 0x05, 0xec, 0x95, 0x91, 0xaf, 0x02, 0x0d, 0xad, 0x31, 0xc1, 0x84, 0xca, 0x17, 0x27,
+	0x9d, 0xdd, 0x58, 0x52, 0x92, 0x91, 0x9c, 0xa4, 0xba, 0xc8, 0xfd, 0x8a, 0xf0, 0x95, 0x1e, 0xb0,
+	0xdd, 0x2c, 0x22, 0x92, 0x3e, 0x2b, 0x11, 0xf3, 0x01, 0x36, 0xc8, 0x40, 0xc6, 0x22, 0x4f, 0xe4,
+	0xc8, 0x42, 0x2d, 0xd4, 0x36, 0xba, 0xd6, 0x8f, 0x2f, 0x9b, 0x6b, 0x7a, 0xda, 0xe3, 0x28, 0xca,
+	0x29, 0xc0, 0x73, 0x99, 0x27, 0x9c, 0x05, 0x27, 0x54, 0xf3, 0x11, 0x6e, 0xa8, 0xde, 0xd6, 0xb9,
+	0x16, 0x6a, 0x5f, 0xdc, 0xb6, 0xbc, 0xaa, 0x55, 0x4f, 0x4d, 0xe8, 0x1a, 0x87, 0xbf, 0xae, 0xd7,
+	0x3e, 0xcf, 0xc6, 0x1d, 0x14, 0xe8, 0x92, 0x9d, 0xfb, 0x1f, 0x66, 0xe3, 0xce, 0x49, 0xb3, 0x8f,
+	0xb3, 0x71, 0xc7, 0x2d, 0xa5, 0x0f, 0x17, 0xc4, 0x57, 0xb4, 0xba, 0x4d, 0xbc, 0x5e, 0x49, 0x05,
+	0x14, 0x32, 0xc1, 0x81, 0xba, 0xdf, 0x10, 0x5e, 0xed, 0x01, 0x0b, 0xe8, 0xdb, 0x01, 0x05, 0xb9,
+	0x9b, 0xb1, 0x9c, 0x44, 0xf4, 0xcc, 0xe6, 0x4c, 0x5c, 0xe7, 0x24, 0xa5, 0xa5, 0x35, 0x23, 0x28,
+	0xcf, 0xe6, 0x35, 0xdc, 0x88, 0x69, 0xc2, 0x62, 0x69, 0xad, 0xb4, 0x50, 0xbb, 0x1e, 0xe8, 0xa8,
+	0xe0, 0x26, 0xfc, 0xb5, 0xb0, 0xea, 0x8a, 0x5b, 0x9c, 0x77, 0x1e, 0x2e, 0xfb, 0xbb, 0x75, 0xba,
+	0xbf, 0x45, 0xc1, 0xee, 0x0b, 0xdc, 0x5c, 0x4a, 0xce, 0x3d, 0x9a, 0x1b, 0x18, 0x87, 0x31, 0xe1,
+	0x9c, 0xee, 0xef, 0x25, 0x91, 0xb2, 0x13, 0x18, 0x3a, 0xf3, 0x34, 0x32, 0x6d, 0x7c, 0x01, 0x8a,
+	0x42, 0x1e, 0x2a, 0xe1, 0xf5, 0xe0, 0x38, 0xde, 0xfe, 0x8e, 0xf0, 0x4a, 0x0f, 0x98, 0xf9, 0x0a,
+	0x5f, 0x5a, 0xd8, 0xfe, 0x8d, 0xe5, 0xad, 0x55, 0x6e, 0xd8, 0xbe, 0xfd, 0x4f, 0xca, 0xb1, 0xc0,
+	0x3e, 0xbe, 0x5c, 0x59, 0xc0, 0xcd, 0x53, 0x8b, 0x17, 0x49, 0xf6, 0x9d, 0xff, 0x20, 0xcd, 0x67,
+	0xd8, 0xe7, 0xdf, 0x17, 0x5f, 0x52, 0xf7, 0xc9, 0xe1, 0xc4, 0x41, 0x47, 0x13, 0x07, 0xfd, 0x9e,
+	0x38, 0xe8, 0xd3, 0xd4, 0xa9, 0x1d, 0x4d, 0x9d, 0xda, 0xcf, 0xa9, 0x53, 0x7b, 0x79, 0x97, 0x25,
+	0x32, 0x1e, 0xf4, 0xbd, 0x50, 0xa4, 0x7e, 0xd1, 0x77, 0x38, 0x7a, 0x57, 0xbe, 0x37, 0x21, 0x7a,
+	0xf3, 0xf7, 0xfd, 0xcb, 0x51, 0x46, 0xa1, 0xdf, 0x28, 0xff, 0x8c, 0x7b, 0x7f, 0x02, 0x00, 0x00,
+	0xff, 0xff, 0x52, 0xc6, 0xe3, 0xa0, 0xc4, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -176,6 +317,9 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RequestUpgrade defines a (governance) operation for requesting an upgrade
+	// of the chainlet from the provider.
+	RequestUpgrade(ctx context.Context, in *MsgRequestUpgrade, opts ...grpc.CallOption) (*MsgRequestUpgradeResponse, error)
 }
 
 type msgClient struct {
@@ -195,10 +339,22 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RequestUpgrade(ctx context.Context, in *MsgRequestUpgrade, opts ...grpc.CallOption) (*MsgRequestUpgradeResponse, error) {
+	out := new(MsgRequestUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/saga.chainlet.v1.Msg/RequestUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RequestUpgrade defines a (governance) operation for requesting an upgrade
+	// of the chainlet from the provider.
+	RequestUpgrade(context.Context, *MsgRequestUpgrade) (*MsgRequestUpgradeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -208,6 +364,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RequestUpgrade(ctx context.Context, req *MsgRequestUpgrade) (*MsgRequestUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestUpgrade not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -231,6 +390,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RequestUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.chainlet.v1.Msg/RequestUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestUpgrade(ctx, req.(*MsgRequestUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "saga.chainlet.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -239,6 +416,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RequestUpgrade",
+			Handler:    _Msg_RequestUpgrade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "saga/chainlet/v1/tx.proto",
@@ -307,6 +488,90 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRequestUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Info) > 0 {
+		i -= len(m.Info)
+		copy(dAtA[i:], m.Info)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Info)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -342,6 +607,46 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRequestUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = len(m.Info)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -513,6 +818,272 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRequestUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
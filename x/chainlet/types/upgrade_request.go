@@ -0,0 +1,28 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// Validate performs a basic validation of an upgrade request.
+func (r UpgradeRequest) Validate() error {
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return err
+	}
+	if r.Sequence == 0 {
+		return errors.New("sequence has to be positive")
+	}
+	if r.Name == "" {
+		return errors.New("name cannot be empty")
+	}
+	if r.Height == 0 {
+		return errors.New("height has to be positive")
+	}
+	if _, ok := UpgradeRequestStatus_name[int32(r.Status)]; !ok || r.Status == UPGRADE_REQUEST_STATUS_UNSPECIFIED {
+		return fmt.Errorf("invalid upgrade request status %s", r.Status)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sagaxyz/saga-sdk/x/chainlet/types"
+)
+
+func TestGenesisValidateUpgradeRequests(t *testing.T) {
+	request := types.UpgradeRequest{
+		ChannelId: "channel-1",
+		Sequence:  1,
+		Name:      "v2",
+		Height:    20,
+		Status:    types.UPGRADE_REQUEST_STATUS_PENDING,
+	}
+	with := func(malleate func(*types.UpgradeRequest)) types.UpgradeRequest {
+		r := request
+		malleate(&r)
+		return r
+	}
+
+	testCases := []struct {
+		name     string
+		requests []types.UpgradeRequest
+		expPass  bool
+	}{
+		{"valid", []types.UpgradeRequest{request, with(func(r *types.UpgradeRequest) { r.Sequence = 2 })}, true},
+		{"same sequence on another channel", []types.UpgradeRequest{request, with(func(r *types.UpgradeRequest) { r.ChannelId = "channel-2" })}, true},
+		{"duplicate", []types.UpgradeRequest{request, with(func(r *types.UpgradeRequest) { r.Name = "v3" })}, false},
+		{"invalid channel", []types.UpgradeRequest{with(func(r *types.UpgradeRequest) { r.ChannelId = "" })}, false},
+		{"zero sequence", []types.UpgradeRequest{with(func(r *types.UpgradeRequest) { r.Sequence = 0 })}, false},
+		{"empty name", []types.UpgradeRequest{with(func(r *types.UpgradeRequest) { r.Name = "" })}, false},
+		{"zero height", []types.UpgradeRequest{with(func(r *types.UpgradeRequest) { r.Height = 0 })}, false},
+		{"unspecified status", []types.UpgradeRequest{with(func(r *types.UpgradeRequest) { r.Status = types.UPGRADE_REQUEST_STATUS_UNSPECIFIED })}, false},
+		{"unknown status", []types.UpgradeRequest{with(func(r *types.UpgradeRequest) { r.Status = 100 })}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genesis := types.DefaultGenesis()
+			genesis.UpgradeRequests = tc.requests
+			if tc.expPass {
+				require.NoError(t, genesis.Validate())
+			} else {
+				require.Error(t, genesis.Validate())
+			}
+		})
+	}
+}
+
+func TestUpgradeRequestKey(t *testing.T) {
+	// Requests are ordered by channel, then by sequence
+	ordered := [][]byte{
+		types.UpgradeRequestKey("channel-1", 1),
+		types.UpgradeRequestKey("channel-1", 2),
+		types.UpgradeRequestKey("channel-1", 256),
+		types.UpgradeRequestKey("channel-2", 1),
+		types.UpgradeRequestKey("channel-10", 1),
+	}
+	for i := 1; i < len(ordered); i++ {
+		require.Equal(t, -1, bytes.Compare(ordered[i-1], ordered[i]), "key %d", i)
+	}
+
+	// Building a key does not alter the prefix
+	require.True(t, bytes.HasPrefix(ordered[0], types.UpgradeRequestKeyPrefix))
+	require.Equal(t, types.KeyPrefix("upgrade-request-"), types.UpgradeRequestKeyPrefix)
+}
+
+func TestRequestUpgradeValidateBasic(t *testing.T) {
+	packet := types.RequestUpgradePacketData{ChainId: "chainlet-1", Name: "v2", Height: 20}
+	require.NoError(t, packet.ValidateBasic())
+	packet.Height = 0
+	require.Error(t, packet.ValidateBasic())
+
+	msg := types.MsgRequestUpgrade{Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn", Name: "v2", Height: 20}
+	require.NoError(t, msg.ValidateBasic())
+	msg.Name = ""
+	require.Error(t, msg.ValidateBasic())
+}
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -21,6 +24,14 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetParamsCmd(),
+		GetBurnedCmd(),
+		GetNextDistributionCmd(),
+		GetFailuresCmd(),
+		GetDistributedCmd(),
+		GetRecordsCmd(),
+		GetFeeCollectorCmd(),
+		GetPendingPayoutCmd(),
+		GetSimulatePayoutCmd(),
 	)
 	return cmd
 }
@@ -52,3 +63,274 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetBurnedCmd queries the total amount of fees burned
+func GetBurnedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned",
+		Short: "Get the total amount of fees burned",
+		Long:  "Get the total amount of fees burned by the feedistribution module per denom.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Burned(cmd.Context(), &types.QueryBurnedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetNextDistributionCmd queries the next fee distribution
+func GetNextDistributionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-distribution",
+		Short: "Get the next fee distribution",
+		Long:  "Get the height or time of the next fee distribution and the amount of fees pending until then.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NextDistribution(cmd.Context(), &types.QueryNextDistributionRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFailuresCmd queries the consecutive fee transfer failures
+func GetFailuresCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "failures",
+		Short: "Get the consecutive fee transfer failures",
+		Long:  "Get the number of consecutive blocks in which the fee transfer failed and the height of the last failure.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Failures(cmd.Context(), &types.QueryFailuresRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetDistributedCmd queries the total amount of fees transferred to a recipient
+func GetDistributedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distributed [recipient]",
+		Short: "Get the total amount of fees transferred to a recipient",
+		Long: `Get the total amount of fees transferred to a recipient per denom. The recipient is
+the bech32 address of an account or module account, or ibc:[channel-id]:[receiver] for an
+account on another chain.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Distributed(cmd.Context(), &types.QueryDistributedRequest{
+				Recipient: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetRecordsCmd queries the past fee distributions
+func GetRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "records",
+		Short: "Get the past fee distributions",
+		Long:  "Get the records of the past fee distributions, with the amounts transferred to each recipient and burned.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Records(cmd.Context(), &types.QueryRecordsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "records")
+	return cmd
+}
+
+// GetFeeCollectorCmd queries the fees collected in the fee collector
+func GetFeeCollectorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-collector",
+		Short: "Get the fees collected in the fee collector",
+		Long:  "Get the balance of the fee collector module account, whose fees are transferred in the next block.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeCollector(cmd.Context(), &types.QueryFeeCollectorRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetPendingPayoutCmd queries the fee transfer of the next block
+func GetPendingPayoutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-payout",
+		Short: "Simulate the fee transfer of the next block",
+		Long:  "Simulate the fee transfer of the next block under the current params, with the amounts transferred to each recipient, burned, escrowed and kept in the fee collector.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingPayout(cmd.Context(), &types.QueryPendingPayoutRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetSimulatePayoutCmd queries the fee transfer of the next block under
+// proposed params
+func GetSimulatePayoutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-payout [params-file-or-json]",
+		Short: "Simulate the fee transfer of the next block under proposed params",
+		Long: `Simulate the fee transfer of the next block under proposed params, e.g. those of a
+MsgUpdateParams proposal, with the amounts transferred to each recipient, burned, escrowed
+and kept in the fee collector.
+
+Example:
+  $ simd query feedistribution simulate-payout params.json
+params.json:
+{
+  "enabled": true,
+  "recipients": [
+    { "address": "cosmos147klh7th5jkjy3aajsj2rqvhtvh9mfde37wq5g", "weight": "3" },
+    { "module": "distribution", "weight": "1" }
+  ],
+  "burn_rate": "0.100000000000000000"
+}
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var paramsBytes []byte
+			input := args[0]
+
+			if _, err := os.Stat(input); err == nil {
+				paramsBytes, err = os.ReadFile(input)
+				if err != nil {
+					return fmt.Errorf("failed to read params file: %w", err)
+				}
+			} else {
+				paramsBytes = []byte(input)
+			}
+
+			var params types.Params
+			if err := clientCtx.Codec.UnmarshalJSON(paramsBytes, &params); err != nil {
+				return fmt.Errorf("failed to parse params JSON: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulatePayout(cmd.Context(), &types.QuerySimulatePayoutRequest{
+				Params: params,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -15,6 +15,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) []ab
 	if err != nil {
 		panic(errorsmod.Wrap(err, "could not set parameters at genesis"))
 	}
+	for _, coin := range data.Burned {
+		k.SetBurned(ctx, coin)
+	}
+	k.SetLastDistribution(ctx, data.LastDistribution)
+	for _, payout := range data.Distributed {
+		k.SetDistributed(ctx, payout.Recipient, payout.Amount)
+	}
+	for _, record := range data.Records {
+		k.SetRecord(ctx, record)
+	}
+	k.SetRetained(ctx, data.Retained)
+	k.SetFailures(ctx, data.Failures)
 
 	return []abci.ValidatorUpdate{}
 }
@@ -22,6 +34,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) []ab
 // ExportGenesis exports genesis state of the feedistribution module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
+		Params:           k.GetParams(ctx),
+		Burned:           k.GetAllBurned(ctx),
+		LastDistribution: k.GetLastDistribution(ctx),
+		Distributed:      k.GetAllDistributed(ctx),
+		Records:          k.GetAllRecords(ctx),
+		Retained:         k.GetRetained(ctx),
+		Failures:         k.GetFailures(ctx),
 	}
 }
@@ -13,5 +13,7 @@ func (k *Keeper) BeginBlock(ctx context.Context) error {
 }
 
 func (k *Keeper) EndBlock(ctx context.Context) error {
+	k.PruneRecords(sdk.UnwrapSDKContext(ctx))
+
 	return nil
 }
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/saga-sdk/x/feedistribution/types"
+)
+
+// BurnFees burns escrowed fees from the module account, which must be
+// registered with the burner permission.
+func (k Keeper) BurnFees(ctx sdk.Context, coins sdk.Coins) error {
+	err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+	if err != nil {
+		return err
+	}
+
+	for _, coin := range coins {
+		k.SetBurned(ctx, coin.AddAmount(k.GetBurned(ctx, coin.Denom)))
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventBurnFees{
+		Amount: coins,
+		Height: ctx.BlockHeight(),
+	})
+}
+
+// GetBurned returns the total amount of denom burned.
+func (k Keeper) GetBurned(ctx sdk.Context, denom string) sdkmath.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBurned)
+
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdkmath.ZeroInt()
+	}
+
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return amount
+}
+
+// SetBurned sets the total amount burned of a denom.
+func (k Keeper) SetBurned(ctx sdk.Context, coin sdk.Coin) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBurned)
+
+	bz, err := coin.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set([]byte(coin.Denom), bz)
+}
+
+// GetAllBurned returns the total amount burned for all the denoms.
+func (k Keeper) GetAllBurned(ctx sdk.Context) sdk.Coins {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBurned).Iterator(nil, nil)
+	defer iterator.Close()
+
+	burned := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		burned = burned.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+
+	return burned
+}
@@ -1,28 +1,352 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+
+	"github.com/sagaxyz/saga-sdk/x/feedistribution/types"
 )
 
-func (k Keeper) GetRecipient(ctx sdk.Context) string {
+func (k Keeper) GetRecipients(ctx sdk.Context) []types.Recipient {
 	params := k.GetParams(ctx)
-	return params.Recipient
+	return params.Recipients
 }
 
+// TransferFees escrows the collected fees in the module account and
+// distributes them once the distribution interval has elapsed. The transfer
+// runs in a cached context so that a failure, e.g. a blocked recipient, leaves
+// the fees in place to be retried in the next block instead of halting the
+// chain.
 func (k Keeper) TransferFees(ctx sdk.Context) {
 	params := k.GetParams(ctx)
 	if !params.Enabled {
 		return
 	}
+
+	cacheCtx, write := ctx.CacheContext()
+	err := k.transferFees(cacheCtx, params)
+	if err != nil {
+		failures := k.GetFailures(ctx)
+		failures.Consecutive++
+		failures.LastHeight = ctx.BlockHeight()
+		k.SetFailures(ctx, failures)
+
+		k.Logger(ctx).Error("failed to transfer fees", "error", err, "consecutive_failures", failures.Consecutive)
+		err = ctx.EventManager().EmitTypedEvent(&types.EventTransferFailed{
+			Error:               err.Error(),
+			ConsecutiveFailures: failures.Consecutive,
+			Height:              ctx.BlockHeight(),
+		})
+		if err != nil {
+			k.Logger(ctx).Error("failed to emit event", "error", err)
+		}
+		return
+	}
+	write()
+
+	failures := k.GetFailures(ctx)
+	if failures.Consecutive > 0 {
+		failures.Consecutive = 0
+		k.SetFailures(ctx, failures)
+	}
+}
+
+func (k Keeper) transferFees(ctx sdk.Context, params types.Params) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("transfer panicked: %v", r)
+		}
+	}()
+
+	err = k.EscrowFees(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	if !k.DistributionDue(ctx, params) {
+		return nil
+	}
+	err = k.DistributeFees(ctx, params)
+	if err != nil {
+		return err
+	}
+	k.SetLastDistribution(ctx, types.LastDistribution{
+		Height: ctx.BlockHeight(),
+		Time:   ctx.BlockTime(),
+	})
+
+	return nil
+}
+
+// EscrowFees moves the collected fees that are going to be distributed from
+// the fee collector to the module account. The fees returned to the fee
+// collector by previous distributions are left out, so that they are not
+// burned again.
+func (k Keeper) EscrowFees(ctx sdk.Context, params types.Params) error {
 	// Since this is called in BeginBlock, collected fees will be from the previous block
 	feeCollector := k.authKeeper.GetModuleAccount(ctx, k.feeCollectorName)
 	feesCollectedInt := k.bankKeeper.GetAllBalances(ctx, feeCollector.GetAddress())
 
-	recipient := sdk.MustAccAddressFromBech32(params.Recipient)
+	// Other modules may have spent the retained fees in the meantime. The
+	// retained fees are only exact if the fee collector is not spent between
+	// a distribution and the next escrow, or is emptied.
+	retained := k.GetRetained(ctx)
+	if available := retained.Min(feesCollectedInt); !available.Equal(retained) {
+		retained = available
+		k.SetRetained(ctx, retained)
+	}
+
+	escrow := params.DistributedFees(feesCollectedInt.Sub(retained...))
+	if escrow.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, escrow)
+}
+
+// DistributeFees burns and forwards the fees escrowed in the module account.
+// Escrowed fees that are not forwarded, because they are only partially burned
+// or not distributed anymore under the current params, are returned to the
+// fee collector and retained there.
+func (k Keeper) DistributeFees(ctx sdk.Context, params types.Params) error {
+	fees := k.PendingFees(ctx)
+	if fees.IsZero() {
+		return nil
+	}
+
+	burn := params.BurnFees(fees)
+	if !burn.IsZero() {
+		if err := k.BurnFees(ctx, burn); err != nil {
+			return err
+		}
+		fees = fees.Sub(burn...)
+	}
+
+	// Transfer the weighted share of the routed fees to each recipient
+	var payouts []types.Payout
+	for _, route := range params.RouteFees(fees) {
+		var rule string
+		if route.Rule != types.DefaultRoute {
+			rule = params.DenomRules[route.Rule].Denom
+		}
+
+		shares := types.SplitFees(route.Fees, route.Recipients)
+		for i, recipient := range route.Recipients {
+			if shares[i].IsZero() {
+				continue
+			}
+
+			err := k.sendFees(ctx, recipient, shares[i])
+			if err != nil {
+				return err
+			}
+			payouts = addPayout(payouts, recipient.Destination(), shares[i])
+
+			err = ctx.EventManager().EmitTypedEvent(&types.EventTransferFees{
+				Recipient: recipient.Destination(),
+				Amount:    shares[i],
+				Height:    ctx.BlockHeight(),
+				Rule:      rule,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		fees = fees.Sub(route.Fees...)
+	}
+	k.recordDistribution(ctx, payouts, burn)
 
-	// Transfer collected fees to the recipient account
-	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, k.feeCollectorName, recipient, feesCollectedInt)
+	if fees.IsZero() {
+		return nil
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 	if err != nil {
-		panic(err)
+		return err
+	}
+	k.SetRetained(ctx, k.GetRetained(ctx).Add(fees...))
+
+	return nil
+}
+
+// sendFees transfers fees from the module account to a recipient.
+func (k Keeper) sendFees(ctx sdk.Context, recipient types.Recipient, fees sdk.Coins) error {
+	switch {
+	case recipient.Module == types.CommunityPoolModule:
+		if k.distrKeeper == nil {
+			return errors.New("cannot fund the community pool without a distribution keeper")
+		}
+		return k.distrKeeper.FundCommunityPool(ctx, fees, k.authKeeper.GetModuleAddress(types.ModuleName))
+	case recipient.Module != "":
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipient.Module, fees)
+	case recipient.Ibc != nil:
+		return k.forwardFees(ctx, *recipient.Ibc, fees)
+	default:
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(recipient.Address), fees)
+	}
+}
+
+// forwardFees sends fees to an account on another chain with an ICS-20
+// transfer per denom. The transfer module refunds the module account if the
+// transfers time out or fail on the other chain.
+func (k Keeper) forwardFees(ctx sdk.Context, recipient types.IBCRecipient, fees sdk.Coins) error {
+	if k.transferKeeper == nil {
+		return errors.New("cannot forward fees to other chains without a transfer keeper")
+	}
+
+	sender := k.authKeeper.GetModuleAddress(types.ModuleName).String()
+	timeout := uint64(ctx.BlockTime().Add(recipient.TransferTimeout()).UnixNano())
+	for _, coin := range fees {
+		msg := transfertypes.NewMsgTransfer(transfertypes.PortID, recipient.ChannelId, coin, sender, recipient.Receiver, clienttypes.ZeroHeight(), timeout, "")
+		if _, err := k.transferKeeper.Transfer(ctx, msg); err != nil {
+			return fmt.Errorf("failed to forward %s over %s: %w", coin, recipient.ChannelId, err)
+		}
+	}
+
+	return nil
+}
+
+// addPayout adds an amount to the payout of a recipient, appending it if the
+// recipient has none yet.
+func addPayout(payouts []types.Payout, recipient string, amount sdk.Coins) []types.Payout {
+	for i := range payouts {
+		if payouts[i].Recipient == recipient {
+			payouts[i].Amount = payouts[i].Amount.Add(amount...)
+			return payouts
+		}
+	}
+
+	return append(payouts, types.Payout{
+		Recipient: recipient,
+		Amount:    amount,
+	})
+}
+
+// PendingFees returns the fees escrowed until the next distribution.
+func (k Keeper) PendingFees(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, k.authKeeper.GetModuleAddress(types.ModuleName))
+}
+
+// DistributionDue returns true if the distribution interval has elapsed since
+// the last distribution.
+func (k Keeper) DistributionDue(ctx sdk.Context, params types.Params) bool {
+	interval := params.DistributionInterval
+	last := k.GetLastDistribution(ctx)
+
+	switch {
+	case interval.Blocks > 0:
+		return ctx.BlockHeight() >= last.Height+int64(interval.Blocks)
+	case interval.Time > 0:
+		return !ctx.BlockTime().Before(last.Time.Add(interval.Time))
+	default:
+		return true
+	}
+}
+
+// GetNextDistribution returns the height of the next distribution for block
+// based intervals, or its earliest time for time based intervals.
+func (k Keeper) GetNextDistribution(ctx sdk.Context, params types.Params) (height int64, t *time.Time) {
+	interval := params.DistributionInterval
+	last := k.GetLastDistribution(ctx)
+
+	switch {
+	case interval.Time > 0:
+		next := last.Time.Add(interval.Time)
+		if next.Before(ctx.BlockTime()) {
+			next = ctx.BlockTime()
+		}
+		return 0, &next
+	case interval.Blocks > 0:
+		return max(last.Height+int64(interval.Blocks), ctx.BlockHeight()+1), nil
+	default:
+		return ctx.BlockHeight() + 1, nil
+	}
+}
+
+// GetLastDistribution returns when the fees were last distributed.
+func (k Keeper) GetLastDistribution(ctx sdk.Context) (last types.LastDistribution) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyLastDistribution)
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &last)
+
+	return
+}
+
+// SetLastDistribution records when the fees were last distributed.
+func (k Keeper) SetLastDistribution(ctx sdk.Context, last types.LastDistribution) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyLastDistribution, k.cdc.MustMarshal(&last))
+}
+
+// GetFailures returns the consecutive failures to transfer the fees.
+func (k Keeper) GetFailures(ctx sdk.Context) (failures types.DistributionFailures) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyFailures)
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &failures)
+
+	return
+}
+
+// SetFailures records the consecutive failures to transfer the fees.
+func (k Keeper) SetFailures(ctx sdk.Context, failures types.DistributionFailures) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyFailures, k.cdc.MustMarshal(&failures))
+}
+
+// GetRetained returns the fees returned to the fee collector that are not
+// escrowed again.
+func (k Keeper) GetRetained(ctx sdk.Context) sdk.Coins {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRetained).Iterator(nil, nil)
+	defer iterator.Close()
+
+	retained := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		retained = retained.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+
+	return retained
+}
+
+// SetRetained replaces the fees retained in the fee collector.
+func (k Keeper) SetRetained(ctx sdk.Context, coins sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRetained)
+
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, coin := range coins {
+		bz, err := coin.Amount.Marshal()
+		if err != nil {
+			panic(err)
+		}
+
+		store.Set([]byte(coin.Denom), bz)
 	}
 }
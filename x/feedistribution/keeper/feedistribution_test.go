@@ -0,0 +1,420 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/sagaxyz/saga-sdk/x/feedistribution/keeper"
+	"github.com/sagaxyz/saga-sdk/x/feedistribution/types"
+)
+
+func (suite *KeeperTestSuite) TestTransferFees() {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	testCases := []struct {
+		name         string
+		params       types.Params
+		fees         sdk.Coins
+		expBalances  map[string]sdk.Coins
+		expRemaining sdk.Coins
+	}{
+		{
+			"disabled",
+			types.NewParams(false, types.NewRecipient(addr1.String(), 1)),
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			map[string]sdk.Coins{},
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		},
+		{
+			"single recipient",
+			types.NewParams(true, types.NewRecipient(addr1.String(), 1)),
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			map[string]sdk.Coins{
+				addr1.String(): sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			},
+			sdk.NewCoins(),
+		},
+		{
+			"weighted recipients with dust",
+			types.NewParams(true, types.NewRecipient(addr1.String(), 2), types.NewRecipient(addr2.String(), 1)),
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("utoken", 1)),
+			map[string]sdk.Coins{
+				addr1.String(): sdk.NewCoins(sdk.NewInt64Coin("stake", 67), sdk.NewInt64Coin("utoken", 1)),
+				addr2.String(): sdk.NewCoins(sdk.NewInt64Coin("stake", 33)),
+			},
+			sdk.NewCoins(),
+		},
+		{
+			"denom rules",
+			types.Params{
+				Enabled:    true,
+				Recipients: []types.Recipient{types.NewRecipient(addr1.String(), 1)},
+				DenomRules: []types.DenomRule{
+					types.NewDenomRule("ibc/*", types.NewRecipient(addr2.String(), 1)),
+					types.NewKeepDenomRule("ukept"),
+				},
+			},
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("ibc/ABCD", 7), sdk.NewInt64Coin("ukept", 3)),
+			map[string]sdk.Coins{
+				addr1.String(): sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+				addr2.String(): sdk.NewCoins(sdk.NewInt64Coin("ibc/ABCD", 7)),
+			},
+			sdk.NewCoins(sdk.NewInt64Coin("ukept", 3)),
+		},
+		{
+			"no fees",
+			types.NewParams(true, types.NewRecipient(addr1.String(), 1)),
+			sdk.NewCoins(),
+			map[string]sdk.Coins{},
+			sdk.NewCoins(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.Require().NoError(suite.keeper.SetParams(suite.ctx, tc.params))
+			suite.fundFeeCollector(tc.fees)
+
+			suite.keeper.TransferFees(suite.ctx)
+
+			for addr, expBalance := range tc.expBalances {
+				suite.Require().Equal(expBalance.String(), suite.balance(sdk.MustAccAddressFromBech32(addr)).String())
+			}
+			suite.Require().Equal(tc.expRemaining.String(), suite.balance(feeCollector).String())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTransferFeesBurn() {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	params := types.NewParams(true, types.NewRecipient(addr1.String(), 1))
+	params.BurnRate = sdkmath.LegacyNewDecWithPrec(25, 2)
+	params.DenomRules = []types.DenomRule{types.NewKeepDenomRule("ukept")}
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	suite.fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("ukept", 10)))
+	suite.keeper.TransferFees(suite.ctx)
+
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 25)), suite.bankKeeper.get(suite.ctx, mockBurnedKey))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 75)), suite.balance(addr1))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ukept", 10)), suite.balance(feeCollector))
+
+	// The total burned accumulates across blocks
+	suite.fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("stake", 40)))
+	suite.keeper.TransferFees(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 35)), suite.keeper.GetAllBurned(suite.ctx))
+
+	res, err := suite.keeper.Burned(suite.ctx, &types.QueryBurnedRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 35)), res.Burned)
+
+	var burnEvents int
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == proto.MessageName(&types.EventBurnFees{}) {
+			burnEvents++
+		}
+	}
+	suite.Require().Equal(2, burnEvents)
+}
+
+func (suite *KeeperTestSuite) TestTransferFeesBurnOnly() {
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	params := types.NewParams(true)
+	params.BurnRate = sdkmath.LegacyNewDecWithPrec(5, 1)
+	params.DistributionInterval = types.DistributionInterval{Blocks: 2}
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	// The unburned fees returned to the fee collector are not burned again in
+	// the following epochs
+	collected := map[int64]int64{1: 1000, 4: 200}
+	for height := int64(1); height <= 10; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		suite.fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("stake", collected[height])))
+		suite.keeper.TransferFees(suite.ctx)
+	}
+
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 600)), suite.keeper.GetAllBurned(suite.ctx))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 600)), suite.balance(feeCollector))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 600)), suite.keeper.GetRetained(suite.ctx))
+
+	// Once another module empties the fee collector, the retained fees are
+	// cleared and the fees collected afterwards are burned
+	suite.Require().NoError(suite.bankKeeper.SendCoinsFromModuleToModule(suite.ctx, authtypes.FeeCollectorName, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("stake", 600))))
+	suite.Require().NoError(suite.bankKeeper.BurnCoins(suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("stake", 600))))
+	suite.ctx = suite.ctx.WithBlockHeight(11)
+	suite.keeper.TransferFees(suite.ctx)
+	suite.Require().True(suite.keeper.GetRetained(suite.ctx).IsZero())
+
+	suite.ctx = suite.ctx.WithBlockHeight(12)
+	suite.fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	suite.keeper.TransferFees(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 650)), suite.keeper.GetAllBurned(suite.ctx))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), suite.keeper.GetRetained(suite.ctx))
+
+	// Updating the params releases the retained fees, which are distributed
+	// to the recipients added
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	params = types.NewParams(true, types.NewRecipient(addr1.String(), 1))
+	_, err := suite.keeper.UpdateParams(suite.ctx, &types.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params:    params,
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(suite.keeper.GetRetained(suite.ctx).IsZero())
+
+	suite.ctx = suite.ctx.WithBlockHeight(13)
+	suite.keeper.TransferFees(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), suite.balance(addr1))
+	suite.Require().True(suite.balance(feeCollector).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestTransferFeesBlockInterval() {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	escrow := authtypes.NewModuleAddress(types.ModuleName)
+
+	params := types.NewParams(true, types.NewRecipient(addr1.String(), 1))
+	params.DistributionInterval = types.DistributionInterval{Blocks: 3}
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	for height := int64(1); height <= 3; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		suite.fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+		suite.keeper.TransferFees(suite.ctx)
+
+		if height < 3 {
+			suite.Require().True(suite.balance(addr1).IsZero())
+			suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 10*height)), suite.balance(escrow))
+
+			res, err := suite.keeper.NextDistribution(suite.ctx, &types.QueryNextDistributionRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Equal(int64(3), res.Height)
+			suite.Require().Nil(res.Time)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 10*height)), res.Pending)
+		}
+	}
+
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), suite.balance(addr1))
+	suite.Require().True(suite.balance(escrow).IsZero())
+	suite.Require().Equal(int64(3), suite.keeper.GetLastDistribution(suite.ctx).Height)
+
+	res, err := suite.keeper.NextDistribution(suite.ctx, &types.QueryNextDistributionRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(6), res.Height)
+}
+
+func (suite *KeeperTestSuite) TestTransferFeesTimeInterval() {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	start := suite.ctx.BlockTime()
+
+	params := types.NewParams(true, types.NewRecipient(addr1.String(), 1))
+	params.DistributionInterval = types.DistributionInterval{Time: time.Hour}
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+	suite.keeper.SetLastDistribution(suite.ctx, types.LastDistribution{Height: 1, Time: start})
+
+	suite.ctx = suite.ctx.WithBlockHeight(2).WithBlockTime(start.Add(30 * time.Minute))
+	suite.fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	suite.keeper.TransferFees(suite.ctx)
+	suite.Require().True(suite.balance(addr1).IsZero())
+
+	res, err := suite.keeper.NextDistribution(suite.ctx, &types.QueryNextDistributionRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Zero(res.Height)
+	suite.Require().NotNil(res.Time)
+	suite.Require().True(start.Add(time.Hour).Equal(*res.Time))
+
+	suite.ctx = suite.ctx.WithBlockHeight(3).WithBlockTime(start.Add(time.Hour))
+	suite.keeper.TransferFees(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), suite.balance(addr1))
+}
+
+func (suite *KeeperTestSuite) TestDistributeFeesReturnsUnrouted() {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	params := types.NewParams(true, types.NewRecipient(addr1.String(), 1))
+	params.DistributionInterval = types.DistributionInterval{Blocks: 10}
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+	suite.fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("ukept", 5)))
+	suite.keeper.TransferFees(suite.ctx)
+
+	// The params change before the end of the epoch to keep ukept
+	params.DenomRules = []types.DenomRule{types.NewKeepDenomRule("ukept")}
+	suite.Require().NoError(suite.keeper.DistributeFees(suite.ctx, params))
+
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), suite.balance(addr1))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ukept", 5)), suite.balance(feeCollector))
+	suite.Require().True(suite.keeper.PendingFees(suite.ctx).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestTransferFeesFailure() {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	escrow := authtypes.NewModuleAddress(types.ModuleName)
+	fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	params := types.NewParams(true, types.NewRecipient(addr1.String(), 1), types.NewRecipient(addr2.String(), 1))
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+	suite.bankKeeper.blocked[addr2.String()] = true
+	suite.fundFeeCollector(fees)
+
+	for height := int64(1); height <= 2; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		suite.Require().NotPanics(func() {
+			suite.keeper.TransferFees(suite.ctx)
+		})
+
+		// Nothing is transferred, not even to the valid recipient
+		suite.Require().Equal(fees, suite.balance(feeCollector))
+		suite.Require().True(suite.balance(escrow).IsZero())
+		suite.Require().True(suite.balance(addr1).IsZero())
+
+		res, err := suite.keeper.Failures(suite.ctx, &types.QueryFailuresRequest{})
+		suite.Require().NoError(err)
+		suite.Require().Equal(types.DistributionFailures{Consecutive: uint64(height), LastHeight: height}, res.Failures)
+	}
+
+	var failedEvents int
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == proto.MessageName(&types.EventTransferFailed{}) {
+			failedEvents++
+		}
+	}
+	suite.Require().Equal(2, failedEvents)
+
+	// The fees are transferred once the recipient is unblocked
+	delete(suite.bankKeeper.blocked, addr2.String())
+	suite.ctx = suite.ctx.WithBlockHeight(3)
+	suite.keeper.TransferFees(suite.ctx)
+
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), suite.balance(addr1))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), suite.balance(addr2))
+	suite.Require().Equal(types.DistributionFailures{Consecutive: 0, LastHeight: 2}, suite.keeper.GetFailures(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestTransferFeesEvents() {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	params := types.NewParams(true, types.NewRecipient(addr1.String(), 1))
+	params.DenomRules = []types.DenomRule{
+		types.NewDenomRule("ibc/*", types.NewRecipient(addr2.String(), 1)),
+	}
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+	suite.fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("ibc/ABCD", 7)))
+
+	suite.ctx = suite.ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+	suite.keeper.TransferFees(suite.ctx)
+
+	var transfers []types.EventTransferFees
+	for _, event := range suite.ctx.EventManager().ABCIEvents() {
+		if event.Type != proto.MessageName(&types.EventTransferFees{}) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(event)
+		suite.Require().NoError(err)
+		transfers = append(transfers, *msg.(*types.EventTransferFees))
+	}
+
+	suite.Require().Equal([]types.EventTransferFees{
+		{Recipient: addr2.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("ibc/ABCD", 7)), Height: 5, Rule: "ibc/*"},
+		{Recipient: addr1.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), Height: 5, Rule: ""},
+	}, transfers)
+}
+
+func (suite *KeeperTestSuite) TestTransferFeesModuleAndIBCRecipients() {
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	ibcRecipient := types.NewIBCRecipient("channel-0", "saga1receiver", 0, 2)
+
+	params := types.NewParams(true,
+		types.NewModuleRecipient("gov", 1),
+		types.NewModuleRecipient(types.CommunityPoolModule, 1),
+		ibcRecipient,
+	)
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+	suite.fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("utoken", 4)))
+
+	suite.keeper.TransferFees(suite.ctx)
+	suite.Require().Zero(suite.keeper.GetFailures(suite.ctx).Consecutive)
+
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 25), sdk.NewInt64Coin("utoken", 1)), suite.balance(authtypes.NewModuleAddress("gov")))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 25), sdk.NewInt64Coin("utoken", 1)), suite.bankKeeper.get(suite.ctx, mockCommunityPoolKey))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50), sdk.NewInt64Coin("utoken", 2)), suite.balance(mockTransferEscrow))
+
+	// One transfer per denom, sent by the module account
+	timeout := uint64(suite.ctx.BlockTime().Add(types.DefaultIBCTimeout).UnixNano())
+	suite.Require().Len(suite.transferKeeper.transfers, 2)
+	for i, denom := range []string{"stake", "utoken"} {
+		transfer := suite.transferKeeper.transfers[i]
+		suite.Require().Equal(denom, transfer.Token.Denom)
+		suite.Require().Equal("transfer", transfer.SourcePort)
+		suite.Require().Equal("channel-0", transfer.SourceChannel)
+		suite.Require().Equal(moduleAddr.String(), transfer.Sender)
+		suite.Require().Equal("saga1receiver", transfer.Receiver)
+		suite.Require().Equal(timeout, transfer.TimeoutTimestamp)
+	}
+
+	res, err := suite.keeper.Distributed(suite.ctx, &types.QueryDistributedRequest{Recipient: ibcRecipient.Destination()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50), sdk.NewInt64Coin("utoken", 2)), res.Distributed)
+
+	res, err = suite.keeper.Distributed(suite.ctx, &types.QueryDistributedRequest{Recipient: authtypes.NewModuleAddress("gov").String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 25), sdk.NewInt64Coin("utoken", 1)), res.Distributed)
+}
+
+func (suite *KeeperTestSuite) TestTransferFeesIBCFailure() {
+	params := types.NewParams(true, types.NewIBCRecipient("channel-0", "saga1receiver", time.Hour, 1))
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+	suite.fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	suite.transferKeeper.err = errors.New("channel closed")
+
+	suite.keeper.TransferFees(suite.ctx)
+	suite.Require().Equal(uint64(1), suite.keeper.GetFailures(suite.ctx).Consecutive)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), suite.balance(authtypes.NewModuleAddress(authtypes.FeeCollectorName)))
+
+	suite.transferKeeper.err = nil
+	suite.keeper.TransferFees(suite.ctx)
+	suite.Require().Zero(suite.keeper.GetFailures(suite.ctx).Consecutive)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), suite.balance(mockTransferEscrow))
+	suite.Require().Equal(uint64(suite.ctx.BlockTime().Add(time.Hour).UnixNano()), suite.transferKeeper.transfers[0].TimeoutTimestamp)
+}
+
+func (suite *KeeperTestSuite) TestSetParamsIBCRecipients() {
+	ibcRecipient := types.NewIBCRecipient("channel-0", "saga1receiver", 0, 1)
+	params := types.NewParams(true, types.NewRecipient(sdk.AccAddress([]byte("addr1_______________")).String(), 1))
+	params.DenomRules = []types.DenomRule{{Denom: "ibc/*", Recipients: []types.Recipient{ibcRecipient}}}
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	// ICS-20 transfers reject blocked senders
+	suite.bankKeeper.blocked[authtypes.NewModuleAddress(types.ModuleName).String()] = true
+	suite.Require().ErrorContains(suite.keeper.SetParams(suite.ctx, params), "blocked")
+	suite.Require().ErrorContains(suite.keeper.SetParams(suite.ctx, types.NewParams(true, ibcRecipient)), "blocked")
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, types.DefaultParams()))
+
+	withoutTransfer := keeper.New(
+		suite.encCfg.Codec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		storetypes.NewKVStoreKey(types.StoreKey),
+		mockAccountKeeper{},
+		newMockBankKeeper(storetypes.NewKVStoreKey("mockbank")),
+		suite.distrKeeper,
+		nil,
+		authtypes.FeeCollectorName,
+	)
+	suite.Require().ErrorContains(withoutTransfer.SetParams(suite.ctx, params), "without a transfer keeper")
+}
@@ -3,7 +3,11 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/sagaxyz/saga-sdk/x/feedistribution/types"
 )
@@ -19,3 +23,116 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: params,
 	}, nil
 }
+
+// Burned implements the Query/Burned gRPC method
+func (k Keeper) Burned(c context.Context, _ *types.QueryBurnedRequest) (*types.QueryBurnedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBurnedResponse{
+		Burned: k.GetAllBurned(ctx),
+	}, nil
+}
+
+// NextDistribution implements the Query/NextDistribution gRPC method
+func (k Keeper) NextDistribution(c context.Context, _ *types.QueryNextDistributionRequest) (*types.QueryNextDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	height, t := k.GetNextDistribution(ctx, k.GetParams(ctx))
+
+	return &types.QueryNextDistributionResponse{
+		Height:  height,
+		Time:    t,
+		Pending: k.PendingFees(ctx),
+	}, nil
+}
+
+// Failures implements the Query/Failures gRPC method
+func (k Keeper) Failures(c context.Context, _ *types.QueryFailuresRequest) (*types.QueryFailuresResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryFailuresResponse{
+		Failures: k.GetFailures(ctx),
+	}, nil
+}
+
+// Distributed implements the Query/Distributed gRPC method
+func (k Keeper) Distributed(c context.Context, req *types.QueryDistributedRequest) (*types.QueryDistributedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateDestination(req.Recipient); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDistributedResponse{
+		Distributed: k.GetDistributed(ctx, req.Recipient),
+	}, nil
+}
+
+// Records implements the Query/Records gRPC method
+func (k Keeper) Records(c context.Context, req *types.QueryRecordsRequest) (*types.QueryRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecords)
+
+	var records []types.DistributionRecord
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var record types.DistributionRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecordsResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}, nil
+}
+
+// FeeCollector implements the Query/FeeCollector gRPC method
+func (k Keeper) FeeCollector(c context.Context, _ *types.QueryFeeCollectorRequest) (*types.QueryFeeCollectorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	feeCollector := k.authKeeper.GetModuleAccount(ctx, k.feeCollectorName)
+
+	return &types.QueryFeeCollectorResponse{
+		Balance: k.bankKeeper.GetAllBalances(ctx, feeCollector.GetAddress()),
+	}, nil
+}
+
+// PendingPayout implements the Query/PendingPayout gRPC method
+func (k Keeper) PendingPayout(c context.Context, _ *types.QueryPendingPayoutRequest) (*types.QueryPendingPayoutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPendingPayoutResponse{
+		Simulation: k.SimulateTransferFees(ctx, k.GetParams(ctx)),
+	}, nil
+}
+
+// SimulatePayout implements the Query/SimulatePayout gRPC method
+func (k Keeper) SimulatePayout(c context.Context, req *types.QuerySimulatePayoutRequest) (*types.QuerySimulatePayoutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := req.Params.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := k.checkIBCRecipients(req.Params); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QuerySimulatePayoutResponse{
+		Simulation: k.SimulateTransferFees(ctx, req.Params),
+	}, nil
+}
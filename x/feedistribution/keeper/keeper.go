@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,18 +20,29 @@ type Keeper struct {
 
 	authKeeper types.AccountKeeper
 	bankKeeper types.BankKeeper
+	// Optional keepers of the module and IBC recipients
+	distrKeeper    types.DistributionKeeper
+	transferKeeper types.TransferKeeper
 
 	// Name of the FeeCollector ModuleAccount
 	feeCollectorName string
 }
 
-// New generates a new feedistribution module keeper
+// New generates a new feedistribution module keeper. The distribution and
+// transfer keepers are optional, without them fees cannot be sent to the
+// community pool or to other chains. The fees are escrowed in the module
+// account, which must be registered in the app module account permissions.
 func New(cdc codec.BinaryCodec, authority sdk.AccAddress, storeKey storetypes.StoreKey,
-	ak types.AccountKeeper, bk types.BankKeeper, feeCollectorName string) Keeper {
+	ak types.AccountKeeper, bk types.BankKeeper, dk types.DistributionKeeper, tk types.TransferKeeper,
+	feeCollectorName string) Keeper {
 	// Ensure authority account is correctly formatted
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
 		panic(err)
 	}
+	// Ensure the module account is registered, distributions fail without it
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the %s module account has not been set", types.ModuleName))
+	}
 
 	return Keeper{
 		cdc:              cdc,
@@ -37,6 +50,8 @@ func New(cdc codec.BinaryCodec, authority sdk.AccAddress, storeKey storetypes.St
 		authority:        authority,
 		authKeeper:       ak,
 		bankKeeper:       bk,
+		distrKeeper:      dk,
+		transferKeeper:   tk,
 		feeCollectorName: feeCollectorName,
 	}
 }
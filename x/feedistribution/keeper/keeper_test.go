@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/sagaxyz/saga-sdk/x/feedistribution"
+	"github.com/sagaxyz/saga-sdk/x/feedistribution/keeper"
+	"github.com/sagaxyz/saga-sdk/x/feedistribution/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx            sdk.Context
+	keeper         keeper.Keeper
+	bankKeeper     *mockBankKeeper
+	distrKeeper    *mockDistributionKeeper
+	transferKeeper *mockTransferKeeper
+	encCfg         moduletestutil.TestEncodingConfig
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	bankKey := storetypes.NewKVStoreKey("mockbank")
+	ctx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{
+			types.StoreKey: key,
+			"mockbank":     bankKey,
+		},
+		nil,
+		nil)
+	suite.ctx = ctx.WithBlockHeader(tmproto.Header{Height: 1, Time: tmtime.Now()})
+	suite.encCfg = moduletestutil.MakeTestEncodingConfig(feedistribution.AppModuleBasic{})
+
+	suite.bankKeeper = newMockBankKeeper(bankKey)
+	suite.distrKeeper = &mockDistributionKeeper{bankKeeper: suite.bankKeeper}
+	suite.transferKeeper = &mockTransferKeeper{bankKeeper: suite.bankKeeper}
+	suite.keeper = keeper.New(
+		suite.encCfg.Codec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		key,
+		mockAccountKeeper{},
+		suite.bankKeeper,
+		suite.distrKeeper,
+		suite.transferKeeper,
+		authtypes.FeeCollectorName,
+	)
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, types.DefaultParams()))
+}
+
+func (suite *KeeperTestSuite) TestNewUnregisteredModuleAccount() {
+	suite.Require().Panics(func() {
+		keeper.New(
+			suite.encCfg.Codec,
+			authtypes.NewModuleAddress(govtypes.ModuleName),
+			storetypes.NewKVStoreKey(types.StoreKey),
+			mockAccountKeeper{unregistered: types.ModuleName},
+			suite.bankKeeper,
+			nil,
+			nil,
+			authtypes.FeeCollectorName,
+		)
+	})
+}
+
+// fundFeeCollector mints coins directly into the fee collector module account.
+func (suite *KeeperTestSuite) fundFeeCollector(coins sdk.Coins) {
+	addr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	suite.bankKeeper.set(suite.ctx, addr, suite.balance(addr).Add(coins...))
+}
+
+// balance returns the balance of an account in the mock bank.
+func (suite *KeeperTestSuite) balance(addr sdk.AccAddress) sdk.Coins {
+	return suite.bankKeeper.GetAllBalances(suite.ctx, addr)
+}
+
+// mockAccountKeeper registers every module account but the unregistered one.
+type mockAccountKeeper struct {
+	unregistered string
+}
+
+func (k mockAccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	if name == k.unregistered {
+		return nil
+	}
+	return authtypes.NewModuleAddress(name)
+}
+
+func (mockAccountKeeper) GetModuleAccount(_ context.Context, name string) sdk.ModuleAccountI {
+	return authtypes.NewEmptyModuleAccount(name)
+}
+
+// mockBankKeeper keeps the balances in its own store so that they follow the
+// cache semantics of the context like the real bank keeper.
+type mockBankKeeper struct {
+	storeKey storetypes.StoreKey
+	blocked  map[string]bool
+}
+
+func newMockBankKeeper(storeKey storetypes.StoreKey) *mockBankKeeper {
+	return &mockBankKeeper{
+		storeKey: storeKey,
+		blocked:  make(map[string]bool),
+	}
+}
+
+var mockBurnedKey = []byte("burned")
+
+func (bk *mockBankKeeper) get(ctx context.Context, key []byte) sdk.Coins {
+	bz := sdk.UnwrapSDKContext(ctx).KVStore(bk.storeKey).Get(key)
+	coins, err := sdk.ParseCoinsNormalized(string(bz))
+	if err != nil {
+		panic(err)
+	}
+
+	return coins
+}
+
+func (bk *mockBankKeeper) set(ctx context.Context, key []byte, coins sdk.Coins) {
+	sdk.UnwrapSDKContext(ctx).KVStore(bk.storeKey).Set(key, []byte(coins.String()))
+}
+
+func (bk *mockBankKeeper) GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	return bk.get(ctx, addr)
+}
+
+func (bk *mockBankKeeper) send(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	if bk.blocked[to.String()] {
+		return fmt.Errorf("%s is not allowed to receive funds", to)
+	}
+	balance, negative := bk.get(ctx, from).SafeSub(amt...)
+	if negative {
+		return fmt.Errorf("insufficient funds: %s < %s", bk.get(ctx, from), amt)
+	}
+	bk.set(ctx, from, balance)
+	bk.set(ctx, to, bk.get(ctx, to).Add(amt...))
+
+	return nil
+}
+
+func (bk *mockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule string, recipientModule string, amt sdk.Coins) error {
+	return bk.send(ctx, authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (bk *mockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return bk.send(ctx, authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (bk *mockBankKeeper) BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName)
+	balance, negative := bk.get(ctx, addr).SafeSub(amt...)
+	if negative {
+		return fmt.Errorf("insufficient funds: %s < %s", bk.get(ctx, addr), amt)
+	}
+	bk.set(ctx, addr, balance)
+	bk.set(ctx, mockBurnedKey, bk.get(ctx, mockBurnedKey).Add(amt...))
+
+	return nil
+}
+
+func (bk *mockBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return bk.blocked[addr.String()]
+}
+
+// mockDistributionKeeper funds the community pool from the mock bank.
+type mockDistributionKeeper struct {
+	bankKeeper *mockBankKeeper
+}
+
+var mockCommunityPoolKey = []byte("communitypool")
+
+func (dk *mockDistributionKeeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	err := dk.bankKeeper.send(ctx, sender, authtypes.NewModuleAddress(distrtypes.ModuleName), amount)
+	if err != nil {
+		return err
+	}
+	dk.bankKeeper.set(ctx, mockCommunityPoolKey, dk.bankKeeper.get(ctx, mockCommunityPoolKey).Add(amount...))
+
+	return nil
+}
+
+// mockTransferKeeper escrows the transferred tokens and records the transfers.
+type mockTransferKeeper struct {
+	bankKeeper *mockBankKeeper
+	transfers  []transfertypes.MsgTransfer
+	err        error
+}
+
+var mockTransferEscrow = authtypes.NewModuleAddress(transfertypes.ModuleName)
+
+func (tk *mockTransferKeeper) Transfer(ctx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+	if tk.err != nil {
+		return nil, tk.err
+	}
+	err := tk.bankKeeper.send(ctx, sdk.MustAccAddressFromBech32(msg.Sender), mockTransferEscrow, sdk.NewCoins(msg.Token))
+	if err != nil {
+		return nil, err
+	}
+	tk.transfers = append(tk.transfers, *msg)
+
+	return &transfertypes.MsgTransferResponse{}, nil
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/saga-sdk/x/feedistribution/types"
+)
+
+// GetDistributed returns the total amount of fees transferred to the
+// destination of a recipient.
+func (k Keeper) GetDistributed(ctx sdk.Context, destination string) sdk.Coins {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.DistributedKeyPrefix(destination)).Iterator(nil, nil)
+	defer iterator.Close()
+
+	distributed := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		distributed = distributed.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+
+	return distributed
+}
+
+// SetDistributed sets the total amount of fees transferred to the destination
+// of a recipient.
+func (k Keeper) SetDistributed(ctx sdk.Context, destination string, coins sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DistributedKeyPrefix(destination))
+
+	for _, coin := range coins {
+		bz, err := coin.Amount.Marshal()
+		if err != nil {
+			panic(err)
+		}
+
+		store.Set([]byte(coin.Denom), bz)
+	}
+}
+
+// GetAllDistributed returns the total amount of fees transferred to every
+// recipient.
+func (k Keeper) GetAllDistributed(ctx sdk.Context) (payouts []types.Payout) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDistributed).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		recipient := string(key[1 : 1+key[0]])
+		denom := string(key[1+key[0]:])
+
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		// Keys of the same recipient are contiguous
+		if len(payouts) == 0 || payouts[len(payouts)-1].Recipient != recipient {
+			payouts = append(payouts, types.Payout{Recipient: recipient})
+		}
+		last := &payouts[len(payouts)-1]
+		last.Amount = last.Amount.Add(sdk.NewCoin(denom, amount))
+	}
+
+	return payouts
+}
+
+// GetRecord returns the distribution record at height.
+func (k Keeper) GetRecord(ctx sdk.Context, height int64) (record types.DistributionRecord, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecords)
+
+	bz := store.Get(types.RecordKey(height))
+	if bz == nil {
+		return record, false
+	}
+	k.cdc.MustUnmarshal(bz, &record)
+
+	return record, true
+}
+
+// SetRecord stores a distribution record.
+func (k Keeper) SetRecord(ctx sdk.Context, record types.DistributionRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecords)
+	store.Set(types.RecordKey(record.Height), k.cdc.MustMarshal(&record))
+}
+
+// GetAllRecords returns all the distribution records ordered by height.
+func (k Keeper) GetAllRecords(ctx sdk.Context) (records []types.DistributionRecord) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecords).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.DistributionRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// PruneRecords deletes the distribution records older than the record
+// retention.
+func (k Keeper) PruneRecords(ctx sdk.Context) {
+	retention := k.GetParams(ctx).Retention()
+	if uint64(ctx.BlockHeight()) < retention {
+		return
+	}
+	end := ctx.BlockHeight() - int64(retention) + 1
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecords)
+	iterator := store.Iterator(nil, types.RecordKey(end))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// recordDistribution stores the record of a distribution and adds its payouts
+// to the totals of the recipients.
+func (k Keeper) recordDistribution(ctx sdk.Context, payouts []types.Payout, burned sdk.Coins) {
+	for _, payout := range payouts {
+		k.SetDistributed(ctx, payout.Recipient, k.GetDistributed(ctx, payout.Recipient).Add(payout.Amount...))
+	}
+
+	k.SetRecord(ctx, types.DistributionRecord{
+		Height:  ctx.BlockHeight(),
+		Time:    ctx.BlockTime(),
+		Payouts: payouts,
+		Burned:  burned,
+	})
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/sagaxyz/saga-sdk/x/feedistribution"
+	"github.com/sagaxyz/saga-sdk/x/feedistribution/types"
+)
+
+func (suite *KeeperTestSuite) TestLedger() {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	params := types.NewParams(true, types.NewRecipient(addr1.String(), 1))
+	params.DenomRules = []types.DenomRule{
+		types.NewDenomRule("ibc/*", types.NewRecipient(addr1.String(), 1), types.NewRecipient(addr2.String(), 1)),
+	}
+	params.BurnRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	for height := int64(1); height <= 3; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		suite.fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("ibc/ABCD", 20)))
+		suite.keeper.TransferFees(suite.ctx)
+	}
+
+	res, err := suite.keeper.Distributed(suite.ctx, &types.QueryDistributedRequest{Recipient: addr1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 270), sdk.NewInt64Coin("ibc/ABCD", 27)), res.Distributed)
+
+	res, err = suite.keeper.Distributed(suite.ctx, &types.QueryDistributedRequest{Recipient: addr2.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("ibc/ABCD", 27)), res.Distributed)
+
+	_, err = suite.keeper.Distributed(suite.ctx, &types.QueryDistributedRequest{Recipient: "invalid"})
+	suite.Require().Error(err)
+
+	// A single recipient of several routes gets a single payout per record
+	record, found := suite.keeper.GetRecord(suite.ctx, 2)
+	suite.Require().True(found)
+	suite.Require().Equal([]types.Payout{
+		{Recipient: addr1.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 90), sdk.NewInt64Coin("ibc/ABCD", 9))},
+		{Recipient: addr2.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("ibc/ABCD", 9))},
+	}, record.Payouts)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("ibc/ABCD", 2)), record.Burned)
+
+	page, err := suite.keeper.Records(suite.ctx, &types.QueryRecordsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(page.Records, 2)
+	suite.Require().Equal(int64(1), page.Records[0].Height)
+	suite.Require().Equal(uint64(3), page.Pagination.Total)
+
+	page, err = suite.keeper.Records(suite.ctx, &types.QueryRecordsRequest{
+		Pagination: &query.PageRequest{Key: page.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(page.Records, 1)
+	suite.Require().Equal(int64(3), page.Records[0].Height)
+}
+
+func (suite *KeeperTestSuite) TestPruneRecords() {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+
+	params := types.NewParams(true, types.NewRecipient(addr1.String(), 1))
+	params.RecordRetention = 2
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	for height := int64(1); height <= 5; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		suite.fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+		suite.keeper.TransferFees(suite.ctx)
+		suite.Require().NoError(suite.keeper.EndBlock(suite.ctx))
+	}
+
+	records := suite.keeper.GetAllRecords(suite.ctx)
+	suite.Require().Len(records, 2)
+	suite.Require().Equal(int64(4), records[0].Height)
+	suite.Require().Equal(int64(5), records[1].Height)
+	// The totals are not pruned
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), suite.keeper.GetDistributed(suite.ctx, addr1.String()))
+
+	// The records are kept for the default retention without retention
+	params.RecordRetention = 0
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+	suite.ctx = suite.ctx.WithBlockHeight(6)
+	suite.fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	suite.keeper.TransferFees(suite.ctx)
+	suite.Require().NoError(suite.keeper.EndBlock(suite.ctx))
+	suite.Require().Len(suite.keeper.GetAllRecords(suite.ctx), 3)
+}
+
+func (suite *KeeperTestSuite) TestLedgerGenesis() {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+
+	params := types.NewParams(true, types.NewRecipient(addr1.String(), 1))
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+	suite.fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	suite.keeper.TransferFees(suite.ctx)
+	failures := types.DistributionFailures{Consecutive: 3, LastHeight: 5}
+	suite.keeper.SetFailures(suite.ctx, failures)
+
+	genesis := feedistribution.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Len(genesis.Records, 1)
+	suite.Require().Equal(failures, genesis.Failures)
+	suite.Require().Equal([]types.Payout{
+		{Recipient: addr1.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+	}, genesis.Distributed)
+
+	suite.SetupTest()
+	feedistribution.InitGenesis(suite.ctx, suite.keeper, *genesis)
+	suite.Require().Equal(genesis, feedistribution.ExportGenesis(suite.ctx, suite.keeper))
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/saga-sdk/x/feedistribution/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2 by converting
+// the single recipient into a recipients list where it receives all the fees.
+// No fees are burned after the migration and they keep being distributed
+// every block. The distribution records are kept for the default retention.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	if params.BurnRate.IsNil() {
+		params.BurnRate = sdkmath.LegacyZeroDec()
+	}
+	params.RecordRetention = types.DefaultRecordRetention
+
+	//nolint:staticcheck // the deprecated field is read only to be migrated
+	if params.Recipient != "" {
+		params.Recipients = []types.Recipient{
+			types.NewRecipient(params.Recipient, 1),
+		}
+		params.Recipient = ""
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	return m.keeper.SetParams(ctx, params)
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/saga-sdk/x/feedistribution/keeper"
+	"github.com/sagaxyz/saga-sdk/x/feedistribution/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	recipient := sdk.AccAddress([]byte("addr1_______________")).String()
+
+	testCases := []struct {
+		name      string
+		params    types.Params
+		expParams types.Params
+		expErr    bool
+	}{
+		{
+			"single recipient",
+			types.Params{Enabled: true, Recipient: recipient},
+			types.NewParams(true, types.NewRecipient(recipient, 1)),
+			false,
+		},
+		{
+			"disabled without recipient",
+			types.Params{},
+			types.DefaultParams(),
+			false,
+		},
+		{
+			"invalid recipient",
+			types.Params{Enabled: true, Recipient: "invalid"},
+			types.Params{},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.Require().NoError(suite.keeper.SetParams(suite.ctx, tc.params))
+
+			err := keeper.NewMigrator(suite.keeper).Migrate1to2(suite.ctx)
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expParams, suite.keeper.GetParams(suite.ctx))
+		})
+	}
+}
@@ -11,6 +11,8 @@ import (
 
 // UpdateParams updates the module parameters.
 // The update can only be performed if the requested authority is correct.
+// The fees retained in the fee collector are released, so that they are
+// escrowed and distributed again under the new params.
 func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.authority.String() != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
@@ -20,6 +22,7 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
+	k.SetRetained(ctx, sdk.NewCoins())
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"fmt"
+	"slices"
+
 	"github.com/sagaxyz/saga-sdk/x/feedistribution/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,8 +19,13 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return
 }
 
-// SetParams sets the feedistribution params.
+// SetParams sets the feedistribution params. IBC recipients are rejected if
+// the fees cannot be forwarded to them.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := k.checkIBCRecipients(params); err != nil {
+		return err
+	}
+
 	store := ctx.KVStore(k.storeKey)
 
 	bz, err := k.cdc.Marshal(&params)
@@ -29,3 +37,28 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 
 	return nil
 }
+
+// checkIBCRecipients returns an error if params has IBC recipients while the
+// fees cannot be forwarded: without a transfer keeper, or if the module
+// account is a blocked address, which ICS-20 transfers reject as sender. Apps
+// forwarding fees must not block the feedistribution module account.
+func (k Keeper) checkIBCRecipients(params types.Params) error {
+	recipients := slices.Clone(params.Recipients)
+	for _, rule := range params.DenomRules {
+		recipients = append(recipients, rule.Recipients...)
+	}
+
+	for _, recipient := range recipients {
+		if recipient.Ibc == nil {
+			continue
+		}
+		if k.transferKeeper == nil {
+			return fmt.Errorf("cannot forward fees to %s without a transfer keeper", recipient.Destination())
+		}
+		if k.bankKeeper.BlockedAddr(k.authKeeper.GetModuleAddress(types.ModuleName)) {
+			return fmt.Errorf("cannot forward fees to %s, the %s module account is a blocked address", recipient.Destination(), types.ModuleName)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sagaxyz/saga-sdk/x/feedistribution/types"
+)
+
+// SimulateTransferFees simulates the fee transfer of the next block under
+// params. The transfer runs the same code as in BeginBlock in a cached context
+// that is discarded, at the next height and the current block time.
+func (k Keeper) SimulateTransferFees(ctx sdk.Context, params types.Params) types.PayoutSimulation {
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	simulation := types.PayoutSimulation{
+		Height: cacheCtx.BlockHeight(),
+	}
+	if params.Enabled {
+		simulation.Due = k.DistributionDue(cacheCtx, params)
+
+		err := k.transferFees(cacheCtx, params)
+		if err != nil {
+			// The fees are left in place as in TransferFees
+			simulation.Due = false
+			simulation.Error = err.Error()
+			cacheCtx = ctx
+		} else if record, found := k.GetRecord(cacheCtx, simulation.Height); found && simulation.Due {
+			simulation.Payouts = record.Payouts
+			simulation.Burned = record.Burned
+		}
+	}
+
+	feeCollector := k.authKeeper.GetModuleAccount(cacheCtx, k.feeCollectorName)
+	simulation.Kept = k.bankKeeper.GetAllBalances(cacheCtx, feeCollector.GetAddress())
+	simulation.Escrowed = k.PendingFees(cacheCtx)
+
+	return simulation
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/sagaxyz/saga-sdk/x/feedistribution/types"
+)
+
+func (suite *KeeperTestSuite) TestPendingPayout() {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	params := types.NewParams(true, types.NewRecipient(addr1.String(), 1))
+	params.DenomRules = []types.DenomRule{types.NewKeepDenomRule("ukept")}
+	params.BurnRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+	fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("ukept", 3))
+	suite.fundFeeCollector(fees)
+
+	balance, err := suite.keeper.FeeCollector(suite.ctx, &types.QueryFeeCollectorRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(fees, balance.Balance)
+
+	res, err := suite.keeper.PendingPayout(suite.ctx, &types.QueryPendingPayoutRequest{})
+	suite.Require().NoError(err)
+	suite.requireSimulation(types.PayoutSimulation{
+		Height: suite.ctx.BlockHeight() + 1,
+		Due:    true,
+		Payouts: []types.Payout{
+			{Recipient: addr1.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 90))},
+		},
+		Burned:   sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		Escrowed: sdk.NewCoins(),
+		Kept:     sdk.NewCoins(sdk.NewInt64Coin("ukept", 3)),
+	}, res.Simulation)
+
+	// The simulation does not change the state
+	suite.Require().Equal(fees, suite.balance(feeCollector))
+	suite.Require().True(suite.balance(addr1).IsZero())
+	suite.Require().True(suite.keeper.GetAllBurned(suite.ctx).IsZero())
+	suite.Require().Empty(suite.keeper.GetAllRecords(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestSimulatePayout() {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, types.NewParams(true, types.NewRecipient(addr1.String(), 1))))
+
+	testCases := []struct {
+		name          string
+		params        types.Params
+		expSimulation types.PayoutSimulation
+		expErr        bool
+	}{
+		{
+			"proposed recipients",
+			types.NewParams(true, types.NewRecipient(addr1.String(), 1), types.NewRecipient(addr2.String(), 3)),
+			types.PayoutSimulation{
+				Due: true,
+				Payouts: []types.Payout{
+					{Recipient: addr1.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 25))},
+					{Recipient: addr2.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 75))},
+				},
+				Burned:   sdk.NewCoins(),
+				Escrowed: sdk.NewCoins(),
+				Kept:     sdk.NewCoins(),
+			},
+			false,
+		},
+		{
+			"disabled",
+			types.NewParams(false),
+			types.PayoutSimulation{
+				Escrowed: sdk.NewCoins(),
+				Kept:     fees,
+			},
+			false,
+		},
+		{
+			"distribution not due",
+			types.Params{
+				Enabled:              true,
+				Recipients:           []types.Recipient{types.NewRecipient(addr1.String(), 1)},
+				BurnRate:             sdkmath.LegacyZeroDec(),
+				DistributionInterval: types.DistributionInterval{Blocks: 10},
+			},
+			types.PayoutSimulation{
+				Escrowed: fees,
+				Kept:     sdk.NewCoins(),
+			},
+			false,
+		},
+		{
+			"failing transfer",
+			types.NewParams(true, types.NewIBCRecipient("channel-0", "saga1receiver", 0, 1)),
+			types.PayoutSimulation{
+				Escrowed: sdk.NewCoins(),
+				Kept:     fees,
+				Error:    "failed to forward 100stake over channel-0: channel closed",
+			},
+			false,
+		},
+		{
+			"invalid params",
+			types.NewParams(true, types.NewRecipient("invalid", 1)),
+			types.PayoutSimulation{},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.ctx = suite.ctx.WithBlockHeight(5)
+			suite.keeper.SetLastDistribution(suite.ctx, types.LastDistribution{Height: 4})
+			suite.fundFeeCollector(fees)
+			suite.transferKeeper.err = errors.New("channel closed")
+
+			res, err := suite.keeper.SimulatePayout(suite.ctx, &types.QuerySimulatePayoutRequest{Params: tc.params})
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			tc.expSimulation.Height = 6
+			suite.requireSimulation(tc.expSimulation, res.Simulation)
+			suite.Require().Equal(fees, suite.balance(authtypes.NewModuleAddress(authtypes.FeeCollectorName)))
+		})
+	}
+
+	// Params rejected by SetParams are not simulated
+	suite.bankKeeper.blocked[authtypes.NewModuleAddress(types.ModuleName).String()] = true
+	_, err := suite.keeper.SimulatePayout(suite.ctx, &types.QuerySimulatePayoutRequest{
+		Params: types.NewParams(true, types.NewIBCRecipient("channel-0", "saga1receiver", 0, 1)),
+	})
+	suite.Require().ErrorContains(err, "blocked")
+}
+
+// requireSimulation asserts that two payout simulations are equal, regardless
+// of empty coins being nil.
+func (suite *KeeperTestSuite) requireSimulation(expected, actual types.PayoutSimulation) {
+	suite.Require().Equal(expected.Height, actual.Height)
+	suite.Require().Equal(expected.Due, actual.Due)
+	suite.Require().Equal(expected.Payouts, actual.Payouts)
+	suite.Require().True(expected.Burned.Equal(actual.Burned), "burned %s != %s", expected.Burned, actual.Burned)
+	suite.Require().True(expected.Escrowed.Equal(actual.Escrowed), "escrowed %s != %s", expected.Escrowed, actual.Escrowed)
+	suite.Require().True(expected.Kept.Equal(actual.Kept), "kept %s != %s", expected.Kept, actual.Kept)
+	suite.Require().Equal(expected.Error, actual.Error)
+}
@@ -4,18 +4,26 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,8 +33,29 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the set of params for the feedistribution module.
 type Params struct {
-	Enabled   bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Deprecated: recipient is the single recipient of consensus version 1. It
+	// is converted into recipients by the store migration and must be left
+	// empty.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"` // Deprecated: Do not use.
+	// recipients receive the collected fees, split proportionally to their
+	// weights. They apply to the denoms not matched by any of the denom_rules.
+	Recipients []Recipient `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients"`
+	// denom_rules route the collected fees of specific denoms. The first rule
+	// matching a denom is applied.
+	DenomRules []DenomRule `protobuf:"bytes,4,rep,name=denom_rules,json=denomRules,proto3" json:"denom_rules"`
+	// burn_rate is the fraction of the collected fees burned before the rest is
+	// distributed. Fees kept in the fee collector by a denom rule are not
+	// burned.
+	BurnRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=burn_rate,json=burnRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn_rate"`
+	// distribution_interval is the interval between two distributions of the
+	// collected fees, which are escrowed in the module account meanwhile. The
+	// fees are distributed every block when it is empty.
+	DistributionInterval DistributionInterval `protobuf:"bytes,6,opt,name=distribution_interval,json=distributionInterval,proto3" json:"distribution_interval"`
+	// record_retention is the number of blocks the distribution records are
+	// kept for. Older records are pruned at the end of each block. The default
+	// retention applies when it is zero.
+	RecordRetention uint64 `protobuf:"varint,7,opt,name=record_retention,json=recordRetention,proto3" json:"record_retention,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,6 +98,7 @@ func (m *Params) GetEnabled() bool {
 	return false
 }
 
+// Deprecated: Do not use.
 func (m *Params) GetRecipient() string {
 	if m != nil {
 		return m.Recipient
@@ -76,132 +106,3185 @@ func (m *Params) GetRecipient() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "saga.feedistribution.v1.Params")
+func (m *Params) GetRecipients() []Recipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("saga/feedistribution/v1/feedistribution.proto", fileDescriptor_f4f21d4c303d841e)
+func (m *Params) GetDenomRules() []DenomRule {
+	if m != nil {
+		return m.DenomRules
+	}
+	return nil
 }
 
-var fileDescriptor_f4f21d4c303d841e = []byte{
-	// 201 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2d, 0x4e, 0x4c, 0x4f,
-	0xd4, 0x4f, 0x4b, 0x4d, 0x4d, 0xc9, 0x2c, 0x2e, 0x29, 0xca, 0x4c, 0x2a, 0x2d, 0xc9, 0xcc, 0xcf,
-	0xd3, 0x2f, 0x33, 0x44, 0x17, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x07, 0x29, 0xd7,
-	0x43, 0x97, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20,
-	0xca, 0x95, 0x1c, 0xb8, 0xd8, 0x02, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0x85, 0x24, 0xb8, 0xd8, 0x53,
-	0xf3, 0x12, 0x93, 0x72, 0x52, 0x53, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x82, 0x60, 0x5c, 0x21,
-	0x19, 0x2e, 0xce, 0xa2, 0xd4, 0xe4, 0xcc, 0x82, 0xcc, 0xd4, 0xbc, 0x12, 0x09, 0x26, 0x05, 0x46,
-	0x0d, 0xce, 0x20, 0x84, 0x80, 0x53, 0xe0, 0x8a, 0x47, 0x72, 0x8c, 0x27, 0x1e, 0xc9, 0x31, 0x5e,
-	0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31,
-	0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x9c, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f,
-	0xab, 0x0f, 0x72, 0x59, 0x45, 0x65, 0x15, 0x98, 0xd6, 0x2d, 0x4e, 0xc9, 0xd6, 0xaf, 0xc0, 0xf0,
-	0x56, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x6d, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x59, 0x20, 0xb8, 0x83, 0xfb, 0x00, 0x00, 0x00,
+func (m *Params) GetDistributionInterval() DistributionInterval {
+	if m != nil {
+		return m.DistributionInterval
+	}
+	return DistributionInterval{}
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *Params) GetRecordRetention() uint64 {
+	if m != nil {
+		return m.RecordRetention
 	}
+	return 0
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// DistributionInterval defines the epochs of the fee distribution. At most
+// one of blocks and time can be set.
+type DistributionInterval struct {
+	// blocks is the number of blocks between two distributions.
+	Blocks uint64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// time is the minimum duration between two distributions.
+	Time time.Duration `protobuf:"bytes,2,opt,name=time,proto3,stdduration" json:"time"`
+}
+
+func (m *DistributionInterval) Reset()         { *m = DistributionInterval{} }
+func (m *DistributionInterval) String() string { return proto.CompactTextString(m) }
+func (*DistributionInterval) ProtoMessage()    {}
+func (*DistributionInterval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f21d4c303d841e, []int{1}
+}
+func (m *DistributionInterval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionInterval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionInterval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *DistributionInterval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionInterval.Merge(m, src)
+}
+func (m *DistributionInterval) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionInterval) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionInterval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionInterval proto.InternalMessageInfo
+
+func (m *DistributionInterval) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
 	}
-	if this.Enabled != that1.Enabled {
-		return false
+	return 0
+}
+
+func (m *DistributionInterval) GetTime() time.Duration {
+	if m != nil {
+		return m.Time
 	}
-	if this.Recipient != that1.Recipient {
-		return false
+	return 0
+}
+
+// LastDistribution records when the collected fees were last distributed.
+type LastDistribution struct {
+	// height is the block height of the last distribution.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the last distribution.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *LastDistribution) Reset()         { *m = LastDistribution{} }
+func (m *LastDistribution) String() string { return proto.CompactTextString(m) }
+func (*LastDistribution) ProtoMessage()    {}
+func (*LastDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f21d4c303d841e, []int{2}
+}
+func (m *LastDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LastDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastDistribution.Merge(m, src)
+}
+func (m *LastDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastDistribution proto.InternalMessageInfo
+
+func (m *LastDistribution) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LastDistribution) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintFeedistribution(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
+// DenomRule routes the collected fees of the denoms it matches.
+type DenomRule struct {
+	// denom is either an exact denom or a prefix followed by a '*' wildcard,
+	// e.g. "ibc/*". A single '*' matches every denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// keep leaves the matching fees in the fee collector, e.g. for x/distribution
+	// to pick them up.
+	Keep bool `protobuf:"varint,2,opt,name=keep,proto3" json:"keep,omitempty"`
+	// recipients receive the matching fees, split proportionally to their
+	// weights. Must be empty when keep is set.
+	Recipients []Recipient `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *DenomRule) Reset()         { *m = DenomRule{} }
+func (m *DenomRule) String() string { return proto.CompactTextString(m) }
+func (*DenomRule) ProtoMessage()    {}
+func (*DenomRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f21d4c303d841e, []int{3}
+}
+func (m *DenomRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+}
+func (m *DenomRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRule.Merge(m, src)
+}
+func (m *DenomRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomRule proto.InternalMessageInfo
+
+func (m *DenomRule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomRule) GetKeep() bool {
+	if m != nil {
+		return m.Keep
+	}
+	return false
+}
+
+func (m *DenomRule) GetRecipients() []Recipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+// Recipient receives a weighted share of the collected fees. Exactly one of
+// address, module and ibc must be set.
+type Recipient struct {
+	// address is the bech32 address of the recipient account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the share of the fees relative to the sum of all the weights.
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	// module is the name of the module account receiving the fees, e.g. "gov".
+	// The fees sent to the "distribution" module fund the community pool.
+	Module string `protobuf:"bytes,3,opt,name=module,proto3" json:"module,omitempty"`
+	// ibc forwards the fees to an account on another chain.
+	Ibc *IBCRecipient `protobuf:"bytes,4,opt,name=ibc,proto3" json:"ibc,omitempty"`
+}
+
+func (m *Recipient) Reset()         { *m = Recipient{} }
+func (m *Recipient) String() string { return proto.CompactTextString(m) }
+func (*Recipient) ProtoMessage()    {}
+func (*Recipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f21d4c303d841e, []int{4}
+}
+func (m *Recipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Recipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Recipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Recipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Recipient.Merge(m, src)
+}
+func (m *Recipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *Recipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_Recipient.DiscardUnknown(m)
 }
 
-func encodeVarintFeedistribution(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFeedistribution(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_Recipient proto.InternalMessageInfo
+
+func (m *Recipient) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Recipient) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
 	}
-	var l int
-	_ = l
-	if m.Enabled {
-		n += 2
+	return 0
+}
+
+func (m *Recipient) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *Recipient) GetIbc() *IBCRecipient {
+	if m != nil {
+		return m.Ibc
+	}
+	return nil
+}
+
+// IBCRecipient is an account on another chain reached by an ICS-20 transfer.
+// Transfers that time out or fail on the other chain are refunded to the
+// module account and distributed again.
+type IBCRecipient struct {
+	// channel_id is the transfer channel to the other chain.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// receiver is the address of the recipient on the other chain.
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// timeout is the relative timeout of the transfers. A default timeout is
+	// used when it is empty.
+	Timeout time.Duration `protobuf:"bytes,3,opt,name=timeout,proto3,stdduration" json:"timeout"`
+}
+
+func (m *IBCRecipient) Reset()         { *m = IBCRecipient{} }
+func (m *IBCRecipient) String() string { return proto.CompactTextString(m) }
+func (*IBCRecipient) ProtoMessage()    {}
+func (*IBCRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f21d4c303d841e, []int{5}
+}
+func (m *IBCRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCRecipient.Merge(m, src)
+}
+func (m *IBCRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCRecipient proto.InternalMessageInfo
+
+func (m *IBCRecipient) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *IBCRecipient) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *IBCRecipient) GetTimeout() time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+// DistributionFailures tracks the consecutive failures to transfer the
+// collected fees.
+type DistributionFailures struct {
+	// consecutive is the number of consecutive blocks in which the fee transfer
+	// failed. It is reset by the next successful transfer.
+	Consecutive uint64 `protobuf:"varint,1,opt,name=consecutive,proto3" json:"consecutive,omitempty"`
+	// last_height is the block height of the last failure.
+	LastHeight int64 `protobuf:"varint,2,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+}
+
+func (m *DistributionFailures) Reset()         { *m = DistributionFailures{} }
+func (m *DistributionFailures) String() string { return proto.CompactTextString(m) }
+func (*DistributionFailures) ProtoMessage()    {}
+func (*DistributionFailures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f21d4c303d841e, []int{6}
+}
+func (m *DistributionFailures) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionFailures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionFailures.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionFailures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionFailures.Merge(m, src)
+}
+func (m *DistributionFailures) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionFailures) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionFailures.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionFailures proto.InternalMessageInfo
+
+func (m *DistributionFailures) GetConsecutive() uint64 {
+	if m != nil {
+		return m.Consecutive
+	}
+	return 0
+}
+
+func (m *DistributionFailures) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+// DistributionRecord records a distribution of the collected fees.
+type DistributionRecord struct {
+	// height is the block height of the distribution.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the distribution.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// payouts are the fees transferred to each recipient.
+	Payouts []Payout `protobuf:"bytes,3,rep,name=payouts,proto3" json:"payouts"`
+	// burned are the fees burned.
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+}
+
+func (m *DistributionRecord) Reset()         { *m = DistributionRecord{} }
+func (m *DistributionRecord) String() string { return proto.CompactTextString(m) }
+func (*DistributionRecord) ProtoMessage()    {}
+func (*DistributionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f21d4c303d841e, []int{7}
+}
+func (m *DistributionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionRecord.Merge(m, src)
+}
+func (m *DistributionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionRecord proto.InternalMessageInfo
+
+func (m *DistributionRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DistributionRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *DistributionRecord) GetPayouts() []Payout {
+	if m != nil {
+		return m.Payouts
+	}
+	return nil
+}
+
+func (m *DistributionRecord) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+// Payout is an amount of fees transferred to a recipient.
+type Payout struct {
+	// recipient is the recipient: the bech32 address of an account or module
+	// account, or "ibc:{channel_id}:{receiver}" for an account on another chain.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount of fees transferred.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *Payout) Reset()         { *m = Payout{} }
+func (m *Payout) String() string { return proto.CompactTextString(m) }
+func (*Payout) ProtoMessage()    {}
+func (*Payout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f21d4c303d841e, []int{8}
+}
+func (m *Payout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Payout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Payout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Payout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Payout.Merge(m, src)
+}
+func (m *Payout) XXX_Size() int {
+	return m.Size()
+}
+func (m *Payout) XXX_DiscardUnknown() {
+	xxx_messageInfo_Payout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Payout proto.InternalMessageInfo
+
+func (m *Payout) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Payout) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// PayoutSimulation is the simulated fee transfer of a block. The block is
+// simulated at the height following the current block and at the current
+// block time.
+type PayoutSimulation struct {
+	// height is the height of the simulated block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// due is true if the fees are distributed in the simulated block.
+	Due bool `protobuf:"varint,2,opt,name=due,proto3" json:"due,omitempty"`
+	// payouts are the fees transferred to each recipient.
+	Payouts []Payout `protobuf:"bytes,3,rep,name=payouts,proto3" json:"payouts"`
+	// burned are the fees burned.
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	// escrowed are the fees left escrowed in the module account until a later
+	// distribution.
+	Escrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=escrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrowed"`
+	// kept are the fees left in the fee collector.
+	Kept github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=kept,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"kept"`
+	// error is the error the fee transfer would fail with, leaving the fees in
+	// place.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *PayoutSimulation) Reset()         { *m = PayoutSimulation{} }
+func (m *PayoutSimulation) String() string { return proto.CompactTextString(m) }
+func (*PayoutSimulation) ProtoMessage()    {}
+func (*PayoutSimulation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4f21d4c303d841e, []int{9}
+}
+func (m *PayoutSimulation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayoutSimulation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayoutSimulation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayoutSimulation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutSimulation.Merge(m, src)
+}
+func (m *PayoutSimulation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayoutSimulation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutSimulation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutSimulation proto.InternalMessageInfo
+
+func (m *PayoutSimulation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PayoutSimulation) GetDue() bool {
+	if m != nil {
+		return m.Due
+	}
+	return false
+}
+
+func (m *PayoutSimulation) GetPayouts() []Payout {
+	if m != nil {
+		return m.Payouts
+	}
+	return nil
+}
+
+func (m *PayoutSimulation) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *PayoutSimulation) GetEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Escrowed
+	}
+	return nil
+}
+
+func (m *PayoutSimulation) GetKept() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Kept
+	}
+	return nil
+}
+
+func (m *PayoutSimulation) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "saga.feedistribution.v1.Params")
+	proto.RegisterType((*DistributionInterval)(nil), "saga.feedistribution.v1.DistributionInterval")
+	proto.RegisterType((*LastDistribution)(nil), "saga.feedistribution.v1.LastDistribution")
+	proto.RegisterType((*DenomRule)(nil), "saga.feedistribution.v1.DenomRule")
+	proto.RegisterType((*Recipient)(nil), "saga.feedistribution.v1.Recipient")
+	proto.RegisterType((*IBCRecipient)(nil), "saga.feedistribution.v1.IBCRecipient")
+	proto.RegisterType((*DistributionFailures)(nil), "saga.feedistribution.v1.DistributionFailures")
+	proto.RegisterType((*DistributionRecord)(nil), "saga.feedistribution.v1.DistributionRecord")
+	proto.RegisterType((*Payout)(nil), "saga.feedistribution.v1.Payout")
+	proto.RegisterType((*PayoutSimulation)(nil), "saga.feedistribution.v1.PayoutSimulation")
+}
+
+func init() {
+	proto.RegisterFile("saga/feedistribution/v1/feedistribution.proto", fileDescriptor_f4f21d4c303d841e)
+}
+
+var fileDescriptor_f4f21d4c303d841e = []byte{
+	// 912 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xbf, 0x8f, 0x1b, 0x45,
+	0x14, 0xbe, 0x3d, 0xfb, 0x7c, 0xf6, 0x18, 0x89, 0xd3, 0xe8, 0x80, 0x3d, 0x03, 0xb6, 0xb5, 0x12,
+	0x92, 0x29, 0xbc, 0x8b, 0x2f, 0x45, 0x68, 0x10, 0xc2, 0x39, 0xa1, 0x9c, 0x14, 0xa1, 0x30, 0xa1,
+	0x81, 0xc6, 0x9a, 0xdd, 0x7d, 0x59, 0x8f, 0x6e, 0x77, 0xc7, 0x9a, 0x99, 0x75, 0x72, 0x34, 0xb4,
+	0x48, 0x50, 0xa4, 0xe4, 0x4f, 0x88, 0xa8, 0x53, 0x53, 0x50, 0xa5, 0x8c, 0x52, 0x21, 0x8a, 0x04,
+	0xdd, 0xfd, 0x23, 0x68, 0x7e, 0xac, 0xed, 0x5c, 0x72, 0x40, 0xe1, 0x86, 0x6a, 0xf7, 0x7b, 0xbf,
+	0xbe, 0x77, 0xef, 0x7d, 0xfb, 0xce, 0x68, 0x2c, 0x69, 0x46, 0xa3, 0xfb, 0x00, 0x29, 0x93, 0x4a,
+	0xb0, 0xb8, 0x52, 0x8c, 0x97, 0xd1, 0x72, 0x72, 0xd5, 0x14, 0x2e, 0x04, 0x57, 0x1c, 0xbf, 0xa7,
+	0xc3, 0xc3, 0xab, 0xbe, 0xe5, 0xa4, 0x77, 0x98, 0xf1, 0x8c, 0x9b, 0x98, 0x48, 0xbf, 0xd9, 0xf0,
+	0xde, 0x51, 0xc2, 0x65, 0xc1, 0xe5, 0xcc, 0x3a, 0x2c, 0x70, 0xae, 0xbe, 0x45, 0x51, 0x4c, 0x25,
+	0x44, 0xcb, 0x49, 0x0c, 0x8a, 0x4e, 0xa2, 0x84, 0xb3, 0xb2, 0xf6, 0x67, 0x9c, 0x67, 0x39, 0x44,
+	0x06, 0xc5, 0xd5, 0xfd, 0x28, 0xad, 0x04, 0x5d, 0x77, 0xd2, 0x1b, 0x5c, 0xf5, 0x2b, 0x56, 0x80,
+	0x54, 0xb4, 0x58, 0xd8, 0x80, 0xe0, 0xb7, 0x06, 0x6a, 0xdd, 0xa5, 0x82, 0x16, 0x12, 0xfb, 0x68,
+	0x1f, 0x4a, 0x1a, 0xe7, 0x90, 0xfa, 0xde, 0xd0, 0x1b, 0xb5, 0x49, 0x0d, 0xf1, 0x10, 0x75, 0x04,
+	0x24, 0x6c, 0xc1, 0xa0, 0x54, 0xfe, 0xee, 0xd0, 0x1b, 0x75, 0xa6, 0xbb, 0xbe, 0x47, 0xd6, 0x46,
+	0x7c, 0x1b, 0xa1, 0x15, 0x90, 0x7e, 0x63, 0xd8, 0x18, 0x75, 0x8f, 0x83, 0xf0, 0x9a, 0x31, 0x84,
+	0xa4, 0x0e, 0x9d, 0x36, 0x9f, 0xbe, 0x18, 0xec, 0x90, 0x8d, 0x5c, 0x7c, 0x8a, 0xba, 0x29, 0x94,
+	0xbc, 0x98, 0x89, 0x2a, 0x07, 0xe9, 0x37, 0xff, 0xa5, 0xd4, 0x89, 0x8e, 0x25, 0x55, 0x0e, 0x75,
+	0xa9, 0xb4, 0x36, 0x48, 0xfc, 0x15, 0xea, 0xc4, 0x95, 0x28, 0x67, 0x82, 0x2a, 0xf0, 0xf7, 0x4c,
+	0xdb, 0x13, 0x1d, 0xf4, 0xe7, 0x8b, 0xc1, 0xfb, 0x76, 0xae, 0x32, 0x3d, 0x0b, 0x19, 0x8f, 0x0a,
+	0xaa, 0xe6, 0xe1, 0x1d, 0xc8, 0x68, 0x72, 0x7e, 0x02, 0xc9, 0xf3, 0x27, 0x63, 0xe4, 0x96, 0x70,
+	0x02, 0x09, 0x69, 0xeb, 0x1a, 0x84, 0x2a, 0xc0, 0x73, 0xf4, 0xce, 0x26, 0xfd, 0x8c, 0x95, 0x0a,
+	0xc4, 0x92, 0xe6, 0x7e, 0x6b, 0xe8, 0x8d, 0xba, 0xc7, 0xe3, 0xeb, 0x9b, 0xdc, 0xc0, 0xa7, 0x2e,
+	0xc9, 0xf5, 0x7b, 0x98, 0xbe, 0xc1, 0x87, 0x3f, 0x46, 0x07, 0x02, 0x12, 0x2e, 0xd2, 0x99, 0x00,
+	0x05, 0xa5, 0xf6, 0xf9, 0xfb, 0x43, 0x6f, 0xd4, 0x24, 0x6f, 0x5b, 0x3b, 0xa9, 0xcd, 0x41, 0x86,
+	0x0e, 0xdf, 0x54, 0x1e, 0xbf, 0x8b, 0x5a, 0x71, 0xce, 0x93, 0x33, 0x69, 0x96, 0xd9, 0x24, 0x0e,
+	0xe1, 0x9b, 0xa8, 0xa9, 0x35, 0x60, 0xd6, 0xd8, 0x3d, 0x3e, 0x0a, 0xad, 0x40, 0xc2, 0x5a, 0x20,
+	0xe1, 0x89, 0x13, 0xd0, 0xb4, 0xad, 0xfb, 0xfb, 0xe5, 0xe5, 0xc0, 0x23, 0x26, 0x21, 0x48, 0xd1,
+	0xc1, 0x1d, 0x2a, 0xd5, 0x26, 0x99, 0x26, 0x99, 0x03, 0xcb, 0xe6, 0xca, 0x90, 0x34, 0x88, 0x43,
+	0xf8, 0xd3, 0x57, 0x48, 0x7a, 0xaf, 0x91, 0x7c, 0x53, 0xab, 0xd0, 0xb2, 0x3c, 0x5a, 0xb3, 0xfc,
+	0x80, 0x3a, 0xab, 0x95, 0xe2, 0x43, 0xb4, 0x67, 0xd6, 0x69, 0xaa, 0x77, 0x88, 0x05, 0x18, 0xa3,
+	0xe6, 0x19, 0xc0, 0xc2, 0x14, 0x6f, 0x13, 0xf3, 0xbe, 0x3d, 0xfd, 0x05, 0x8f, 0x3d, 0xd4, 0x59,
+	0xf9, 0xf1, 0x31, 0xda, 0xa7, 0x69, 0x2a, 0x40, 0xda, 0x31, 0x76, 0xa6, 0xfe, 0xf3, 0x27, 0xe3,
+	0x43, 0xa7, 0x8e, 0x2f, 0xac, 0xe7, 0x9e, 0x12, 0xac, 0xcc, 0x48, 0x1d, 0xa8, 0x87, 0xf2, 0xc0,
+	0x0e, 0x65, 0xd7, 0x4e, 0xde, 0x22, 0x6d, 0x2f, 0x78, 0x5a, 0xe5, 0xe0, 0x37, 0xcc, 0x9f, 0xe3,
+	0x10, 0xbe, 0x89, 0x1a, 0x2c, 0x4e, 0xfc, 0xa6, 0x99, 0xd5, 0x47, 0xd7, 0x36, 0x7d, 0x3a, 0xbd,
+	0xb5, 0xea, 0x8b, 0xe8, 0x8c, 0xe0, 0x47, 0x0f, 0xbd, 0xb5, 0x69, 0xc5, 0x1f, 0x22, 0x94, 0xcc,
+	0x69, 0x59, 0x42, 0x3e, 0x63, 0xa9, 0x1b, 0x5a, 0xc7, 0x59, 0x4e, 0x53, 0xdc, 0x43, 0x6d, 0x01,
+	0x09, 0xb0, 0x25, 0x08, 0xfb, 0x15, 0x93, 0x15, 0xc6, 0x9f, 0xa1, 0x7d, 0x3d, 0x7f, 0x5e, 0x29,
+	0xd3, 0xdd, 0x7f, 0x54, 0x46, 0x9d, 0x13, 0x7c, 0xfb, 0xaa, 0x0a, 0xbf, 0xa4, 0x2c, 0xaf, 0x04,
+	0x48, 0x3c, 0x44, 0xdd, 0x84, 0x97, 0x12, 0x92, 0x4a, 0xb1, 0x25, 0x38, 0x29, 0x6e, 0x9a, 0xf0,
+	0x00, 0x75, 0x73, 0x2a, 0xd5, 0x6c, 0xbe, 0x1e, 0x59, 0x83, 0x20, 0x6d, 0xba, 0x6d, 0x2c, 0xc1,
+	0xcf, 0xbb, 0x08, 0x6f, 0xd6, 0x26, 0xe6, 0x03, 0xd8, 0xbe, 0xf4, 0xf0, 0xe7, 0x68, 0x7f, 0x41,
+	0xcf, 0x79, 0xb5, 0x12, 0xd0, 0xe0, 0xda, 0x5d, 0xdc, 0x35, 0x71, 0x4e, 0x3d, 0x75, 0x16, 0x4e,
+	0x50, 0x4b, 0xdf, 0x0a, 0x48, 0xdd, 0xd5, 0x3a, 0x0a, 0x9d, 0x50, 0xf4, 0xf5, 0x0e, 0xdd, 0xf5,
+	0x0e, 0x6f, 0x71, 0x56, 0x4e, 0x3f, 0xd1, 0x99, 0xbf, 0xbe, 0x1c, 0x8c, 0x32, 0xa6, 0xe6, 0x55,
+	0x1c, 0x26, 0xbc, 0x70, 0x87, 0xdf, 0x3d, 0xc6, 0x32, 0x3d, 0x8b, 0xd4, 0xf9, 0x02, 0xa4, 0x49,
+	0x90, 0xc4, 0x95, 0x0e, 0x7e, 0xf2, 0xf4, 0xc1, 0xd6, 0x84, 0xf8, 0x83, 0xcd, 0xb3, 0xec, 0xb6,
+	0xbd, 0x3e, 0xc9, 0x09, 0x6a, 0xd1, 0x82, 0x57, 0xe6, 0x62, 0x6f, 0xbf, 0x1b, 0x5b, 0x3a, 0xf8,
+	0xbd, 0x81, 0x0e, 0x6c, 0x37, 0xf7, 0x58, 0x51, 0xe5, 0xf4, 0x1f, 0xaf, 0xc2, 0x01, 0x6a, 0xa4,
+	0x15, 0xb8, 0xef, 0x56, 0xbf, 0xfe, 0x3f, 0x46, 0x8e, 0x33, 0xd4, 0x06, 0x99, 0x08, 0xfe, 0x00,
+	0x52, 0x7f, 0x6f, 0xfb, 0x34, 0xab, 0xe2, 0x78, 0xa6, 0x2f, 0xdb, 0x42, 0xf9, 0xad, 0xed, 0x93,
+	0x98, 0xc2, 0xfa, 0xa0, 0x82, 0x10, 0x5c, 0x98, 0x7f, 0x26, 0x1d, 0x62, 0xc1, 0xf4, 0xeb, 0xc7,
+	0x17, 0x7d, 0xef, 0xe9, 0x45, 0xdf, 0x7b, 0x76, 0xd1, 0xf7, 0xfe, 0xba, 0xe8, 0x7b, 0x8f, 0x2e,
+	0xfb, 0x3b, 0xcf, 0x2e, 0xfb, 0x3b, 0x7f, 0x5c, 0xf6, 0x77, 0xbe, 0xbb, 0xb1, 0xc1, 0xa1, 0x97,
+	0xf3, 0xf0, 0xfc, 0x7b, 0xf3, 0x34, 0x14, 0x0f, 0x5f, 0xfb, 0x51, 0x64, 0x48, 0xe3, 0x96, 0xf9,
+	0xde, 0x6e, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x84, 0x89, 0x87, 0x33, 0x39, 0x09, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if len(this.Recipients) != len(that1.Recipients) {
+		return false
+	}
+	for i := range this.Recipients {
+		if !this.Recipients[i].Equal(&that1.Recipients[i]) {
+			return false
+		}
+	}
+	if len(this.DenomRules) != len(that1.DenomRules) {
+		return false
+	}
+	for i := range this.DenomRules {
+		if !this.DenomRules[i].Equal(&that1.DenomRules[i]) {
+			return false
+		}
+	}
+	if !this.BurnRate.Equal(that1.BurnRate) {
+		return false
+	}
+	if !this.DistributionInterval.Equal(&that1.DistributionInterval) {
+		return false
+	}
+	if this.RecordRetention != that1.RecordRetention {
+		return false
+	}
+	return true
+}
+func (this *DistributionInterval) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DistributionInterval)
+	if !ok {
+		that2, ok := that.(DistributionInterval)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Blocks != that1.Blocks {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	return true
+}
+func (this *LastDistribution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LastDistribution)
+	if !ok {
+		that2, ok := that.(LastDistribution)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	return true
+}
+func (this *DenomRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomRule)
+	if !ok {
+		that2, ok := that.(DenomRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Keep != that1.Keep {
+		return false
+	}
+	if len(this.Recipients) != len(that1.Recipients) {
+		return false
+	}
+	for i := range this.Recipients {
+		if !this.Recipients[i].Equal(&that1.Recipients[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Recipient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Recipient)
+	if !ok {
+		that2, ok := that.(Recipient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Weight != that1.Weight {
+		return false
+	}
+	if this.Module != that1.Module {
+		return false
+	}
+	if !this.Ibc.Equal(that1.Ibc) {
+		return false
+	}
+	return true
+}
+func (this *IBCRecipient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IBCRecipient)
+	if !ok {
+		that2, ok := that.(IBCRecipient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChannelId != that1.ChannelId {
+		return false
+	}
+	if this.Receiver != that1.Receiver {
+		return false
+	}
+	if this.Timeout != that1.Timeout {
+		return false
+	}
+	return true
+}
+func (this *DistributionFailures) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DistributionFailures)
+	if !ok {
+		that2, ok := that.(DistributionFailures)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Consecutive != that1.Consecutive {
+		return false
+	}
+	if this.LastHeight != that1.LastHeight {
+		return false
+	}
+	return true
+}
+func (this *DistributionRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DistributionRecord)
+	if !ok {
+		that2, ok := that.(DistributionRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if len(this.Payouts) != len(that1.Payouts) {
+		return false
+	}
+	for i := range this.Payouts {
+		if !this.Payouts[i].Equal(&that1.Payouts[i]) {
+			return false
+		}
+	}
+	if len(this.Burned) != len(that1.Burned) {
+		return false
+	}
+	for i := range this.Burned {
+		if !this.Burned[i].Equal(&that1.Burned[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Payout) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Payout)
+	if !ok {
+		that2, ok := that.(Payout)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PayoutSimulation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayoutSimulation)
+	if !ok {
+		that2, ok := that.(PayoutSimulation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Due != that1.Due {
+		return false
+	}
+	if len(this.Payouts) != len(that1.Payouts) {
+		return false
+	}
+	for i := range this.Payouts {
+		if !this.Payouts[i].Equal(&that1.Payouts[i]) {
+			return false
+		}
+	}
+	if len(this.Burned) != len(that1.Burned) {
+		return false
+	}
+	for i := range this.Burned {
+		if !this.Burned[i].Equal(&that1.Burned[i]) {
+			return false
+		}
+	}
+	if len(this.Escrowed) != len(that1.Escrowed) {
+		return false
+	}
+	for i := range this.Escrowed {
+		if !this.Escrowed[i].Equal(&that1.Escrowed[i]) {
+			return false
+		}
+	}
+	if len(this.Kept) != len(that1.Kept) {
+		return false
+	}
+	for i := range this.Kept {
+		if !this.Kept[i].Equal(&that1.Kept[i]) {
+			return false
+		}
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordRetention != 0 {
+		i = encodeVarintFeedistribution(dAtA, i, uint64(m.RecordRetention))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.DistributionInterval.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeedistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.BurnRate.Size()
+		i -= size
+		if _, err := m.BurnRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeedistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DenomRules) > 0 {
+		for iNdEx := len(m.DenomRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeedistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeedistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintFeedistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionInterval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionInterval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintFeedistribution(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Blocks != 0 {
+		i = encodeVarintFeedistribution(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintFeedistribution(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintFeedistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeedistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Keep {
+		i--
+		if m.Keep {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeedistribution(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Recipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Recipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Recipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ibc != nil {
+		{
+			size, err := m.Ibc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeedistribution(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintFeedistribution(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Weight != 0 {
+		i = encodeVarintFeedistribution(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFeedistribution(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IBCRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintFeedistribution(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintFeedistribution(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintFeedistribution(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionFailures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionFailures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionFailures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHeight != 0 {
+		i = encodeVarintFeedistribution(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Consecutive != 0 {
+		i = encodeVarintFeedistribution(dAtA, i, uint64(m.Consecutive))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeedistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Payouts) > 0 {
+		for iNdEx := len(m.Payouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeedistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintFeedistribution(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintFeedistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Payout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Payout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeedistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintFeedistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PayoutSimulation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayoutSimulation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayoutSimulation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintFeedistribution(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Kept) > 0 {
+		for iNdEx := len(m.Kept) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Kept[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeedistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Escrowed) > 0 {
+		for iNdEx := len(m.Escrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeedistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeedistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Payouts) > 0 {
+		for iNdEx := len(m.Payouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeedistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Due {
+		i--
+		if m.Due {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintFeedistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeedistribution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeedistribution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovFeedistribution(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovFeedistribution(uint64(l))
+		}
+	}
+	if len(m.DenomRules) > 0 {
+		for _, e := range m.DenomRules {
+			l = e.Size()
+			n += 1 + l + sovFeedistribution(uint64(l))
+		}
+	}
+	l = m.BurnRate.Size()
+	n += 1 + l + sovFeedistribution(uint64(l))
+	l = m.DistributionInterval.Size()
+	n += 1 + l + sovFeedistribution(uint64(l))
+	if m.RecordRetention != 0 {
+		n += 1 + sovFeedistribution(uint64(m.RecordRetention))
+	}
+	return n
+}
+
+func (m *DistributionInterval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovFeedistribution(uint64(m.Blocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Time)
+	n += 1 + l + sovFeedistribution(uint64(l))
+	return n
+}
+
+func (m *LastDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeedistribution(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovFeedistribution(uint64(l))
+	return n
+}
+
+func (m *DenomRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeedistribution(uint64(l))
+	}
+	if m.Keep {
+		n += 2
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovFeedistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Recipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFeedistribution(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovFeedistribution(uint64(m.Weight))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovFeedistribution(uint64(l))
+	}
+	if m.Ibc != nil {
+		l = m.Ibc.Size()
+		n += 1 + l + sovFeedistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *IBCRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovFeedistribution(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovFeedistribution(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovFeedistribution(uint64(l))
+	return n
+}
+
+func (m *DistributionFailures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Consecutive != 0 {
+		n += 1 + sovFeedistribution(uint64(m.Consecutive))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovFeedistribution(uint64(m.LastHeight))
+	}
+	return n
+}
+
+func (m *DistributionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeedistribution(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovFeedistribution(uint64(l))
+	if len(m.Payouts) > 0 {
+		for _, e := range m.Payouts {
+			l = e.Size()
+			n += 1 + l + sovFeedistribution(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovFeedistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Payout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovFeedistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovFeedistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PayoutSimulation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeedistribution(uint64(m.Height))
+	}
+	if m.Due {
+		n += 2
+	}
+	if len(m.Payouts) > 0 {
+		for _, e := range m.Payouts {
+			l = e.Size()
+			n += 1 + l + sovFeedistribution(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovFeedistribution(uint64(l))
+		}
+	}
+	if len(m.Escrowed) > 0 {
+		for _, e := range m.Escrowed {
+			l = e.Size()
+			n += 1 + l + sovFeedistribution(uint64(l))
+		}
+	}
+	if len(m.Kept) > 0 {
+		for _, e := range m.Kept {
+			l = e.Size()
+			n += 1 + l + sovFeedistribution(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovFeedistribution(uint64(l))
+	}
+	return n
+}
+
+func sovFeedistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeedistribution(x uint64) (n int) {
+	return sovFeedistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeedistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, Recipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomRules = append(m.DenomRules, DenomRule{})
+			if err := m.DenomRules[len(m.DenomRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordRetention", wireType)
+			}
+			m.RecordRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeedistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionInterval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeedistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionInterval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeedistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeedistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeedistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeedistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keep", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Keep = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, Recipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeedistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Recipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeedistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Recipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Recipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ibc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ibc == nil {
+				m.Ibc = &IBCRecipient{}
+			}
+			if err := m.Ibc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeedistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeedistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeedistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionFailures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeedistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionFailures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionFailures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consecutive", wireType)
+			}
+			m.Consecutive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Consecutive |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeedistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeedistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payouts = append(m.Payouts, Payout{})
+			if err := m.Payouts[len(m.Payouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types1.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeedistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovFeedistribution(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *Payout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeedistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Payout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Payout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeedistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovFeedistribution(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFeedistribution(x uint64) (n int) {
-	return sovFeedistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PayoutSimulation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -224,15 +3307,34 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PayoutSimulation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PayoutSimulation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Due", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -249,10 +3351,146 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
-		case 2:
+			m.Due = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payouts = append(m.Payouts, Payout{})
+			if err := m.Payouts[len(m.Payouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types1.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrowed = append(m.Escrowed, types1.Coin{})
+			if err := m.Escrowed[len(m.Escrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kept", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kept = append(m.Kept, types1.Coin{})
+			if err := m.Kept[len(m.Kept)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -280,7 +3518,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultGenesisState sets default feedistribution genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
@@ -17,5 +19,40 @@ func NewGenesisState(params Params) *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Burned.Validate(); err != nil {
+		return fmt.Errorf("invalid burned coins: %w", err)
+	}
+	if err := gs.Retained.Validate(); err != nil {
+		return fmt.Errorf("invalid retained coins: %w", err)
+	}
+	if gs.LastDistribution.Height < 0 {
+		return fmt.Errorf("negative last distribution height: %d", gs.LastDistribution.Height)
+	}
+	if gs.Failures.LastHeight < 0 {
+		return fmt.Errorf("negative last failure height: %d", gs.Failures.LastHeight)
+	}
+
+	recipients := make(map[string]bool, len(gs.Distributed))
+	for _, payout := range gs.Distributed {
+		if err := payout.Validate(); err != nil {
+			return err
+		}
+		if recipients[payout.Recipient] {
+			return fmt.Errorf("duplicate distributed recipient %s", payout.Recipient)
+		}
+		recipients[payout.Recipient] = true
+	}
+
+	heights := make(map[int64]bool, len(gs.Records))
+	for _, record := range gs.Records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if heights[record.Height] {
+			return fmt.Errorf("duplicate distribution record at height %d", record.Height)
+		}
+		heights[record.Height] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -27,6 +29,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the paramaters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// burned is the total amount of fees burned per denom.
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	// last_distribution is the time of the last fee distribution.
+	LastDistribution LastDistribution `protobuf:"bytes,3,opt,name=last_distribution,json=lastDistribution,proto3" json:"last_distribution"`
+	// distributed is the total amount of fees transferred to each recipient.
+	Distributed []Payout `protobuf:"bytes,4,rep,name=distributed,proto3" json:"distributed"`
+	// records are the past fee distributions.
+	Records []DistributionRecord `protobuf:"bytes,5,rep,name=records,proto3" json:"records"`
+	// retained are the fees returned to the fee collector after a distribution,
+	// which are not escrowed again.
+	Retained github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=retained,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"retained"`
+	// failures are the consecutive failures to transfer the collected fees.
+	Failures DistributionFailures `protobuf:"bytes,7,opt,name=failures,proto3" json:"failures"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,21 +86,34 @@ func init() {
 }
 
 var fileDescriptor_7042bc15f019ae7f = []byte{
-	// 222 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x4e, 0x4c, 0x4f,
-	0xd4, 0x4f, 0x4b, 0x4d, 0x4d, 0xc9, 0x2c, 0x2e, 0x29, 0xca, 0x4c, 0x2a, 0x2d, 0xc9, 0xcc, 0xcf,
-	0xd3, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x07, 0x29, 0xd3, 0x43, 0x53, 0xa6, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f,
-	0x9e, 0x0f, 0x56, 0xa3, 0x0f, 0x62, 0x41, 0x94, 0x4b, 0xe9, 0xe2, 0x32, 0x15, 0xdd, 0x04, 0xb0,
-	0x72, 0xa5, 0x70, 0x2e, 0x1e, 0x77, 0x88, 0x75, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0xb6, 0x5c,
-	0x6c, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xf2, 0x7a,
-	0x38, 0xac, 0xd7, 0x0b, 0x00, 0x2b, 0x73, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0xc9,
-	0x8a, 0xa3, 0x63, 0x81, 0x3c, 0xc3, 0x8b, 0x05, 0xf2, 0x0c, 0x4e, 0x81, 0x2b, 0x1e, 0xc9, 0x31,
-	0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb,
-	0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x71, 0x7a, 0x66, 0x49, 0x46,
-	0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xc8, 0x82, 0x8a, 0xca, 0x2a, 0x30, 0xad, 0x5b, 0x9c,
-	0x92, 0xad, 0x5f, 0x81, 0xe1, 0xfc, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x93, 0x8d,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xca, 0x2f, 0x0d, 0xc1, 0x39, 0x01, 0x00, 0x00,
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x41, 0xeb, 0xd3, 0x30,
+	0x18, 0xc6, 0x5b, 0xff, 0xb3, 0x1b, 0x99, 0x07, 0x0d, 0x82, 0x75, 0x87, 0x74, 0x08, 0xc2, 0x44,
+	0x96, 0xd8, 0xed, 0x26, 0x78, 0x99, 0xe2, 0x0e, 0x0a, 0xea, 0xbc, 0x89, 0x20, 0x69, 0x9b, 0xd5,
+	0xe0, 0xd6, 0x8c, 0x24, 0x1d, 0x9b, 0x9f, 0xc0, 0xa3, 0x1f, 0x61, 0x47, 0xf1, 0x93, 0xec, 0xb8,
+	0xa3, 0x27, 0x95, 0xee, 0x22, 0x7e, 0x0a, 0x69, 0x1a, 0x47, 0x99, 0x54, 0x3c, 0x78, 0x4a, 0x49,
+	0x9f, 0xe7, 0x79, 0x7f, 0x79, 0x78, 0xc1, 0x6d, 0x45, 0x53, 0x4a, 0xe6, 0x8c, 0x25, 0x5c, 0x69,
+	0xc9, 0xa3, 0x5c, 0x73, 0x91, 0x91, 0x75, 0x48, 0x52, 0x96, 0x31, 0xc5, 0x15, 0x5e, 0x49, 0xa1,
+	0x05, 0xbc, 0x51, 0xca, 0xf0, 0x99, 0x0c, 0xaf, 0xc3, 0xde, 0xf5, 0x54, 0xa4, 0xc2, 0x68, 0x48,
+	0xf9, 0x55, 0xc9, 0x7b, 0x28, 0x16, 0x6a, 0x29, 0x14, 0x89, 0xa8, 0x62, 0x64, 0x1d, 0x46, 0x4c,
+	0xd3, 0x90, 0xc4, 0x82, 0x67, 0xf6, 0xff, 0xb0, 0x69, 0xea, 0xf9, 0x04, 0x23, 0xbf, 0xf5, 0xb3,
+	0x05, 0xae, 0x4c, 0x2b, 0x9e, 0x97, 0x9a, 0x6a, 0x06, 0x1f, 0x00, 0x6f, 0x45, 0x25, 0x5d, 0x2a,
+	0xdf, 0xed, 0xbb, 0x83, 0xee, 0x28, 0xc0, 0x0d, 0x7c, 0xf8, 0xb9, 0x91, 0x4d, 0x5a, 0xfb, 0xaf,
+	0x81, 0x33, 0xb3, 0x26, 0x18, 0x03, 0x2f, 0xca, 0x65, 0xc6, 0x12, 0xff, 0x52, 0xff, 0x62, 0xd0,
+	0x1d, 0xdd, 0xc4, 0x15, 0x2f, 0x2e, 0x79, 0xb1, 0xe5, 0xc5, 0x0f, 0x05, 0xcf, 0x26, 0xf7, 0x4a,
+	0xe3, 0xe7, 0x6f, 0xc1, 0x20, 0xe5, 0xfa, 0x6d, 0x1e, 0xe1, 0x58, 0x2c, 0x89, 0x7d, 0x5c, 0x75,
+	0x0c, 0x55, 0xf2, 0x8e, 0xe8, 0xed, 0x8a, 0x29, 0x63, 0x50, 0x33, 0x1b, 0x0d, 0x5f, 0x83, 0x6b,
+	0x0b, 0xaa, 0xf4, 0x9b, 0x3a, 0x91, 0x7f, 0x61, 0x70, 0xef, 0x34, 0xe2, 0x3e, 0xa5, 0x4a, 0x3f,
+	0xaa, 0xdd, 0x59, 0xf0, 0xab, 0x8b, 0xb3, 0x7b, 0x38, 0x05, 0xdd, 0x93, 0x97, 0x25, 0x7e, 0xcb,
+	0xbc, 0xe3, 0x6f, 0x35, 0x6c, 0x45, 0xae, 0x6d, 0x5a, 0xdd, 0x09, 0x9f, 0x80, 0xb6, 0x64, 0xb1,
+	0x90, 0x89, 0xf2, 0x2f, 0x9b, 0x90, 0xbb, 0x8d, 0x21, 0x75, 0x80, 0x99, 0xf1, 0xd8, 0xc0, 0xdf,
+	0x09, 0x30, 0x05, 0x1d, 0xc9, 0x34, 0xe5, 0x65, 0xb5, 0xde, 0xff, 0xaf, 0xf6, 0x14, 0x0e, 0x9f,
+	0x81, 0xce, 0x9c, 0xf2, 0x45, 0x2e, 0x99, 0xf2, 0xdb, 0xa6, 0xd3, 0xe1, 0x3f, 0x61, 0x3f, 0xb6,
+	0x26, 0x0b, 0x7e, 0x0a, 0xb9, 0xdf, 0xf9, 0xb0, 0x0b, 0x9c, 0x1f, 0xbb, 0xc0, 0x99, 0xbc, 0xf8,
+	0x54, 0x20, 0x77, 0x5f, 0x20, 0xf7, 0x50, 0x20, 0xf7, 0x7b, 0x81, 0xdc, 0x8f, 0x47, 0xe4, 0x1c,
+	0x8e, 0xc8, 0xf9, 0x72, 0x44, 0xce, 0xab, 0x71, 0x0d, 0xb6, 0x1c, 0xb8, 0xd9, 0xbe, 0x37, 0xa7,
+	0x61, 0xdd, 0xfc, 0xb1, 0xd2, 0x86, 0x3e, 0xf2, 0xcc, 0x1a, 0x8f, 0x7f, 0x05, 0x00, 0x00, 0xff,
+	0xff, 0xf8, 0xe2, 0xd6, 0xd7, 0x6d, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -108,6 +136,82 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Failures.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Retained) > 0 {
+		for iNdEx := len(m.Retained) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Retained[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Distributed) > 0 {
+		for iNdEx := len(m.Distributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.LastDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -140,6 +244,34 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.LastDistribution.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Distributed) > 0 {
+		for _, e := range m.Distributed {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Retained) > 0 {
+		for _, e := range m.Retained {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Failures.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -211,6 +343,208 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributed = append(m.Distributed, Payout{})
+			if err := m.Distributed[len(m.Distributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, DistributionRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retained", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Retained = append(m.Retained, types.Coin{})
+			if err := m.Retained[len(m.Retained)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Failures.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,9 @@ package types
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/stretchr/testify/suite"
 )
 
@@ -28,10 +31,84 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		{
 			"valid genesis",
 			&GenesisState{
-				DefaultParams(),
+				Params: DefaultParams(),
+				Burned: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			},
+			true,
+		},
+		{
+			"invalid burned coins",
+			&GenesisState{
+				Params: DefaultParams(),
+				Burned: sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdkmath.NewInt(-1)}},
+			},
+			false,
+		},
+		{
+			"invalid retained coins",
+			&GenesisState{
+				Params:   DefaultParams(),
+				Retained: sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdkmath.NewInt(-1)}},
+			},
+			false,
+		},
+		{
+			"negative last distribution height",
+			&GenesisState{
+				Params:           DefaultParams(),
+				LastDistribution: LastDistribution{Height: -1},
+			},
+			false,
+		},
+		{
+			"negative last failure height",
+			&GenesisState{
+				Params:   DefaultParams(),
+				Failures: DistributionFailures{Consecutive: 1, LastHeight: -1},
+			},
+			false,
+		},
+		{
+			"valid ledger",
+			&GenesisState{
+				Params: DefaultParams(),
+				Distributed: []Payout{
+					{Recipient: "cosmos147klh7th5jkjy3aajsj2rqvhtvh9mfde37wq5g", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+				},
+				Records: []DistributionRecord{
+					{Height: 1, Burned: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+					{Height: 2},
+				},
 			},
 			true,
 		},
+		{
+			"invalid payout recipient",
+			&GenesisState{
+				Params:      DefaultParams(),
+				Distributed: []Payout{{Recipient: "invalid"}},
+			},
+			false,
+		},
+		{
+			"duplicate distributed recipient",
+			&GenesisState{
+				Params: DefaultParams(),
+				Distributed: []Payout{
+					{Recipient: "cosmos147klh7th5jkjy3aajsj2rqvhtvh9mfde37wq5g"},
+					{Recipient: "cosmos147klh7th5jkjy3aajsj2rqvhtvh9mfde37wq5g"},
+				},
+			},
+			false,
+		},
+		{
+			"duplicate record height",
+			&GenesisState{
+				Params:  DefaultParams(),
+				Records: []DistributionRecord{{Height: 1}, {Height: 1}},
+			},
+			false,
+		},
 		{
 			"empty params",
 			&GenesisState{
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName string name of module
 	ModuleName = "feedistribution"
@@ -10,3 +15,34 @@ const (
 	// RouterKey uses module name for routing
 	RouterKey = ModuleName
 )
+
+// prefix bytes for the feedistribution module's persistent store
+const (
+	prefixBurned = iota + 1
+	prefixLastDistribution
+	prefixFailures
+	prefixDistributed
+	prefixRecords
+	prefixRetained
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixBurned      = []byte{prefixBurned}
+	KeyLastDistribution  = []byte{prefixLastDistribution}
+	KeyFailures          = []byte{prefixFailures}
+	KeyPrefixDistributed = []byte{prefixDistributed}
+	KeyPrefixRecords     = []byte{prefixRecords}
+	KeyPrefixRetained    = []byte{prefixRetained}
+)
+
+// DistributedKeyPrefix returns the store key prefix of the total amounts
+// transferred to the destination of a recipient.
+func DistributedKeyPrefix(destination string) []byte {
+	return append(KeyPrefixDistributed, address.MustLengthPrefix([]byte(destination))...)
+}
+
+// RecordKey returns the store key of the distribution record at height.
+func RecordKey(height int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(height))
+}
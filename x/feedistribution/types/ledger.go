@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Validate performs basic validation of a payout.
+func (p Payout) Validate() error {
+	if err := ValidateDestination(p.Recipient); err != nil {
+		return fmt.Errorf("invalid payout: %w", err)
+	}
+	if err := p.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid payout amount for %s: %w", p.Recipient, err)
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of a distribution record.
+func (r DistributionRecord) Validate() error {
+	if r.Height < 0 {
+		return fmt.Errorf("negative distribution record height: %d", r.Height)
+	}
+	for _, payout := range r.Payouts {
+		if err := payout.Validate(); err != nil {
+			return fmt.Errorf("distribution record at height %d: %w", r.Height, err)
+		}
+	}
+	if err := r.Burned.Validate(); err != nil {
+		return fmt.Errorf("distribution record at height %d: invalid burned coins: %w", r.Height, err)
+	}
+
+	return nil
+}
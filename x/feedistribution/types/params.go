@@ -3,18 +3,28 @@ package types
 import (
 	"errors"
 	"fmt"
+	"math"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmath "cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Parameter keys
 var (
-	ParamsKey              = []byte("Params")
-	ParamStoreKeyEnabled   = []byte("Enabled")
-	ParamStoreKeyRecipient = []byte("Recipient")
+	ParamsKey               = []byte("Params")
+	ParamStoreKeyEnabled    = []byte("Enabled")
+	ParamStoreKeyRecipients = []byte("Recipients")
+	ParamStoreKeyDenomRules = []byte("DenomRules")
+	ParamStoreKeyBurnRate   = []byte("BurnRate")
+
+	ParamStoreKeyDistributionInterval = []byte("DistributionInterval")
+	ParamStoreKeyRecordRetention      = []byte("RecordRetention")
 )
 
+// DefaultRecordRetention is the default number of blocks the distribution
+// records are kept for.
+const DefaultRecordRetention uint64 = 100_000
+
 var _ paramtypes.ParamSet = &Params{}
 
 // ParamKeyTable returns the parameter key table.
@@ -25,49 +35,132 @@ func ParamKeyTable() paramtypes.KeyTable {
 // ParamSetPairs returns the parameter set pairs.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
-		paramtypes.NewParamSetPair(ParamStoreKeyRecipient, &p.Recipient, validateRecipient),
+		paramtypes.NewParamSetPair(ParamStoreKeyRecipients, &p.Recipients, validateRecipients),
+		paramtypes.NewParamSetPair(ParamStoreKeyDenomRules, &p.DenomRules, validateDenomRules),
+		paramtypes.NewParamSetPair(ParamStoreKeyBurnRate, &p.BurnRate, validateBurnRate),
+		paramtypes.NewParamSetPair(ParamStoreKeyDistributionInterval, &p.DistributionInterval, validateDistributionInterval),
 		paramtypes.NewParamSetPair(ParamStoreKeyEnabled, &p.Enabled, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyRecordRetention, &p.RecordRetention, validateUint64),
 	}
 }
 
 // NewParams creates a new Params instance
-func NewParams(enabled bool, recipient string) Params {
+func NewParams(enabled bool, recipients ...Recipient) Params {
 	return Params{
-		Enabled:   enabled,
-		Recipient: recipient,
+		Enabled:         enabled,
+		Recipients:      recipients,
+		BurnRate:        sdkmath.LegacyZeroDec(),
+		RecordRetention: DefaultRecordRetention,
+	}
+}
+
+// NewRecipient creates a new Recipient instance
+func NewRecipient(address string, weight uint64) Recipient {
+	return Recipient{
+		Address: address,
+		Weight:  weight,
 	}
 }
 
 // DefaultParams returns default parameters
 func DefaultParams() Params {
-	return Params{}
+	return Params{
+		BurnRate:        sdkmath.LegacyZeroDec(),
+		RecordRetention: DefaultRecordRetention,
+	}
 }
 
 // Validate performs basic validation on feedistribution parameters.
 func (p Params) Validate() error {
-	if p.Enabled && p.Recipient == "" {
-		return errors.New("cannot be enabled without a recipient address")
-	}
+	//nolint:staticcheck // the deprecated field only exists to be migrated
 	if p.Recipient != "" {
-		_, err := sdk.AccAddressFromBech32(p.Recipient)
+		return errors.New("recipient is deprecated, use recipients instead")
+	}
+	if p.Enabled && len(p.Recipients) == 0 && len(p.DenomRules) == 0 && !p.Burns() {
+		return errors.New("cannot be enabled without recipients, denom rules or a burn rate")
+	}
+	if err := validateRecipients(p.Recipients); err != nil {
+		return err
+	}
+	if err := validateDenomRules(p.DenomRules); err != nil {
+		return err
+	}
+	if err := validateBurnRate(p.BurnRate); err != nil {
+		return err
+	}
+
+	return validateDistributionInterval(p.DistributionInterval)
+}
+
+// Retention returns the number of blocks the distribution records are kept
+// for. Params without a record retention, such as the ones of genesis files
+// predating it, keep them for the default retention.
+func (p Params) Retention() uint64 {
+	if p.RecordRetention == 0 {
+		return DefaultRecordRetention
+	}
+	return p.RecordRetention
+}
+
+// Burns returns true if a part of the collected fees is burned.
+func (p Params) Burns() bool {
+	return !p.BurnRate.IsNil() && p.BurnRate.IsPositive()
+}
+
+func validateRecipients(i interface{}) error {
+	recipients, ok := i.([]Recipient)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	var total uint64
+	seen := make(map[string]bool, len(recipients))
+	for _, r := range recipients {
+		err := r.Validate()
 		if err != nil {
 			return err
 		}
+		destination := r.Destination()
+		if seen[destination] {
+			return fmt.Errorf("duplicate recipient %s", destination)
+		}
+		seen[destination] = true
+
+		if r.Weight > math.MaxUint64-total {
+			return errors.New("total recipient weight overflows")
+		}
+		total += r.Weight
 	}
 
 	return nil
 }
 
-func validateRecipient(i interface{}) error {
-	value, ok := i.(string)
+func validateBurnRate(i interface{}) error {
+	rate, ok := i.(sdkmath.LegacyDec)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	if value != "" {
-		_, err := sdk.AccAddressFromBech32(value)
-		if err != nil {
-			return err
-		}
+	// Params stored before the burn rate was introduced have no rate set
+	if rate.IsNil() {
+		return nil
+	}
+	if rate.IsNegative() || rate.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("burn rate must be between 0 and 1: %s", rate)
+	}
+
+	return nil
+}
+
+func validateDistributionInterval(i interface{}) error {
+	interval, ok := i.(DistributionInterval)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if interval.Time < 0 {
+		return fmt.Errorf("negative distribution interval: %s", interval.Time)
+	}
+	if interval.Blocks > 0 && interval.Time > 0 {
+		return errors.New("distribution interval cannot be both in blocks and time")
 	}
 
 	return nil
@@ -81,3 +174,12 @@ func validateBool(i interface{}) error {
 
 	return nil
 }
+
+func validateUint64(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -1,7 +1,11 @@
 package types
 
 import (
+	"math"
 	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -23,7 +27,15 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{"default", DefaultParams(), false},
 		{
 			"valid",
-			NewParams(true, "cosmos147klh7th5jkjy3aajsj2rqvhtvh9mfde37wq5g"),
+			NewParams(true, NewRecipient("cosmos147klh7th5jkjy3aajsj2rqvhtvh9mfde37wq5g", 1)),
+			false,
+		},
+		{
+			"valid weighted recipients",
+			NewParams(true,
+				NewRecipient("cosmos147klh7th5jkjy3aajsj2rqvhtvh9mfde37wq5g", 70),
+				NewRecipient("cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du", 30),
+			),
 			false,
 		},
 		{
@@ -32,18 +44,89 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			false,
 		},
 		{
-			"enabled without recipient",
-			NewParams(true, ""),
+			"enabled without recipients",
+			NewParams(true),
 			true,
 		},
 		{
 			"enabled with an invalid address",
-			NewParams(true, "abcd"),
+			NewParams(true, NewRecipient("abcd", 1)),
 			true,
 		},
 		{
 			"disabled with an invalid address",
-			NewParams(false, "abcd"),
+			NewParams(false, NewRecipient("abcd", 1)),
+			true,
+		},
+		{
+			"zero weight",
+			NewParams(true, NewRecipient("cosmos147klh7th5jkjy3aajsj2rqvhtvh9mfde37wq5g", 0)),
+			true,
+		},
+		{
+			"duplicate recipient",
+			NewParams(true,
+				NewRecipient("cosmos147klh7th5jkjy3aajsj2rqvhtvh9mfde37wq5g", 1),
+				NewRecipient("cosmos147klh7th5jkjy3aajsj2rqvhtvh9mfde37wq5g", 2),
+			),
+			true,
+		},
+		{
+			"total weight overflow",
+			NewParams(true,
+				NewRecipient("cosmos147klh7th5jkjy3aajsj2rqvhtvh9mfde37wq5g", math.MaxUint64),
+				NewRecipient("cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du", 1),
+			),
+			true,
+		},
+		{
+			"enabled with denom rules only",
+			Params{Enabled: true, DenomRules: []DenomRule{NewKeepDenomRule("stake")}},
+			false,
+		},
+		{
+			"invalid denom rule",
+			Params{Enabled: true, DenomRules: []DenomRule{NewKeepDenomRule("ibc/*/*")}},
+			true,
+		},
+		{
+			"burn only",
+			Params{Enabled: true, BurnRate: sdkmath.LegacyOneDec()},
+			false,
+		},
+		{
+			"burn rate above one",
+			Params{Enabled: true, BurnRate: sdkmath.LegacyNewDec(2)},
+			true,
+		},
+		{
+			"negative burn rate",
+			Params{Enabled: true, BurnRate: sdkmath.LegacyNewDec(-1), Recipients: []Recipient{NewRecipient("cosmos147klh7th5jkjy3aajsj2rqvhtvh9mfde37wq5g", 1)}},
+			true,
+		},
+		{
+			"block interval",
+			Params{Enabled: true, BurnRate: sdkmath.LegacyOneDec(), DistributionInterval: DistributionInterval{Blocks: 100}},
+			false,
+		},
+		{
+			"time interval",
+			Params{Enabled: true, BurnRate: sdkmath.LegacyOneDec(), DistributionInterval: DistributionInterval{Time: time.Hour}},
+			false,
+		},
+		{
+			"block and time interval",
+			Params{Enabled: true, BurnRate: sdkmath.LegacyOneDec(), DistributionInterval: DistributionInterval{Blocks: 100, Time: time.Hour}},
+			true,
+		},
+		{
+			"negative time interval",
+			Params{Enabled: true, BurnRate: sdkmath.LegacyOneDec(), DistributionInterval: DistributionInterval{Time: -time.Hour}},
+			true,
+		},
+		{
+			"deprecated recipient",
+			Params{Enabled: true, Recipient: "cosmos147klh7th5jkjy3aajsj2rqvhtvh9mfde37wq5g"},
 			true,
 		},
 	}
@@ -62,7 +145,16 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 func (suite *ParamsTestSuite) TestParamsValidatePriv() {
 	suite.Require().NoError(validateBool(true))
 	suite.Require().Error(validateBool(2))
-	suite.Require().NoError(validateRecipient("cosmos147klh7th5jkjy3aajsj2rqvhtvh9mfde37wq5g"))
-	suite.Require().NoError(validateRecipient(""))
-	suite.Require().Error(validateRecipient("2141231"))
+	suite.Require().NoError(validateRecipients([]Recipient{NewRecipient("cosmos147klh7th5jkjy3aajsj2rqvhtvh9mfde37wq5g", 1)}))
+	suite.Require().NoError(validateRecipients([]Recipient{}))
+	suite.Require().Error(validateRecipients([]Recipient{NewRecipient("2141231", 1)}))
+	suite.Require().Error(validateRecipients("cosmos147klh7th5jkjy3aajsj2rqvhtvh9mfde37wq5g"))
+	suite.Require().NoError(validateBurnRate(sdkmath.LegacyNewDecWithPrec(5, 1)))
+	suite.Require().NoError(validateBurnRate(sdkmath.LegacyDec{}))
+	suite.Require().Error(validateBurnRate("0.5"))
+}
+
+func (suite *ParamsTestSuite) TestParamsRetention() {
+	suite.Require().Equal(DefaultRecordRetention, Params{}.Retention())
+	suite.Require().Equal(uint64(10), Params{RecordRetention: 10}.Retention())
 }
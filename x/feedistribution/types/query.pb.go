@@ -6,10 +6,14 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -17,12 +21,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -113,214 +119,3270 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "saga.feedistribution.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "saga.feedistribution.v1.QueryParamsResponse")
+// QueryBurnedRequest is the request type for the Query/Burned RPC method.
+type QueryBurnedRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("saga/feedistribution/v1/query.proto", fileDescriptor_49927abc768fee68)
+func (m *QueryBurnedRequest) Reset()         { *m = QueryBurnedRequest{} }
+func (m *QueryBurnedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedRequest) ProtoMessage()    {}
+func (*QueryBurnedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49927abc768fee68, []int{2}
+}
+func (m *QueryBurnedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedRequest.Merge(m, src)
+}
+func (m *QueryBurnedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedRequest.DiscardUnknown(m)
 }
 
-var fileDescriptor_49927abc768fee68 = []byte{
-	// 301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x4e, 0x4c, 0x4f,
-	0xd4, 0x4f, 0x4b, 0x4d, 0x4d, 0xc9, 0x2c, 0x2e, 0x29, 0xca, 0x4c, 0x2a, 0x2d, 0xc9, 0xcc, 0xcf,
-	0xd3, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x07, 0x29, 0xd2, 0x43, 0x53, 0xa4, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f,
-	0x56, 0xa3, 0x0f, 0x62, 0x41, 0x94, 0x4b, 0xc9, 0xa4, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x27,
-	0x16, 0x64, 0xea, 0x27, 0xe6, 0xe5, 0xe5, 0x97, 0x24, 0x82, 0xd4, 0x17, 0x43, 0x65, 0x75, 0x71,
-	0xd9, 0x88, 0x6e, 0x3e, 0x44, 0xb9, 0x64, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x3c, 0xc4, 0x16,
-	0x08, 0x07, 0x22, 0xa5, 0x24, 0xc2, 0x25, 0x14, 0x08, 0x72, 0x65, 0x40, 0x62, 0x51, 0x62, 0x6e,
-	0x71, 0x50, 0x6a, 0x61, 0x69, 0x6a, 0x71, 0x89, 0x52, 0x08, 0x97, 0x30, 0x8a, 0x68, 0x71, 0x41,
-	0x7e, 0x5e, 0x71, 0xaa, 0x90, 0x2d, 0x17, 0x5b, 0x01, 0x58, 0x44, 0x82, 0x51, 0x81, 0x51, 0x83,
-	0xdb, 0x48, 0x5e, 0x0f, 0x87, 0xa7, 0xf4, 0x20, 0x1a, 0x9d, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08,
-	0x82, 0x6a, 0x32, 0x9a, 0xc6, 0xc8, 0xc5, 0x0a, 0x36, 0x56, 0xa8, 0x87, 0x91, 0x8b, 0x0d, 0xa2,
-	0x44, 0x48, 0x1b, 0xa7, 0x19, 0x98, 0xee, 0x92, 0xd2, 0x21, 0x4e, 0x31, 0xc4, 0xb9, 0x4a, 0xea,
-	0x4d, 0x97, 0x9f, 0x4c, 0x66, 0x52, 0x14, 0x92, 0xd7, 0xc7, 0x15, 0x5c, 0x10, 0x87, 0x39, 0xf9,
-	0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb,
-	0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x71, 0x7a, 0x66, 0x49, 0x46,
-	0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0xd8, 0x90, 0x8a, 0xca, 0x2a, 0x30, 0xad, 0x5b, 0x9c, 0x92,
-	0xad, 0x5f, 0x81, 0x61, 0x64, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x68, 0x8d, 0x01,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x55, 0xfd, 0x64, 0xad, 0x18, 0x02, 0x00, 0x00,
+var xxx_messageInfo_QueryBurnedRequest proto.InternalMessageInfo
+
+// QueryBurnedResponse is the response type for the Query/Burned RPC method.
+type QueryBurnedResponse struct {
+	// burned is the total amount of fees burned per denom.
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryBurnedResponse) Reset()         { *m = QueryBurnedResponse{} }
+func (m *QueryBurnedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedResponse) ProtoMessage()    {}
+func (*QueryBurnedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49927abc768fee68, []int{3}
+}
+func (m *QueryBurnedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedResponse.Merge(m, src)
+}
+func (m *QueryBurnedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryBurnedResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries params of the feedistribution module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryBurnedResponse) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryNextDistributionRequest is the request type for the
+// Query/NextDistribution RPC method.
+type QueryNextDistributionRequest struct {
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryNextDistributionRequest) Reset()         { *m = QueryNextDistributionRequest{} }
+func (m *QueryNextDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextDistributionRequest) ProtoMessage()    {}
+func (*QueryNextDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49927abc768fee68, []int{4}
+}
+func (m *QueryNextDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextDistributionRequest.Merge(m, src)
+}
+func (m *QueryNextDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextDistributionRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/saga.feedistribution.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNextDistributionRequest proto.InternalMessageInfo
+
+// QueryNextDistributionResponse is the response type for the
+// Query/NextDistribution RPC method.
+type QueryNextDistributionResponse struct {
+	// height is the block height of the next distribution. It is not set when
+	// the distribution interval is time based.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the earliest block time of the next distribution. It is only set
+	// when the distribution interval is time based.
+	Time *time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+	// pending is the amount of fees escrowed until the next distribution.
+	Pending github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=pending,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pending"`
+}
+
+func (m *QueryNextDistributionResponse) Reset()         { *m = QueryNextDistributionResponse{} }
+func (m *QueryNextDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextDistributionResponse) ProtoMessage()    {}
+func (*QueryNextDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49927abc768fee68, []int{5}
+}
+func (m *QueryNextDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryNextDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextDistributionResponse.Merge(m, src)
+}
+func (m *QueryNextDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextDistributionResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries params of the feedistribution module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryNextDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryNextDistributionResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryNextDistributionResponse) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryNextDistributionResponse) GetPending() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryFailuresRequest is the request type for the Query/Failures RPC method.
+type QueryFailuresRequest struct {
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryFailuresRequest) Reset()         { *m = QueryFailuresRequest{} }
+func (m *QueryFailuresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFailuresRequest) ProtoMessage()    {}
+func (*QueryFailuresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49927abc768fee68, []int{6}
+}
+func (m *QueryFailuresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailuresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailuresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+}
+func (m *QueryFailuresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailuresRequest.Merge(m, src)
+}
+func (m *QueryFailuresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailuresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailuresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailuresRequest proto.InternalMessageInfo
+
+// QueryFailuresResponse is the response type for the Query/Failures RPC
+// method.
+type QueryFailuresResponse struct {
+	// failures are the consecutive failures to transfer the collected fees.
+	Failures DistributionFailures `protobuf:"bytes,1,opt,name=failures,proto3" json:"failures"`
+}
+
+func (m *QueryFailuresResponse) Reset()         { *m = QueryFailuresResponse{} }
+func (m *QueryFailuresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFailuresResponse) ProtoMessage()    {}
+func (*QueryFailuresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49927abc768fee68, []int{7}
+}
+func (m *QueryFailuresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailuresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailuresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/saga.feedistribution.v1.Query/Params",
+}
+func (m *QueryFailuresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailuresResponse.Merge(m, src)
+}
+func (m *QueryFailuresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailuresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailuresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailuresResponse proto.InternalMessageInfo
+
+func (m *QueryFailuresResponse) GetFailures() DistributionFailures {
+	if m != nil {
+		return m.Failures
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return DistributionFailures{}
+}
+
+// QueryDistributedRequest is the request type for the Query/Distributed RPC
+// method.
+type QueryDistributedRequest struct {
+	// recipient is the recipient: the bech32 address of an account or module
+	// account, or "ibc:{channel_id}:{receiver}" for an account on another chain.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *QueryDistributedRequest) Reset()         { *m = QueryDistributedRequest{} }
+func (m *QueryDistributedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributedRequest) ProtoMessage()    {}
+func (*QueryDistributedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49927abc768fee68, []int{8}
+}
+func (m *QueryDistributedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDistributedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributedRequest.Merge(m, src)
+}
+func (m *QueryDistributedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributedRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "saga.feedistribution.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "saga/feedistribution/v1/query.proto",
+var xxx_messageInfo_QueryDistributedRequest proto.InternalMessageInfo
+
+func (m *QueryDistributedRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryDistributedResponse is the response type for the Query/Distributed RPC
+// method.
+type QueryDistributedResponse struct {
+	// distributed is the total amount of fees transferred to the recipient.
+	Distributed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=distributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed"`
+}
+
+func (m *QueryDistributedResponse) Reset()         { *m = QueryDistributedResponse{} }
+func (m *QueryDistributedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributedResponse) ProtoMessage()    {}
+func (*QueryDistributedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49927abc768fee68, []int{9}
+}
+func (m *QueryDistributedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDistributedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributedResponse.Merge(m, src)
+}
+func (m *QueryDistributedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDistributedResponse proto.InternalMessageInfo
+
+func (m *QueryDistributedResponse) GetDistributed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Distributed
+	}
+	return nil
+}
+
+// QueryRecordsRequest is the request type for the Query/Records RPC method.
+type QueryRecordsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecordsRequest) Reset()         { *m = QueryRecordsRequest{} }
+func (m *QueryRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecordsRequest) ProtoMessage()    {}
+func (*QueryRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49927abc768fee68, []int{10}
+}
+func (m *QueryRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecordsRequest.Merge(m, src)
+}
+func (m *QueryRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRecordsResponse is the response type for the Query/Records RPC method.
+type QueryRecordsResponse struct {
+	// records are the past fee distributions, ordered by height.
+	Records []DistributionRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecordsResponse) Reset()         { *m = QueryRecordsResponse{} }
+func (m *QueryRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecordsResponse) ProtoMessage()    {}
+func (*QueryRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49927abc768fee68, []int{11}
+}
+func (m *QueryRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecordsResponse.Merge(m, src)
+}
+func (m *QueryRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryRecordsResponse) GetRecords() []DistributionRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeeCollectorRequest is the request type for the Query/FeeCollector RPC
+// method.
+type QueryFeeCollectorRequest struct {
+}
+
+func (m *QueryFeeCollectorRequest) Reset()         { *m = QueryFeeCollectorRequest{} }
+func (m *QueryFeeCollectorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeCollectorRequest) ProtoMessage()    {}
+func (*QueryFeeCollectorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49927abc768fee68, []int{12}
+}
+func (m *QueryFeeCollectorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeCollectorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeCollectorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeCollectorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeCollectorRequest.Merge(m, src)
+}
+func (m *QueryFeeCollectorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeCollectorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeCollectorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeCollectorRequest proto.InternalMessageInfo
+
+// QueryFeeCollectorResponse is the response type for the Query/FeeCollector
+// RPC method.
+type QueryFeeCollectorResponse struct {
+	// balance is the balance of the fee collector module account.
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *QueryFeeCollectorResponse) Reset()         { *m = QueryFeeCollectorResponse{} }
+func (m *QueryFeeCollectorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeCollectorResponse) ProtoMessage()    {}
+func (*QueryFeeCollectorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49927abc768fee68, []int{13}
+}
+func (m *QueryFeeCollectorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeCollectorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeCollectorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeCollectorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeCollectorResponse.Merge(m, src)
+}
+func (m *QueryFeeCollectorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeCollectorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeCollectorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeCollectorResponse proto.InternalMessageInfo
+
+func (m *QueryFeeCollectorResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+// QueryPendingPayoutRequest is the request type for the Query/PendingPayout
+// RPC method.
+type QueryPendingPayoutRequest struct {
+}
+
+func (m *QueryPendingPayoutRequest) Reset()         { *m = QueryPendingPayoutRequest{} }
+func (m *QueryPendingPayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPayoutRequest) ProtoMessage()    {}
+func (*QueryPendingPayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49927abc768fee68, []int{14}
+}
+func (m *QueryPendingPayoutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPayoutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPayoutRequest.Merge(m, src)
+}
+func (m *QueryPendingPayoutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPayoutRequest proto.InternalMessageInfo
+
+// QueryPendingPayoutResponse is the response type for the Query/PendingPayout
+// RPC method.
+type QueryPendingPayoutResponse struct {
+	// simulation is the fee transfer of the next block.
+	Simulation PayoutSimulation `protobuf:"bytes,1,opt,name=simulation,proto3" json:"simulation"`
+}
+
+func (m *QueryPendingPayoutResponse) Reset()         { *m = QueryPendingPayoutResponse{} }
+func (m *QueryPendingPayoutResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPayoutResponse) ProtoMessage()    {}
+func (*QueryPendingPayoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49927abc768fee68, []int{15}
+}
+func (m *QueryPendingPayoutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPayoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPayoutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPayoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPayoutResponse.Merge(m, src)
+}
+func (m *QueryPendingPayoutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPayoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPayoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPayoutResponse proto.InternalMessageInfo
+
+func (m *QueryPendingPayoutResponse) GetSimulation() PayoutSimulation {
+	if m != nil {
+		return m.Simulation
+	}
+	return PayoutSimulation{}
+}
+
+// QuerySimulatePayoutRequest is the request type for the Query/SimulatePayout
+// RPC method.
+type QuerySimulatePayoutRequest struct {
+	// params are the proposed params.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QuerySimulatePayoutRequest) Reset()         { *m = QuerySimulatePayoutRequest{} }
+func (m *QuerySimulatePayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulatePayoutRequest) ProtoMessage()    {}
+func (*QuerySimulatePayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49927abc768fee68, []int{16}
+}
+func (m *QuerySimulatePayoutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulatePayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulatePayoutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulatePayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulatePayoutRequest.Merge(m, src)
+}
+func (m *QuerySimulatePayoutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulatePayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulatePayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulatePayoutRequest proto.InternalMessageInfo
+
+func (m *QuerySimulatePayoutRequest) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// QuerySimulatePayoutResponse is the response type for the
+// Query/SimulatePayout RPC method.
+type QuerySimulatePayoutResponse struct {
+	// simulation is the fee transfer of the next block under the proposed
+	// params.
+	Simulation PayoutSimulation `protobuf:"bytes,1,opt,name=simulation,proto3" json:"simulation"`
+}
+
+func (m *QuerySimulatePayoutResponse) Reset()         { *m = QuerySimulatePayoutResponse{} }
+func (m *QuerySimulatePayoutResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulatePayoutResponse) ProtoMessage()    {}
+func (*QuerySimulatePayoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49927abc768fee68, []int{17}
+}
+func (m *QuerySimulatePayoutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulatePayoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulatePayoutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulatePayoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulatePayoutResponse.Merge(m, src)
+}
+func (m *QuerySimulatePayoutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulatePayoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulatePayoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulatePayoutResponse proto.InternalMessageInfo
+
+func (m *QuerySimulatePayoutResponse) GetSimulation() PayoutSimulation {
+	if m != nil {
+		return m.Simulation
+	}
+	return PayoutSimulation{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "saga.feedistribution.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "saga.feedistribution.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryBurnedRequest)(nil), "saga.feedistribution.v1.QueryBurnedRequest")
+	proto.RegisterType((*QueryBurnedResponse)(nil), "saga.feedistribution.v1.QueryBurnedResponse")
+	proto.RegisterType((*QueryNextDistributionRequest)(nil), "saga.feedistribution.v1.QueryNextDistributionRequest")
+	proto.RegisterType((*QueryNextDistributionResponse)(nil), "saga.feedistribution.v1.QueryNextDistributionResponse")
+	proto.RegisterType((*QueryFailuresRequest)(nil), "saga.feedistribution.v1.QueryFailuresRequest")
+	proto.RegisterType((*QueryFailuresResponse)(nil), "saga.feedistribution.v1.QueryFailuresResponse")
+	proto.RegisterType((*QueryDistributedRequest)(nil), "saga.feedistribution.v1.QueryDistributedRequest")
+	proto.RegisterType((*QueryDistributedResponse)(nil), "saga.feedistribution.v1.QueryDistributedResponse")
+	proto.RegisterType((*QueryRecordsRequest)(nil), "saga.feedistribution.v1.QueryRecordsRequest")
+	proto.RegisterType((*QueryRecordsResponse)(nil), "saga.feedistribution.v1.QueryRecordsResponse")
+	proto.RegisterType((*QueryFeeCollectorRequest)(nil), "saga.feedistribution.v1.QueryFeeCollectorRequest")
+	proto.RegisterType((*QueryFeeCollectorResponse)(nil), "saga.feedistribution.v1.QueryFeeCollectorResponse")
+	proto.RegisterType((*QueryPendingPayoutRequest)(nil), "saga.feedistribution.v1.QueryPendingPayoutRequest")
+	proto.RegisterType((*QueryPendingPayoutResponse)(nil), "saga.feedistribution.v1.QueryPendingPayoutResponse")
+	proto.RegisterType((*QuerySimulatePayoutRequest)(nil), "saga.feedistribution.v1.QuerySimulatePayoutRequest")
+	proto.RegisterType((*QuerySimulatePayoutResponse)(nil), "saga.feedistribution.v1.QuerySimulatePayoutResponse")
+}
+
+func init() {
+	proto.RegisterFile("saga/feedistribution/v1/query.proto", fileDescriptor_49927abc768fee68)
+}
+
+var fileDescriptor_49927abc768fee68 = []byte{
+	// 996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0x4d, 0x70, 0xda, 0x67, 0x40, 0x68, 0x08, 0xad, 0xbb, 0x0d, 0x76, 0xba, 0x95,
+	0x1a, 0x27, 0xd4, 0xbb, 0xb1, 0x53, 0x7e, 0x08, 0x89, 0x8b, 0x8b, 0xc2, 0x01, 0x01, 0xc1, 0xf4,
+	0x04, 0x42, 0xd1, 0x7a, 0x3d, 0xd9, 0xac, 0xb0, 0x77, 0xb6, 0xfb, 0x23, 0x4a, 0x8a, 0xb8, 0xf4,
+	0x86, 0xc4, 0x21, 0x12, 0xfc, 0x0b, 0x1c, 0x40, 0x3d, 0x70, 0xe1, 0xc8, 0xbd, 0xc7, 0x4a, 0x70,
+	0xe0, 0x44, 0x50, 0xc2, 0x1f, 0x82, 0x76, 0xe6, 0xcd, 0x66, 0xd7, 0xf6, 0xfa, 0x87, 0x20, 0xa7,
+	0xc4, 0x33, 0xef, 0xc7, 0xe7, 0xbd, 0x37, 0x7e, 0x5f, 0xc3, 0x9d, 0xd0, 0x72, 0x2c, 0x73, 0x9f,
+	0xb1, 0x9e, 0x1b, 0x46, 0x81, 0xdb, 0x8d, 0x23, 0x97, 0x7b, 0xe6, 0x61, 0xd3, 0x7c, 0x14, 0xb3,
+	0xe0, 0xd8, 0xf0, 0x03, 0x1e, 0x71, 0x7a, 0x23, 0x31, 0x32, 0x86, 0x8c, 0x8c, 0xc3, 0xa6, 0xb6,
+	0xe2, 0x70, 0x87, 0x0b, 0x1b, 0x33, 0xf9, 0x4f, 0x9a, 0x6b, 0xab, 0x0e, 0xe7, 0x4e, 0x9f, 0x99,
+	0x96, 0xef, 0x9a, 0x96, 0xe7, 0xf1, 0xc8, 0x4a, 0xec, 0x43, 0xbc, 0x6d, 0x14, 0x65, 0x1c, 0x8e,
+	0x2f, 0xcd, 0xab, 0x36, 0x0f, 0x07, 0x3c, 0x34, 0xbb, 0x56, 0xc8, 0xcc, 0xc3, 0x66, 0x97, 0x45,
+	0x56, 0xd3, 0xb4, 0xb9, 0xab, 0xee, 0x37, 0xb3, 0xf7, 0x02, 0x3a, 0xb5, 0xf2, 0x2d, 0xc7, 0xf5,
+	0xac, 0x4c, 0xac, 0x1a, 0x82, 0x89, 0x4f, 0xdd, 0x78, 0xdf, 0x8c, 0xdc, 0x01, 0x0b, 0x23, 0x6b,
+	0xe0, 0x4b, 0x03, 0x7d, 0x05, 0xe8, 0xa7, 0x49, 0x88, 0x5d, 0x2b, 0xb0, 0x06, 0x61, 0x87, 0x3d,
+	0x8a, 0x59, 0x18, 0xe9, 0x0f, 0xe1, 0xd5, 0xdc, 0x69, 0xe8, 0x73, 0x2f, 0x64, 0xf4, 0x3d, 0x28,
+	0xf9, 0xe2, 0xa4, 0x42, 0xd6, 0x48, 0xbd, 0xdc, 0xaa, 0x19, 0x05, 0x6d, 0x32, 0xa4, 0x63, 0x7b,
+	0xe9, 0xd9, 0x5f, 0xb5, 0x85, 0x0e, 0x3a, 0xa5, 0xb9, 0xda, 0x71, 0xe0, 0xb1, 0x9e, 0xca, 0xf5,
+	0x18, 0x73, 0xa9, 0x53, 0xcc, 0x65, 0x43, 0xa9, 0x2b, 0x4e, 0x2a, 0x64, 0x6d, 0xb1, 0x5e, 0x6e,
+	0xdd, 0x34, 0x64, 0xd9, 0x46, 0x52, 0xb6, 0x81, 0x05, 0x1b, 0x0f, 0xb8, 0xeb, 0xb5, 0xb7, 0x92,
+	0x2c, 0x3f, 0x9f, 0xd6, 0xea, 0x8e, 0x1b, 0x1d, 0xc4, 0x5d, 0xc3, 0xe6, 0x03, 0x13, 0x7b, 0x24,
+	0xff, 0x34, 0xc2, 0xde, 0x57, 0x66, 0x74, 0xec, 0xb3, 0x50, 0x38, 0x84, 0x1d, 0x0c, 0xad, 0x57,
+	0x61, 0x55, 0xe4, 0xfe, 0x98, 0x1d, 0x45, 0xef, 0x67, 0x4a, 0x50, 0x6c, 0x7f, 0x10, 0x78, 0xbd,
+	0xc0, 0x00, 0x31, 0xaf, 0x43, 0xe9, 0x80, 0xb9, 0xce, 0x41, 0x24, 0x5a, 0xb2, 0xd8, 0xc1, 0x4f,
+	0xf4, 0x3e, 0x2c, 0x25, 0xad, 0xae, 0x5c, 0x11, 0x8d, 0xd2, 0x0c, 0x39, 0x07, 0x43, 0xcd, 0xc1,
+	0x78, 0xa8, 0xe6, 0xd0, 0x5e, 0x3a, 0x39, 0xad, 0x91, 0x8e, 0xb0, 0xa6, 0x0c, 0x96, 0x7d, 0xe6,
+	0xf5, 0x5c, 0xcf, 0xa9, 0x2c, 0xfe, 0xff, 0x55, 0xab, 0xd8, 0xfa, 0x75, 0x58, 0x11, 0x55, 0xed,
+	0x58, 0x6e, 0x3f, 0x0e, 0x58, 0x3a, 0xf6, 0x03, 0x78, 0x6d, 0xe8, 0x1c, 0xab, 0xfc, 0x04, 0xae,
+	0xee, 0xe3, 0x19, 0x8e, 0xbe, 0x51, 0x38, 0xfa, 0x6c, 0x9b, 0x54, 0x20, 0x7c, 0x08, 0x69, 0x10,
+	0xfd, 0x6d, 0xb8, 0x21, 0x32, 0xa5, 0xc6, 0xe9, 0x7b, 0xa0, 0xab, 0x70, 0x2d, 0x60, 0xb6, 0xeb,
+	0xbb, 0xcc, 0x93, 0x4d, 0xbd, 0xd6, 0xb9, 0x38, 0xd0, 0xbf, 0x25, 0x50, 0x19, 0xf5, 0x44, 0xcc,
+	0x01, 0x94, 0x7b, 0x17, 0xc7, 0x97, 0xf1, 0x70, 0xb2, 0xf1, 0xf5, 0x2f, 0xf1, 0xe5, 0x76, 0x98,
+	0xcd, 0x83, 0x9e, 0xea, 0x22, 0xdd, 0x01, 0xb8, 0xf8, 0x1e, 0x62, 0xbb, 0xee, 0xe6, 0x20, 0xe4,
+	0xa6, 0x51, 0x28, 0xbb, 0x96, 0xc3, 0xd0, 0xb7, 0x93, 0xf1, 0xd4, 0x9f, 0x12, 0x1c, 0x53, 0x1a,
+	0x1f, 0xcb, 0xfc, 0x10, 0x96, 0x03, 0x79, 0x84, 0x25, 0xbe, 0x31, 0xd3, 0x30, 0x64, 0x18, 0x1c,
+	0x85, 0x8a, 0x40, 0x3f, 0xc8, 0xd1, 0xca, 0xe7, 0xba, 0x3e, 0x95, 0x56, 0x92, 0xe4, 0x70, 0x35,
+	0x1c, 0xcc, 0x0e, 0x63, 0x0f, 0x78, 0xbf, 0xcf, 0xec, 0x88, 0x07, 0xea, 0x61, 0x3d, 0x21, 0x70,
+	0x73, 0xcc, 0x25, 0xd6, 0xc3, 0x60, 0xb9, 0x6b, 0xf5, 0x2d, 0xcf, 0x66, 0x97, 0x31, 0x32, 0x15,
+	0x5b, 0xbf, 0x85, 0x0c, 0xbb, 0xf2, 0x5b, 0xb0, 0x6b, 0x1d, 0xf3, 0x38, 0x52, 0x84, 0x03, 0xd0,
+	0xc6, 0x5d, 0xa6, 0xef, 0x1f, 0x42, 0x77, 0x10, 0xf7, 0xb3, 0x23, 0xdd, 0x98, 0xb0, 0xfc, 0x12,
+	0xe7, 0xcf, 0x52, 0x07, 0x6c, 0x79, 0x26, 0x84, 0xfe, 0x05, 0xa6, 0x43, 0x23, 0x96, 0x83, 0xf9,
+	0xaf, 0x7b, 0xd6, 0x83, 0x5b, 0x63, 0x83, 0x5f, 0x52, 0x31, 0xad, 0xdf, 0xca, 0xf0, 0x82, 0x48,
+	0x48, 0xbf, 0x23, 0x50, 0x92, 0x48, 0xb4, 0xf8, 0x4d, 0x8e, 0xea, 0x8d, 0x76, 0x6f, 0x36, 0x63,
+	0x59, 0x80, 0xbe, 0xfe, 0xe4, 0xf7, 0x7f, 0xbe, 0xbf, 0x72, 0x9b, 0xd6, 0xcc, 0x22, 0x61, 0x95,
+	0x8d, 0x10, 0x38, 0x52, 0x56, 0xa6, 0xe1, 0xe4, 0x24, 0x69, 0x1a, 0x4e, 0x5e, 0xa9, 0x66, 0xc0,
+	0x91, 0x6a, 0x43, 0x7f, 0x25, 0xf0, 0xca, 0xb0, 0x90, 0xd0, 0x37, 0x27, 0xe7, 0x2a, 0x50, 0x26,
+	0xed, 0xad, 0x79, 0xdd, 0x10, 0xb6, 0x25, 0x60, 0xef, 0xd1, 0xcd, 0x42, 0x58, 0x8f, 0x1d, 0x45,
+	0x7b, 0xd9, 0x43, 0xfa, 0x03, 0x81, 0xab, 0x6a, 0x93, 0xd3, 0xc6, 0xe4, 0xc4, 0x43, 0x92, 0xa2,
+	0x19, 0xb3, 0x9a, 0x23, 0xdf, 0x86, 0xe0, 0xbb, 0x43, 0x6f, 0x17, 0xf2, 0x29, 0x0d, 0xa1, 0x4f,
+	0x09, 0x94, 0x33, 0x2a, 0x40, 0xb7, 0x26, 0xa7, 0x1a, 0x95, 0x1a, 0xad, 0x39, 0x87, 0x07, 0xf2,
+	0xbd, 0x23, 0xf8, 0x5a, 0x74, 0xab, 0x90, 0x2f, 0xa3, 0x10, 0xe6, 0xd7, 0xa9, 0x70, 0x7d, 0x43,
+	0x4f, 0x08, 0x2c, 0xe3, 0x26, 0xa7, 0x53, 0x1e, 0x58, 0x5e, 0x50, 0xb4, 0xc6, 0x8c, 0xd6, 0x88,
+	0x58, 0x17, 0x88, 0x3a, 0x5d, 0x2b, 0x44, 0x54, 0xbb, 0xff, 0x47, 0x02, 0x2f, 0x66, 0x37, 0x32,
+	0x9d, 0xd2, 0x90, 0x31, 0xab, 0x5d, 0x6b, 0xcd, 0xe3, 0x82, 0x84, 0x86, 0x20, 0xac, 0xd3, 0xbb,
+	0xe6, 0x84, 0x5f, 0xc6, 0x7b, 0x76, 0x8a, 0xf5, 0x13, 0x81, 0x97, 0x72, 0x8b, 0x99, 0x4e, 0xc9,
+	0x3a, 0x6e, 0xc5, 0x6b, 0xdb, 0x73, 0xf9, 0x20, 0xaa, 0x29, 0x50, 0x37, 0xe8, 0x7a, 0xf1, 0xae,
+	0x91, 0x7e, 0x7b, 0xbe, 0x24, 0xfb, 0x85, 0xc0, 0xcb, 0xf9, 0xc5, 0x4b, 0xa7, 0x24, 0x1e, 0xab,
+	0x01, 0xda, 0xfd, 0xf9, 0x9c, 0x10, 0x77, 0x5b, 0xe0, 0x36, 0xde, 0x25, 0x9b, 0x7a, 0xbd, 0x90,
+	0x18, 0x57, 0x37, 0x43, 0xe4, 0xf6, 0x47, 0xcf, 0xce, 0xaa, 0xe4, 0xf9, 0x59, 0x95, 0xfc, 0x7d,
+	0x56, 0x25, 0x27, 0xe7, 0xd5, 0x85, 0xe7, 0xe7, 0xd5, 0x85, 0x3f, 0xcf, 0xab, 0x0b, 0x9f, 0x6f,
+	0x67, 0x54, 0x36, 0x89, 0x76, 0x74, 0xfc, 0x58, 0xfc, 0x15, 0x22, 0x7b, 0x34, 0x12, 0x5b, 0xc8,
+	0x6e, 0xb7, 0x24, 0x7e, 0xe4, 0x6e, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x8d, 0x74, 0xaa,
+	0x69, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries params of the feedistribution module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Burned queries the total amount of fees burned per denom.
+	Burned(ctx context.Context, in *QueryBurnedRequest, opts ...grpc.CallOption) (*QueryBurnedResponse, error)
+	// NextDistribution queries when the next fee distribution happens and the
+	// amount of fees pending until then.
+	NextDistribution(ctx context.Context, in *QueryNextDistributionRequest, opts ...grpc.CallOption) (*QueryNextDistributionResponse, error)
+	// Failures queries the consecutive failures to transfer the collected fees.
+	Failures(ctx context.Context, in *QueryFailuresRequest, opts ...grpc.CallOption) (*QueryFailuresResponse, error)
+	// Distributed queries the total amount of fees transferred to a recipient.
+	Distributed(ctx context.Context, in *QueryDistributedRequest, opts ...grpc.CallOption) (*QueryDistributedResponse, error)
+	// Records queries the past fee distributions.
+	Records(ctx context.Context, in *QueryRecordsRequest, opts ...grpc.CallOption) (*QueryRecordsResponse, error)
+	// FeeCollector queries the fees currently collected in the fee collector.
+	FeeCollector(ctx context.Context, in *QueryFeeCollectorRequest, opts ...grpc.CallOption) (*QueryFeeCollectorResponse, error)
+	// PendingPayout simulates the fee transfer of the next block under the
+	// current params.
+	PendingPayout(ctx context.Context, in *QueryPendingPayoutRequest, opts ...grpc.CallOption) (*QueryPendingPayoutResponse, error)
+	// SimulatePayout simulates the fee transfer of the next block under
+	// proposed params, e.g. those of a MsgUpdateParams proposal.
+	SimulatePayout(ctx context.Context, in *QuerySimulatePayoutRequest, opts ...grpc.CallOption) (*QuerySimulatePayoutResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/saga.feedistribution.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Burned(ctx context.Context, in *QueryBurnedRequest, opts ...grpc.CallOption) (*QueryBurnedResponse, error) {
+	out := new(QueryBurnedResponse)
+	err := c.cc.Invoke(ctx, "/saga.feedistribution.v1.Query/Burned", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextDistribution(ctx context.Context, in *QueryNextDistributionRequest, opts ...grpc.CallOption) (*QueryNextDistributionResponse, error) {
+	out := new(QueryNextDistributionResponse)
+	err := c.cc.Invoke(ctx, "/saga.feedistribution.v1.Query/NextDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Failures(ctx context.Context, in *QueryFailuresRequest, opts ...grpc.CallOption) (*QueryFailuresResponse, error) {
+	out := new(QueryFailuresResponse)
+	err := c.cc.Invoke(ctx, "/saga.feedistribution.v1.Query/Failures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Distributed(ctx context.Context, in *QueryDistributedRequest, opts ...grpc.CallOption) (*QueryDistributedResponse, error) {
+	out := new(QueryDistributedResponse)
+	err := c.cc.Invoke(ctx, "/saga.feedistribution.v1.Query/Distributed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Records(ctx context.Context, in *QueryRecordsRequest, opts ...grpc.CallOption) (*QueryRecordsResponse, error) {
+	out := new(QueryRecordsResponse)
+	err := c.cc.Invoke(ctx, "/saga.feedistribution.v1.Query/Records", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeCollector(ctx context.Context, in *QueryFeeCollectorRequest, opts ...grpc.CallOption) (*QueryFeeCollectorResponse, error) {
+	out := new(QueryFeeCollectorResponse)
+	err := c.cc.Invoke(ctx, "/saga.feedistribution.v1.Query/FeeCollector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingPayout(ctx context.Context, in *QueryPendingPayoutRequest, opts ...grpc.CallOption) (*QueryPendingPayoutResponse, error) {
+	out := new(QueryPendingPayoutResponse)
+	err := c.cc.Invoke(ctx, "/saga.feedistribution.v1.Query/PendingPayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulatePayout(ctx context.Context, in *QuerySimulatePayoutRequest, opts ...grpc.CallOption) (*QuerySimulatePayoutResponse, error) {
+	out := new(QuerySimulatePayoutResponse)
+	err := c.cc.Invoke(ctx, "/saga.feedistribution.v1.Query/SimulatePayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries params of the feedistribution module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Burned queries the total amount of fees burned per denom.
+	Burned(context.Context, *QueryBurnedRequest) (*QueryBurnedResponse, error)
+	// NextDistribution queries when the next fee distribution happens and the
+	// amount of fees pending until then.
+	NextDistribution(context.Context, *QueryNextDistributionRequest) (*QueryNextDistributionResponse, error)
+	// Failures queries the consecutive failures to transfer the collected fees.
+	Failures(context.Context, *QueryFailuresRequest) (*QueryFailuresResponse, error)
+	// Distributed queries the total amount of fees transferred to a recipient.
+	Distributed(context.Context, *QueryDistributedRequest) (*QueryDistributedResponse, error)
+	// Records queries the past fee distributions.
+	Records(context.Context, *QueryRecordsRequest) (*QueryRecordsResponse, error)
+	// FeeCollector queries the fees currently collected in the fee collector.
+	FeeCollector(context.Context, *QueryFeeCollectorRequest) (*QueryFeeCollectorResponse, error)
+	// PendingPayout simulates the fee transfer of the next block under the
+	// current params.
+	PendingPayout(context.Context, *QueryPendingPayoutRequest) (*QueryPendingPayoutResponse, error)
+	// SimulatePayout simulates the fee transfer of the next block under
+	// proposed params, e.g. those of a MsgUpdateParams proposal.
+	SimulatePayout(context.Context, *QuerySimulatePayoutRequest) (*QuerySimulatePayoutResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Burned(ctx context.Context, req *QueryBurnedRequest) (*QueryBurnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burned not implemented")
+}
+func (*UnimplementedQueryServer) NextDistribution(ctx context.Context, req *QueryNextDistributionRequest) (*QueryNextDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextDistribution not implemented")
+}
+func (*UnimplementedQueryServer) Failures(ctx context.Context, req *QueryFailuresRequest) (*QueryFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Failures not implemented")
+}
+func (*UnimplementedQueryServer) Distributed(ctx context.Context, req *QueryDistributedRequest) (*QueryDistributedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Distributed not implemented")
+}
+func (*UnimplementedQueryServer) Records(ctx context.Context, req *QueryRecordsRequest) (*QueryRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Records not implemented")
+}
+func (*UnimplementedQueryServer) FeeCollector(ctx context.Context, req *QueryFeeCollectorRequest) (*QueryFeeCollectorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeCollector not implemented")
+}
+func (*UnimplementedQueryServer) PendingPayout(ctx context.Context, req *QueryPendingPayoutRequest) (*QueryPendingPayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPayout not implemented")
+}
+func (*UnimplementedQueryServer) SimulatePayout(ctx context.Context, req *QuerySimulatePayoutRequest) (*QuerySimulatePayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulatePayout not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.feedistribution.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Burned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Burned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.feedistribution.v1.Query/Burned",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Burned(ctx, req.(*QueryBurnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.feedistribution.v1.Query/NextDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextDistribution(ctx, req.(*QueryNextDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Failures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Failures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.feedistribution.v1.Query/Failures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Failures(ctx, req.(*QueryFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Distributed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Distributed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.feedistribution.v1.Query/Distributed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Distributed(ctx, req.(*QueryDistributedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Records_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Records(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.feedistribution.v1.Query/Records",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Records(ctx, req.(*QueryRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeCollector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeCollectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeCollector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.feedistribution.v1.Query/FeeCollector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeCollector(ctx, req.(*QueryFeeCollectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingPayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.feedistribution.v1.Query/PendingPayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPayout(ctx, req.(*QueryPendingPayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulatePayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulatePayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulatePayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/saga.feedistribution.v1.Query/SimulatePayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulatePayout(ctx, req.(*QuerySimulatePayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "saga.feedistribution.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Burned",
+			Handler:    _Query_Burned_Handler,
+		},
+		{
+			MethodName: "NextDistribution",
+			Handler:    _Query_NextDistribution_Handler,
+		},
+		{
+			MethodName: "Failures",
+			Handler:    _Query_Failures_Handler,
+		},
+		{
+			MethodName: "Distributed",
+			Handler:    _Query_Distributed_Handler,
+		},
+		{
+			MethodName: "Records",
+			Handler:    _Query_Records_Handler,
+		},
+		{
+			MethodName: "FeeCollector",
+			Handler:    _Query_FeeCollector_Handler,
+		},
+		{
+			MethodName: "PendingPayout",
+			Handler:    _Query_PendingPayout_Handler,
+		},
+		{
+			MethodName: "SimulatePayout",
+			Handler:    _Query_SimulatePayout_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "saga/feedistribution/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Time != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailuresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailuresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailuresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailuresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailuresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailuresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Failures.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distributed) > 0 {
+		for iNdEx := len(m.Distributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeCollectorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeCollectorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeCollectorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeCollectorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeCollectorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeCollectorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPayoutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPayoutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPayoutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPayoutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPayoutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPayoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Simulation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulatePayoutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulatePayoutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulatePayoutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulatePayoutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulatePayoutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulatePayoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Simulation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBurnedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNextDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNextDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFailuresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFailuresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Failures.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDistributedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDistributedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Distributed) > 0 {
+		for _, e := range m.Distributed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeCollectorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeCollectorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingPayoutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingPayoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Simulation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulatePayoutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulatePayoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Simulation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, types.Coin{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailuresRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailuresRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailuresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailuresResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailuresResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailuresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Failures.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributed = append(m.Distributed, types.Coin{})
+			if err := m.Distributed[len(m.Distributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, DistributionRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeCollectorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeCollectorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeCollectorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeCollectorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeCollectorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeCollectorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+func (m *QueryPendingPayoutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPayoutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPayoutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingPayoutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPayoutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPayoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Simulation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Simulation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulatePayoutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -343,12 +3405,45 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulatePayoutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulatePayoutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -370,7 +3465,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulatePayoutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -393,15 +3488,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulatePayoutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulatePayoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Simulation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -428,7 +3523,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Simulation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
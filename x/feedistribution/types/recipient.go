@@ -0,0 +1,152 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+const (
+	// CommunityPoolModule is the module recipient whose fees fund the
+	// community pool of x/distribution.
+	CommunityPoolModule = "distribution"
+
+	// IBCDestinationPrefix prefixes the destination of the recipients on
+	// other chains.
+	IBCDestinationPrefix = "ibc:"
+
+	// DefaultIBCTimeout is the relative timeout of the transfers to the
+	// recipients on other chains that do not set one.
+	DefaultIBCTimeout = 10 * time.Minute
+
+	// MaxIBCReceiverLength is the maximum length of the address of a
+	// recipient on another chain, which keeps its destination short enough
+	// to be part of a store key.
+	MaxIBCReceiverLength = 128
+)
+
+var moduleNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// NewModuleRecipient creates a new Recipient instance for a module account
+func NewModuleRecipient(module string, weight uint64) Recipient {
+	return Recipient{
+		Module: module,
+		Weight: weight,
+	}
+}
+
+// NewIBCRecipient creates a new Recipient instance for an account on another
+// chain
+func NewIBCRecipient(channelID, receiver string, timeout time.Duration, weight uint64) Recipient {
+	return Recipient{
+		Ibc: &IBCRecipient{
+			ChannelId: channelID,
+			Receiver:  receiver,
+			Timeout:   timeout,
+		},
+		Weight: weight,
+	}
+}
+
+// Destination returns where the fees of the recipient are transferred: the
+// bech32 address of an account or module account, or
+// "ibc:{channel_id}:{receiver}" for an account on another chain.
+func (r Recipient) Destination() string {
+	switch {
+	case r.Module != "":
+		return authtypes.NewModuleAddress(r.Module).String()
+	case r.Ibc != nil:
+		return IBCDestinationPrefix + r.Ibc.ChannelId + ":" + r.Ibc.Receiver
+	default:
+		return r.Address
+	}
+}
+
+// Validate performs basic validation of a fee recipient.
+func (r Recipient) Validate() error {
+	var set int
+	for _, ok := range []bool{r.Address != "", r.Module != "", r.Ibc != nil} {
+		if ok {
+			set++
+		}
+	}
+	if set != 1 {
+		return errors.New("recipient must set exactly one of address, module and ibc")
+	}
+
+	switch {
+	case r.Module != "":
+		if !moduleNameRegex.MatchString(r.Module) {
+			return fmt.Errorf("invalid recipient module name %s", r.Module)
+		}
+		if r.Module == ModuleName {
+			return fmt.Errorf("recipient cannot be the %s module", ModuleName)
+		}
+	case r.Ibc != nil:
+		if err := r.Ibc.Validate(); err != nil {
+			return err
+		}
+	default:
+		if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+			return fmt.Errorf("invalid recipient address %s: %w", r.Address, err)
+		}
+	}
+	if r.Weight == 0 {
+		return fmt.Errorf("recipient %s has a zero weight", r.Destination())
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of a recipient on another chain.
+func (r IBCRecipient) Validate() error {
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return fmt.Errorf("invalid recipient channel %s: %w", r.ChannelId, err)
+	}
+	if strings.TrimSpace(r.Receiver) == "" {
+		return errors.New("recipient receiver cannot be blank")
+	}
+	if len(r.Receiver) > MaxIBCReceiverLength {
+		return fmt.Errorf("recipient receiver cannot exceed %d bytes", MaxIBCReceiverLength)
+	}
+	if r.Timeout < 0 {
+		return fmt.Errorf("negative recipient timeout: %s", r.Timeout)
+	}
+
+	return nil
+}
+
+// TransferTimeout returns the relative timeout of the transfers to the
+// recipient.
+func (r IBCRecipient) TransferTimeout() time.Duration {
+	if r.Timeout == 0 {
+		return DefaultIBCTimeout
+	}
+
+	return r.Timeout
+}
+
+// ValidateDestination validates the destination of a recipient as returned by
+// Recipient.Destination.
+func ValidateDestination(destination string) error {
+	if rest, ok := strings.CutPrefix(destination, IBCDestinationPrefix); ok {
+		channelID, receiver, found := strings.Cut(rest, ":")
+		if !found {
+			return fmt.Errorf("invalid recipient %s", destination)
+		}
+
+		return IBCRecipient{ChannelId: channelID, Receiver: receiver}.Validate()
+	}
+
+	if _, err := sdk.AccAddressFromBech32(destination); err != nil {
+		return fmt.Errorf("invalid recipient %s: %w", destination, err)
+	}
+
+	return nil
+}
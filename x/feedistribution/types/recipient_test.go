@@ -0,0 +1,84 @@
+package types
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type RecipientTestSuite struct {
+	suite.Suite
+}
+
+func TestRecipientTestSuite(t *testing.T) {
+	suite.Run(t, new(RecipientTestSuite))
+}
+
+func (suite *RecipientTestSuite) TestRecipientValidate() {
+	testCases := []struct {
+		name      string
+		recipient Recipient
+		expError  bool
+	}{
+		{"account", NewRecipient("cosmos147klh7th5jkjy3aajsj2rqvhtvh9mfde37wq5g", 1), false},
+		{"module", NewModuleRecipient("gov", 1), false},
+		{"community pool", NewModuleRecipient(CommunityPoolModule, 1), false},
+		{"ibc", NewIBCRecipient("channel-0", "saga1receiver", time.Hour, 1), false},
+		{"ibc with the default timeout", NewIBCRecipient("channel-0", "saga1receiver", 0, 1), false},
+		{"nothing set", Recipient{Weight: 1}, true},
+		{
+			"address and module",
+			Recipient{Address: "cosmos147klh7th5jkjy3aajsj2rqvhtvh9mfde37wq5g", Module: "gov", Weight: 1},
+			true,
+		},
+		{
+			"module and ibc",
+			Recipient{Module: "gov", Ibc: &IBCRecipient{ChannelId: "channel-0", Receiver: "saga1receiver"}, Weight: 1},
+			true,
+		},
+		{"invalid module name", NewModuleRecipient("Gov!", 1), true},
+		{"own module", NewModuleRecipient(ModuleName, 1), true},
+		{"zero weight module", NewModuleRecipient("gov", 0), true},
+		{"invalid channel", NewIBCRecipient("0", "saga1receiver", 0, 1), true},
+		{"blank receiver", NewIBCRecipient("channel-0", " ", 0, 1), true},
+		{"long receiver", NewIBCRecipient("channel-0", strings.Repeat("a", MaxIBCReceiverLength+1), 0, 1), true},
+		{"negative timeout", NewIBCRecipient("channel-0", "saga1receiver", -time.Second, 1), true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.recipient.Validate()
+			if tc.expError {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *RecipientTestSuite) TestDestination() {
+	suite.Require().Equal(authtypes.NewModuleAddress("gov").String(), NewModuleRecipient("gov", 1).Destination())
+	suite.Require().Equal("ibc:channel-0:saga1receiver", NewIBCRecipient("channel-0", "saga1receiver", 0, 1).Destination())
+
+	// A module recipient and its account are the same recipient
+	params := NewParams(true,
+		NewModuleRecipient("gov", 1),
+		NewRecipient(authtypes.NewModuleAddress("gov").String(), 1),
+	)
+	suite.Require().Error(params.Validate())
+
+	suite.Require().NoError(ValidateDestination(authtypes.NewModuleAddress("gov").String()))
+	suite.Require().NoError(ValidateDestination("ibc:channel-0:saga1receiver"))
+	suite.Require().Error(ValidateDestination("ibc:channel-0"))
+	suite.Require().Error(ValidateDestination("ibc:0:saga1receiver"))
+	suite.Require().Error(ValidateDestination("gov"))
+}
+
+func (suite *RecipientTestSuite) TestTransferTimeout() {
+	suite.Require().Equal(DefaultIBCTimeout, IBCRecipient{}.TransferTimeout())
+	suite.Require().Equal(time.Hour, IBCRecipient{Timeout: time.Hour}.TransferTimeout())
+}
@@ -0,0 +1,185 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DenomWildcard matches any suffix when it terminates a denom rule.
+const DenomWildcard = "*"
+
+// DefaultRoute is the rule index of a Route using the default recipients.
+const DefaultRoute = -1
+
+// Route is a set of collected fees along with the recipients they are
+// split between.
+type Route struct {
+	// Rule is the index of the matched denom rule, or DefaultRoute.
+	Rule       int
+	Recipients []Recipient
+	Fees       sdk.Coins
+}
+
+// NewDenomRule creates a new DenomRule forwarding the matching fees to the
+// recipients.
+func NewDenomRule(denom string, recipients ...Recipient) DenomRule {
+	return DenomRule{
+		Denom:      denom,
+		Recipients: recipients,
+	}
+}
+
+// NewKeepDenomRule creates a new DenomRule leaving the matching fees in the
+// fee collector.
+func NewKeepDenomRule(denom string) DenomRule {
+	return DenomRule{
+		Denom: denom,
+		Keep:  true,
+	}
+}
+
+// Matches returns true if the rule applies to the denom.
+func (r DenomRule) Matches(denom string) bool {
+	if prefix, ok := strings.CutSuffix(r.Denom, DenomWildcard); ok {
+		return strings.HasPrefix(denom, prefix)
+	}
+
+	return r.Denom == denom
+}
+
+// Validate performs basic validation of a denom rule.
+func (r DenomRule) Validate() error {
+	prefix, wildcard := strings.CutSuffix(r.Denom, DenomWildcard)
+	if strings.Contains(prefix, DenomWildcard) {
+		return fmt.Errorf("denom rule %s: wildcard is only allowed at the end", r.Denom)
+	}
+	if !wildcard {
+		if err := sdk.ValidateDenom(r.Denom); err != nil {
+			return fmt.Errorf("denom rule %s: %w", r.Denom, err)
+		}
+	}
+
+	if r.Keep && len(r.Recipients) > 0 {
+		return fmt.Errorf("denom rule %s: cannot both keep the fees and have recipients", r.Denom)
+	}
+	if !r.Keep && len(r.Recipients) == 0 {
+		return fmt.Errorf("denom rule %s: either keep or recipients must be set", r.Denom)
+	}
+	if err := validateRecipients(r.Recipients); err != nil {
+		return fmt.Errorf("denom rule %s: %w", r.Denom, err)
+	}
+
+	return nil
+}
+
+func validateDenomRules(i interface{}) error {
+	rules, ok := i.([]DenomRule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(rules))
+	for _, r := range rules {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		if seen[r.Denom] {
+			return fmt.Errorf("duplicate denom rule %s", r.Denom)
+		}
+		seen[r.Denom] = true
+	}
+
+	return nil
+}
+
+// RuleFor returns the index of the first denom rule matching the denom, or
+// DefaultRoute if none matches.
+func (p Params) RuleFor(denom string) int {
+	for i, r := range p.DenomRules {
+		if r.Matches(denom) {
+			return i
+		}
+	}
+
+	return DefaultRoute
+}
+
+// Distributes returns true if the fees in denom are burned or forwarded
+// rather than left in the fee collector.
+func (p Params) Distributes(denom string) bool {
+	rule := p.RuleFor(denom)
+	if rule != DefaultRoute {
+		return !p.DenomRules[rule].Keep
+	}
+
+	return len(p.Recipients) > 0 || p.Burns()
+}
+
+// DistributedFees returns the part of the fees that is burned or forwarded.
+func (p Params) DistributedFees(fees sdk.Coins) sdk.Coins {
+	distributed := sdk.NewCoins()
+	for _, fee := range fees {
+		if p.Distributes(fee.Denom) {
+			distributed = distributed.Add(fee)
+		}
+	}
+
+	return distributed
+}
+
+// RouteFees groups the fees by the rule matching their denom. Fees that are
+// kept by a rule, or that are not matched when there are no default
+// recipients, are left out. Routes are ordered by rule index, the default
+// route being last.
+func (p Params) RouteFees(fees sdk.Coins) []Route {
+	byRule := make(map[int]sdk.Coins)
+	for _, fee := range fees {
+		rule := p.RuleFor(fee.Denom)
+		byRule[rule] = byRule[rule].Add(fee)
+	}
+
+	var routes []Route
+	for i, r := range p.DenomRules {
+		if r.Keep || byRule[i].IsZero() {
+			continue
+		}
+		routes = append(routes, Route{
+			Rule:       i,
+			Recipients: r.Recipients,
+			Fees:       byRule[i],
+		})
+	}
+	if len(p.Recipients) > 0 && !byRule[DefaultRoute].IsZero() {
+		routes = append(routes, Route{
+			Rule:       DefaultRoute,
+			Recipients: p.Recipients,
+			Fees:       byRule[DefaultRoute],
+		})
+	}
+
+	return routes
+}
+
+// BurnFees returns the part of the fees to burn according to the burn rate.
+// Amounts are rounded down and fees kept in the fee collector by a denom rule
+// are not burned.
+func (p Params) BurnFees(fees sdk.Coins) sdk.Coins {
+	burn := sdk.NewCoins()
+	if !p.Burns() {
+		return burn
+	}
+
+	for _, fee := range fees {
+		rule := p.RuleFor(fee.Denom)
+		if rule != DefaultRoute && p.DenomRules[rule].Keep {
+			continue
+		}
+
+		amount := p.BurnRate.MulInt(fee.Amount).TruncateInt()
+		burn = burn.Add(sdk.NewCoin(fee.Denom, amount))
+	}
+
+	return burn
+}
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type RoutingTestSuite struct {
+	suite.Suite
+}
+
+func TestRoutingTestSuite(t *testing.T) {
+	suite.Run(t, new(RoutingTestSuite))
+}
+
+func (suite *RoutingTestSuite) TestDenomRuleMatches() {
+	testCases := []struct {
+		rule  string
+		denom string
+		match bool
+	}{
+		{"stake", "stake", true},
+		{"stake", "stake2", false},
+		{"ibc/*", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", true},
+		{"ibc/*", "stake", false},
+		{"ibc/*", "ibc", false},
+		{"*", "stake", true},
+		{"factory/*", "factory/cosmos1abc/token", true},
+	}
+
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.match, NewKeepDenomRule(tc.rule).Matches(tc.denom), "%s ~ %s", tc.rule, tc.denom)
+	}
+}
+
+func (suite *RoutingTestSuite) TestDenomRuleValidate() {
+	recipient := NewRecipient("cosmos147klh7th5jkjy3aajsj2rqvhtvh9mfde37wq5g", 1)
+
+	testCases := []struct {
+		name     string
+		rule     DenomRule
+		expError bool
+	}{
+		{"exact denom", NewDenomRule("stake", recipient), false},
+		{"prefix", NewDenomRule("ibc/*", recipient), false},
+		{"catch-all", NewKeepDenomRule("*"), false},
+		{"keep", NewKeepDenomRule("stake"), false},
+		{"invalid denom", NewDenomRule("1", recipient), true},
+		{"wildcard in the middle", NewDenomRule("ibc/*/x", recipient), true},
+		{"double wildcard", NewDenomRule("ibc/**", recipient), true},
+		{"keep with recipients", DenomRule{Denom: "stake", Keep: true, Recipients: []Recipient{recipient}}, true},
+		{"no action", DenomRule{Denom: "stake"}, true},
+		{"invalid recipient", NewDenomRule("stake", NewRecipient("abcd", 1)), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.rule.Validate()
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+
+	suite.Require().Error(validateDenomRules([]DenomRule{NewKeepDenomRule("stake"), NewKeepDenomRule("stake")}))
+	suite.Require().Error(validateDenomRules("stake"))
+}
+
+func (suite *RoutingTestSuite) TestRouteFees() {
+	gas := NewRecipient(sdk.AccAddress([]byte("gas_________________")).String(), 1)
+	ibc := NewRecipient(sdk.AccAddress([]byte("ibc_________________")).String(), 1)
+	other := NewRecipient(sdk.AccAddress([]byte("other_______________")).String(), 1)
+
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	fees := sdk.NewCoins(
+		sdk.NewInt64Coin("stake", 100),
+		sdk.NewInt64Coin(ibcDenom, 50),
+		sdk.NewInt64Coin("ukept", 10),
+		sdk.NewInt64Coin("uother", 5),
+	)
+
+	params := NewParams(true, other)
+	params.DenomRules = []DenomRule{
+		NewDenomRule("stake", gas),
+		NewDenomRule("ibc/*", ibc),
+		NewKeepDenomRule("ukept"),
+	}
+	suite.Require().NoError(params.Validate())
+
+	routes := params.RouteFees(fees)
+	suite.Require().Equal([]Route{
+		{Rule: 0, Recipients: []Recipient{gas}, Fees: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+		{Rule: 1, Recipients: []Recipient{ibc}, Fees: sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 50))},
+		{Rule: DefaultRoute, Recipients: []Recipient{other}, Fees: sdk.NewCoins(sdk.NewInt64Coin("uother", 5))},
+	}, routes)
+
+	// Without default recipients the unmatched denoms stay in the collector
+	params.Recipients = nil
+	suite.Require().NoError(params.Validate())
+	suite.Require().Len(params.RouteFees(fees), 2)
+
+	// The first matching rule wins
+	params.DenomRules = []DenomRule{NewKeepDenomRule("*"), NewDenomRule("stake", gas)}
+	suite.Require().Empty(params.RouteFees(fees))
+}
+
+func (suite *RoutingTestSuite) TestBurnFees() {
+	fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 101), sdk.NewInt64Coin("ukept", 10))
+
+	params := DefaultParams()
+	params.DenomRules = []DenomRule{NewKeepDenomRule("ukept")}
+	suite.Require().True(params.BurnFees(fees).IsZero())
+
+	params.BurnRate = sdkmath.LegacyNewDecWithPrec(5, 1)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), params.BurnFees(fees))
+
+	params.BurnRate = sdkmath.LegacyOneDec()
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 101)), params.BurnFees(fees))
+
+	// Params stored before the burn rate existed
+	params.BurnRate = sdkmath.LegacyDec{}
+	suite.Require().True(params.BurnFees(fees).IsZero())
+}
+
+func (suite *RoutingTestSuite) TestDistributedFees() {
+	recipient := NewRecipient(sdk.AccAddress([]byte("addr1_______________")).String(), 1)
+	fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("ukept", 5), sdk.NewInt64Coin("uother", 1))
+
+	params := DefaultParams()
+	params.DenomRules = []DenomRule{NewDenomRule("stake", recipient), NewKeepDenomRule("ukept")}
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), params.DistributedFees(fees))
+
+	// Unmatched denoms are burned
+	params.BurnRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("uother", 1)), params.DistributedFees(fees))
+
+	// or forwarded to the default recipients
+	params.BurnRate = sdkmath.LegacyZeroDec()
+	params.Recipients = []Recipient{recipient}
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("uother", 1)), params.DistributedFees(fees))
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SplitFees divides the fees between the recipients proportionally to their
+// weights. The returned slice is indexed like recipients. Shares are rounded
+// down and the rounding remainder of every denom goes to the first recipient,
+// so the sum of the shares always equals the fees.
+func SplitFees(fees sdk.Coins, recipients []Recipient) []sdk.Coins {
+	shares := make([]sdk.Coins, len(recipients))
+	if len(recipients) == 0 {
+		return shares
+	}
+
+	var total uint64
+	for _, r := range recipients {
+		total += r.Weight
+	}
+	if total == 0 {
+		return shares
+	}
+	totalInt := sdkmath.NewIntFromUint64(total)
+
+	for _, fee := range fees {
+		remainder := fee.Amount
+		for i, r := range recipients {
+			amount := fee.Amount.Mul(sdkmath.NewIntFromUint64(r.Weight)).Quo(totalInt)
+			remainder = remainder.Sub(amount)
+			shares[i] = shares[i].Add(sdk.NewCoin(fee.Denom, amount))
+		}
+		shares[0] = shares[0].Add(sdk.NewCoin(fee.Denom, remainder))
+	}
+
+	return shares
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type SplitTestSuite struct {
+	suite.Suite
+}
+
+func TestSplitTestSuite(t *testing.T) {
+	suite.Run(t, new(SplitTestSuite))
+}
+
+func (suite *SplitTestSuite) TestSplitFees() {
+	addr1 := sdk.AccAddress([]byte("addr1_______________")).String()
+	addr2 := sdk.AccAddress([]byte("addr2_______________")).String()
+	addr3 := sdk.AccAddress([]byte("addr3_______________")).String()
+
+	testCases := []struct {
+		name       string
+		fees       sdk.Coins
+		recipients []Recipient
+		expShares  []sdk.Coins
+	}{
+		{
+			"no recipients",
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			nil,
+			[]sdk.Coins{},
+		},
+		{
+			"single recipient",
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			[]Recipient{NewRecipient(addr1, 5)},
+			[]sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+		},
+		{
+			"exact split",
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			[]Recipient{NewRecipient(addr1, 70), NewRecipient(addr2, 30)},
+			[]sdk.Coins{
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 70)),
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 30)),
+			},
+		},
+		{
+			"remainder goes to the first recipient",
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("utoken", 2)),
+			[]Recipient{NewRecipient(addr1, 1), NewRecipient(addr2, 1), NewRecipient(addr3, 1)},
+			[]sdk.Coins{
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 34), sdk.NewInt64Coin("utoken", 2)),
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 33)),
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 33)),
+			},
+		},
+		{
+			"dust below the smallest share",
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+			[]Recipient{NewRecipient(addr1, 1), NewRecipient(addr2, 99)},
+			[]sdk.Coins{
+				sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+				nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			shares := SplitFees(tc.fees, tc.recipients)
+			suite.Require().Len(shares, len(tc.expShares))
+
+			total := sdk.NewCoins()
+			for i, share := range shares {
+				suite.Require().True(tc.expShares[i].Equal(share), "share %d: expected %s, got %s", i, tc.expShares[i], share)
+				total = total.Add(share...)
+			}
+			if len(tc.recipients) > 0 {
+				suite.Require().Equal(tc.fees, total)
+			}
+		})
+	}
+}
+
+func (suite *SplitTestSuite) TestSplitFeesLargeAmounts() {
+	amount, ok := sdkmath.NewIntFromString("1000000000000000000000000007")
+	suite.Require().True(ok)
+
+	fees := sdk.NewCoins(sdk.NewCoin("stake", amount))
+	recipients := []Recipient{
+		NewRecipient(sdk.AccAddress([]byte("addr1_______________")).String(), 1<<62),
+		NewRecipient(sdk.AccAddress([]byte("addr2_______________")).String(), 1<<62),
+	}
+
+	shares := SplitFees(fees, recipients)
+	suite.Require().Equal(fees, shares[0].Add(shares[1]...))
+	suite.Require().Equal(shares[0].AmountOf("stake").Sub(shares[1].AmountOf("stake")), sdkmath.OneInt())
+}